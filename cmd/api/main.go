@@ -37,9 +37,13 @@ func main() {
 
 	accountClient := pb.NewAccountServiceClient(conn)
 	transferClient := pb.NewTransferServiceClient(conn)
+	scheduleClient := pb.NewScheduleServiceClient(conn)
+	webhookClient := pb.NewWebhookServiceClient(conn)
 
 	accountHandler := handler.NewAccountHandler(accountClient, log)
 	transferHandler := handler.NewTransactionHandler(transferClient, log)
+	scheduleHandler := handler.NewScheduleHandler(scheduleClient, log)
+	webhookHandler := handler.NewWebhookHandler(webhookClient, log)
 
 	r := chi.NewRouter()
 	r.Use(middleware.RequestID)
@@ -48,7 +52,37 @@ func main() {
 	r.Use(atm.GRPCCorrelationMiddleware)
 
 	r.Post("/accounts", accountHandler.CreateAccount)
+	r.Get("/accounts/{id}", accountHandler.GetAccount)
+	r.Patch("/accounts/{id}", accountHandler.UpdateAccount)
+	r.Get("/accounts/{id}/balance", accountHandler.GetBalanceAt)
+	r.Get("/accounts/{id}/statement", accountHandler.GetStatement)
+	r.Put("/accounts/{id}/limits", accountHandler.SetAccountLimits)
+	r.Get("/accounts/{id}/limits", accountHandler.GetAccountLimits)
+	r.Delete("/accounts/{id}/limits", accountHandler.ClearAccountLimits)
+	r.Post("/accounts/{id}/freeze", accountHandler.FreezeAccount)
+	r.Post("/accounts/{id}/unfreeze", accountHandler.UnfreezeAccount)
+	r.Post("/accounts/{id}/close", accountHandler.CloseAccount)
+	r.Post("/accounts/{id}/deposit", transferHandler.Deposit)
+	r.Post("/accounts/{id}/withdraw", transferHandler.Withdraw)
+	r.Get("/accounts/{id}/transfers", transferHandler.ListTransfers)
+	r.Get("/accounts/{id}/events", transferHandler.StreamAccountEvents)
+	r.Get("/accounts/{id}/schedules", scheduleHandler.ListScheduledTransfers)
 	r.Post("/transfers", transferHandler.MakeTransfer)
+	r.Post("/transfers/authorize", transferHandler.AuthorizeTransfer)
+	r.Post("/transfers/batch", transferHandler.MakeBatchTransfer)
+	r.Post("/transfers/multi-leg", transferHandler.MakeMultiLegTransfer)
+	r.Get("/transfers/{id}", transferHandler.GetTransfer)
+	r.Post("/transfers/{id}/capture", transferHandler.CaptureTransfer)
+	r.Post("/transfers/{id}/void", transferHandler.VoidTransfer)
+	r.Post("/transfers/{id}/reverse", transferHandler.ReverseTransfer)
+	r.Post("/schedules", scheduleHandler.ScheduleTransfer)
+	r.Post("/schedules/{id}/cancel", scheduleHandler.CancelScheduledTransfer)
+	r.Post("/webhooks", webhookHandler.CreateWebhook)
+	r.Get("/webhooks", webhookHandler.ListWebhooks)
+	r.Get("/webhooks/{id}", webhookHandler.GetWebhook)
+	r.Delete("/webhooks/{id}", webhookHandler.DeleteWebhook)
+	r.Get("/webhooks/{id}/dead-letters", webhookHandler.ListDeadLetters)
+	r.Post("/webhooks/dead-letters/{id}/redeliver", webhookHandler.RedeliverDeadLetter)
 	log.Info("Server Listening", zap.Int("port", 8080))
 
 	if err := http.ListenAndServe(":8080", r); err != nil {
@@ -3,14 +3,20 @@ package main
 import (
 	"context"
 	"database/sql"
+	"expvar"
 	"github.com/jhaprabhatt/account-transfer-project/internal/config"
 	"github.com/jhaprabhatt/account-transfer-project/internal/core/handler"
 	"github.com/jhaprabhatt/account-transfer-project/internal/core/interceptors"
 	"github.com/jhaprabhatt/account-transfer-project/internal/logger"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
 	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+	"github.com/jhaprabhatt/account-transfer-project/internal/publisher"
 	"github.com/jhaprabhatt/account-transfer-project/internal/repository"
 	"github.com/jhaprabhatt/account-transfer-project/internal/service"
 	"net"
+	"net/http"
+	"strconv"
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -46,11 +52,129 @@ func main() {
 		log.Fatal("Failed to ping DB", zap.Error(err))
 	}
 
+	rounding, err := models.ParseRoundingMode(config.LoadFxConfig().RoundingMode)
+	if err != nil {
+		log.Fatal("Invalid FX configuration", zap.Error(err))
+	}
+
+	holdConfig := config.LoadHoldConfig()
+	holdExpiry, err := time.ParseDuration(holdConfig.ExpiryInterval)
+	if err != nil || holdExpiry <= 0 {
+		log.Fatal("Invalid hold configuration", zap.String("expiry_interval", holdConfig.ExpiryInterval))
+	}
+
+	schedConfig := config.LoadSchedulerConfig()
+	schedInterval, err := time.ParseDuration(schedConfig.Interval)
+	if err != nil || schedInterval <= 0 {
+		log.Fatal("Invalid scheduler configuration", zap.String("interval", schedConfig.Interval))
+	}
+
+	watchConfig := config.LoadWatchConfig()
+	watchInterval, err := time.ParseDuration(watchConfig.PollInterval)
+	if err != nil || watchInterval <= 0 {
+		log.Fatal("Invalid watch configuration", zap.String("poll_interval", watchConfig.PollInterval))
+	}
+
+	cacheConfig := config.LoadCacheConfig()
+	missingTTL, err := time.ParseDuration(cacheConfig.MissingTTL)
+	if err != nil || missingTTL <= 0 {
+		log.Fatal("Invalid cache configuration", zap.String("missing_ttl", cacheConfig.MissingTTL))
+	}
+
+	txConfig := config.LoadTxConfig()
+	txPolicy := repository.DefaultTxPolicy()
+	if txPolicy.Isolation, err = repository.ParseIsolationLevel(txConfig.Isolation); err != nil {
+		log.Fatal("Invalid transaction configuration", zap.Error(err))
+	}
+	if txPolicy.MaxAttempts, err = strconv.Atoi(txConfig.MaxAttempts); err != nil || txPolicy.MaxAttempts < 1 {
+		log.Fatal("Invalid transaction configuration", zap.String("max_attempts", txConfig.MaxAttempts))
+	}
+	if txPolicy.BaseDelay, err = time.ParseDuration(txConfig.RetryBaseDelay); err != nil || txPolicy.BaseDelay < 0 {
+		log.Fatal("Invalid transaction configuration", zap.String("retry_base_delay", txConfig.RetryBaseDelay))
+	}
+
+	limitsConfig := config.LoadLimitsConfig()
+	limits, err := models.ParseLimitDefaults(limitsConfig.MaxSingle, limitsConfig.DailyAmount, limitsConfig.DailyCount,
+		limitsConfig.MonthlyAmount, limitsConfig.MonthlyCount)
+	if err != nil {
+		log.Fatal("Invalid transfer limit configuration", zap.Error(err))
+	}
+
+	feeConfig := config.LoadFeeConfig()
+	fees, err := models.ParseFeeSchedule(feeConfig.Policies, feeConfig.Accounts)
+	if err != nil {
+		log.Fatal("Invalid fee configuration", zap.Error(err))
+	}
+
+	settlementConfig := config.LoadSettlementConfig()
+	settlement, err := models.ParseSettlementAccounts(settlementConfig.Accounts)
+	if err != nil {
+		log.Fatal("Invalid settlement configuration", zap.Error(err))
+	}
+
+	outboxConfig := config.LoadOutboxConfig()
+	relayInterval, err := time.ParseDuration(outboxConfig.RelayInterval)
+	if err != nil || relayInterval <= 0 {
+		log.Fatal("Invalid outbox configuration", zap.String("relay_interval", outboxConfig.RelayInterval))
+	}
+	relayBatch, err := strconv.Atoi(outboxConfig.BatchSize)
+	if err != nil || relayBatch < 1 {
+		log.Fatal("Invalid outbox configuration", zap.String("batch_size", outboxConfig.BatchSize))
+	}
+
+	webhookConfig := config.LoadWebhookConfig()
+	webhookInterval, err := time.ParseDuration(webhookConfig.DeliveryInterval)
+	if err != nil || webhookInterval <= 0 {
+		log.Fatal("Invalid webhook configuration", zap.String("delivery_interval", webhookConfig.DeliveryInterval))
+	}
+	webhookTimeout, err := time.ParseDuration(webhookConfig.Timeout)
+	if err != nil || webhookTimeout <= 0 {
+		log.Fatal("Invalid webhook configuration", zap.String("timeout", webhookConfig.Timeout))
+	}
+	webhookWorkers, err := strconv.Atoi(webhookConfig.Workers)
+	if err != nil || webhookWorkers < 1 {
+		log.Fatal("Invalid webhook configuration", zap.String("workers", webhookConfig.Workers))
+	}
+	webhookAllowPrivate, err := strconv.ParseBool(webhookConfig.AllowPrivateURLs)
+	if err != nil {
+		log.Fatal("Invalid webhook configuration", zap.String("allow_private_urls", webhookConfig.AllowPrivateURLs))
+	}
+	webhookRetry := models.DefaultWebhookRetryPolicy()
+	if webhookRetry.MaxAttempts, err = strconv.Atoi(webhookConfig.MaxAttempts); err != nil || webhookRetry.MaxAttempts < 1 {
+		log.Fatal("Invalid webhook configuration", zap.String("max_attempts", webhookConfig.MaxAttempts))
+	}
+	if webhookRetry.BaseDelay, err = time.ParseDuration(webhookConfig.BackoffBase); err != nil || webhookRetry.BaseDelay <= 0 {
+		log.Fatal("Invalid webhook configuration", zap.String("backoff_base", webhookConfig.BackoffBase))
+	}
+	if webhookRetry.MaxDelay, err = time.ParseDuration(webhookConfig.BackoffMax); err != nil || webhookRetry.MaxDelay < webhookRetry.BaseDelay {
+		log.Fatal("Invalid webhook configuration", zap.String("backoff_max", webhookConfig.BackoffMax))
+	}
+
+	var eventPublisher *publisher.WriterPublisher
+	switch outboxConfig.Publisher {
+	case "stdout":
+		eventPublisher = publisher.NewStdoutPublisher()
+	case "file":
+		if eventPublisher, err = publisher.NewFilePublisher(outboxConfig.File); err != nil {
+			log.Fatal("Invalid outbox configuration", zap.Error(err))
+		}
+		defer func() {
+			_ = eventPublisher.Close()
+		}()
+	case "none":
+	default:
+		log.Fatal("Invalid outbox configuration", zap.String("publisher", outboxConfig.Publisher))
+	}
+
 	accRepo := repository.NewAccountRepository(db, log)
-	transferRepo := repository.NewTransferRepository(db, log)
-	cache := repository.NewAccountCache()
+	transferRepo := repository.NewTransferRepository(db, txPolicy, limits, log)
+	fxRepo := repository.NewFxRateRepository(db, log)
+	cache := repository.NewAccountCache(missingTTL)
 	accSvc := service.NewAccountService(accRepo, cache, log)
-	txSvc := service.NewTransferService(transferRepo, cache, log)
+	txSvc := service.NewTransferService(transferRepo, accRepo, cache, fxRepo, rounding, fees, settlement, watchInterval, log)
+	schedSvc := service.NewScheduleService(repository.NewScheduleRepository(db, log), txSvc, log)
+	webhookSvc := service.NewWebhookService(repository.NewWebhookRepository(db, log), accRepo, cache,
+		publisher.NewWebhookClient(webhookTimeout, webhookAllowPrivate), webhookRetry, webhookWorkers, log)
 
 	log.Info("Starting Cache Warm-up...")
 	ctx := context.Background()
@@ -59,7 +183,30 @@ func main() {
 	}
 	log.Info("Cache Warm-up Complete")
 
+	go txSvc.RunHoldExpiry(ctx, holdExpiry)
+	go schedSvc.RunScheduler(ctx, schedInterval)
+	go webhookSvc.RunDelivery(ctx, webhookInterval)
+	if eventPublisher != nil {
+		relay := service.NewOutboxRelay(repository.NewOutboxRepository(db, log), eventPublisher, relayBatch, log)
+		go relay.RunRelay(ctx, relayInterval)
+	}
+
+	metricsAddr := config.LoadMetricsConfig().Addr
+	go func() {
+		log.Info("Serving metrics", zap.String("address", metricsAddr))
+		if err := http.ListenAndServe(metricsAddr, expvar.Handler()); err != nil {
+			log.Error("Metrics server stopped", zap.Error(err))
+		}
+	}()
+
 	grpcHandler := handler.NewGrpcHandler(accSvc, txSvc, log)
+	adminHandler := handler.NewAdminHandler(
+		service.NewReconciler(repository.NewReconcileRepository(db, log), log),
+		service.NewFxRateService(fxRepo, log),
+		log,
+	)
+	scheduleHandler := handler.NewScheduleHandler(schedSvc, log)
+	webhookHandler := handler.NewWebhookHandler(webhookSvc, log)
 
 	lis, err := net.Listen("tcp", ":50051")
 	if err != nil {
@@ -68,10 +215,14 @@ func main() {
 
 	grpcServer := grpc.NewServer(
 		grpc.UnaryInterceptor(interceptors.UnaryCorrelationInterceptor()),
+		grpc.StreamInterceptor(interceptors.StreamCorrelationInterceptor()),
 	)
 
 	pb.RegisterAccountServiceServer(grpcServer, grpcHandler)
 	pb.RegisterTransferServiceServer(grpcServer, grpcHandler)
+	pb.RegisterAdminServiceServer(grpcServer, adminHandler)
+	pb.RegisterScheduleServiceServer(grpcServer, scheduleHandler)
+	pb.RegisterWebhookServiceServer(grpcServer, webhookHandler)
 
 	log.Info("Core Service listening via gRPC", zap.String("address", ":50051"))
 	if err := grpcServer.Serve(lis); err != nil {
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"os"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/config"
+	"github.com/jhaprabhatt/account-transfer-project/internal/logger"
+	"github.com/jhaprabhatt/account-transfer-project/internal/repository"
+	"github.com/jhaprabhatt/account-transfer-project/internal/service"
+
+	"go.uber.org/zap"
+)
+
+// reconcile recomputes every account balance from its opening balance and the
+// transfers history, prints the report as JSON and exits 1 on any discrepancy.
+// Logging is kept at warn so stdout stays parseable.
+func main() {
+	log := logger.InitLogger("account-transfer-reconcile", "warn")
+
+	defer func() {
+		_ = log.Sync()
+	}()
+
+	dbConfig := config.LoadDatabaseConfig()
+
+	db, err := sql.Open("pgx", dbConfig.ConnectionString())
+	if err != nil {
+		log.Fatal("Failed to open DB connection", zap.Error(err))
+	}
+	defer func(db *sql.DB) {
+		err := db.Close()
+		if err != nil {
+			log.Error("Error closing DB connection")
+		}
+	}(db)
+
+	reconciler := service.NewReconciler(repository.NewReconcileRepository(db, log), log)
+
+	report, err := reconciler.Reconcile(context.Background())
+	if err != nil {
+		log.Fatal("Reconciliation failed", zap.Error(err))
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		log.Error("Failed to write report", zap.Error(err))
+	}
+
+	if !report.Consistent() {
+		_ = log.Sync()
+		os.Exit(1)
+	}
+}
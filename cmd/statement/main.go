@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"os"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/config"
+	"github.com/jhaprabhatt/account-transfer-project/internal/logger"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	"github.com/jhaprabhatt/account-transfer-project/internal/repository"
+	"github.com/jhaprabhatt/account-transfer-project/internal/service"
+
+	"go.uber.org/zap"
+)
+
+// statement writes the statement of one account between two RFC 3339 times to
+// stdout as json, csv or mt940, reading the database directly like reconcile.
+// Logging is kept at warn so stdout holds only the statement.
+func main() {
+	accountID := flag.Int64("account", 0, "account id")
+	fromFlag := flag.String("from", "", "start of the statement, RFC 3339 (exclusive)")
+	toFlag := flag.String("to", "", "end of the statement, RFC 3339 (inclusive)")
+	formatFlag := flag.String("format", "json", "json, csv or mt940")
+	flag.Parse()
+
+	log := logger.InitLogger("account-transfer-statement", "warn")
+
+	defer func() {
+		_ = log.Sync()
+	}()
+
+	format, err := models.ParseStatementFormat(*formatFlag)
+	if err != nil {
+		log.Fatal("Invalid format", zap.Error(err))
+	}
+	from, err := time.Parse(time.RFC3339, *fromFlag)
+	if err != nil {
+		log.Fatal("Invalid from", zap.Error(err))
+	}
+	to, err := time.Parse(time.RFC3339, *toFlag)
+	if err != nil {
+		log.Fatal("Invalid to", zap.Error(err))
+	}
+
+	dbConfig := config.LoadDatabaseConfig()
+
+	db, err := sql.Open("pgx", dbConfig.ConnectionString())
+	if err != nil {
+		log.Fatal("Failed to open DB connection", zap.Error(err))
+	}
+	defer func(db *sql.DB) {
+		err := db.Close()
+		if err != nil {
+			log.Error("Error closing DB connection")
+		}
+	}(db)
+
+	// Statements are read from the database alone, so no cache is wired in.
+	accountService := service.NewAccountService(repository.NewAccountRepository(db, log), nil, log)
+
+	statement, err := accountService.GetStatement(context.Background(), models.StatementQuery{
+		AccountID: *accountID,
+		From:      from,
+		To:        to,
+	})
+	if err != nil {
+		log.Fatal("Failed to generate statement", zap.Error(err))
+	}
+
+	if err := statement.Write(os.Stdout, format); err != nil {
+		log.Fatal("Failed to write statement", zap.Error(err))
+	}
+}
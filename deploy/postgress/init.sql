@@ -1,15 +1,96 @@
+-- ISO 4217 currencies and their minor units (decimal places). Mirrors
+-- currencyMinorUnits in internal/models/currency.go.
+CREATE TABLE IF NOT EXISTS currencies
+(
+    code        CHAR(3) PRIMARY KEY,
+    minor_units SMALLINT NOT NULL CHECK (minor_units BETWEEN 0 AND 5)
+);
+
+INSERT INTO currencies (code, minor_units)
+VALUES ('USD', 2), ('EUR', 2), ('GBP', 2), ('CHF', 2), ('CAD', 2), ('AUD', 2), ('INR', 2),
+       ('JPY', 0), ('KRW', 0),
+       ('KWD', 3), ('BHD', 3)
+ON CONFLICT (code) DO NOTHING;
+
 CREATE TABLE IF NOT EXISTS accounts
 (
-    account_id BIGINT PRIMARY KEY,
-    balance    NUMERIC(20, 5) NOT NULL DEFAULT 0,
-    CONSTRAINT check_balance_positive CHECK (balance >= 0)
+    account_id      BIGINT PRIMARY KEY,
+    balance         NUMERIC(20, 5) NOT NULL DEFAULT 0,
+    held_balance    NUMERIC(20, 5) NOT NULL DEFAULT 0, -- reserved by active holds
+    opening_balance NUMERIC(20, 5) NOT NULL DEFAULT 0,
+    currency        CHAR(3)        NOT NULL DEFAULT 'USD',
+    status          INT            NOT NULL DEFAULT 1, -- 1: ACTIVE, 2: FROZEN, 3: CLOSED
+    account_class   VARCHAR(32)    NOT NULL DEFAULT 'standard', -- selects the fee policy
+    overdraft_limit NUMERIC(20, 5) NOT NULL DEFAULT 0, -- how far below zero balance may go
+    version         BIGINT         NOT NULL DEFAULT 1, -- bumped on every update; orders cache writes
+    created_at      TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT check_overdraft_not_negative CHECK (overdraft_limit >= 0),
+    CONSTRAINT check_balance_within_overdraft CHECK (balance >= -overdraft_limit),
+    CONSTRAINT check_held_within_balance CHECK (held_balance >= 0 AND held_balance <= balance + overdraft_limit),
+    CONSTRAINT fk_account_currency FOREIGN KEY (currency) REFERENCES currencies (code)
+);
+
+-- Per-account transfer limits in the account's currency. A NULL column falls
+-- back to the default configured on the Core service.
+CREATE TABLE IF NOT EXISTS account_limits
+(
+    account_id     BIGINT PRIMARY KEY,
+    max_single     NUMERIC(20, 5),
+    daily_amount   NUMERIC(20, 5),
+    daily_count    INT,
+    monthly_amount NUMERIC(20, 5),
+    monthly_count  INT,
+    updated_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_limits_positive CHECK (max_single > 0 AND daily_amount > 0 AND daily_count > 0 AND
+                                            monthly_amount > 0 AND monthly_count > 0),
+    CONSTRAINT fk_limits_account FOREIGN KEY (account_id) REFERENCES accounts (account_id)
+);
+
+-- Append-only: one unit of base_currency buys rate units of quote_currency from
+-- effective_at onwards. Transfers reference the row they were priced with.
+CREATE TABLE IF NOT EXISTS fx_rates
+(
+    rate_id        BIGSERIAL PRIMARY KEY,
+    base_currency  CHAR(3)        NOT NULL,
+    quote_currency CHAR(3)        NOT NULL,
+    rate           NUMERIC(24, 10) NOT NULL,
+    effective_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_rate_positive CHECK (rate > 0),
+    CONSTRAINT check_rate_pair CHECK (base_currency <> quote_currency),
+    CONSTRAINT fk_rate_base FOREIGN KEY (base_currency) REFERENCES currencies (code),
+    CONSTRAINT fk_rate_quote FOREIGN KEY (quote_currency) REFERENCES currencies (code)
 );
 
+CREATE INDEX IF NOT EXISTS idx_fx_rates_pair ON fx_rates (base_currency, quote_currency, effective_at DESC);
+
+CREATE OR REPLACE FUNCTION reject_fx_rate_change() RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'fx rate % is immutable; load a new rate instead', OLD.rate_id;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_fx_rates_immutable ON fx_rates;
+CREATE TRIGGER trg_fx_rates_immutable
+    BEFORE UPDATE OR DELETE ON fx_rates
+    FOR EACH ROW
+EXECUTE FUNCTION reject_fx_rate_change();
+
+-- Drawn when a transfer moves balances, while its accounts are locked. A captured
+-- hold posts long after its transfer_id was assigned, so replay orders by this.
+CREATE SEQUENCE IF NOT EXISTS transfer_posting_seq;
+
+-- Shared by the transfers posted for one multi-leg transfer.
+CREATE SEQUENCE IF NOT EXISTS transfer_group_seq;
+
 CREATE TABLE IF NOT EXISTS transfers
 (
     transfer_id              SERIAL PRIMARY KEY,
     correlation_id           BIGINT         NOT NULL,
-    status                   INT                      DEFAULT 1, -- 1: PENDING, 2: COMPLETED, 3: FAILED
+    status                   INT                      DEFAULT 1, -- 1: PENDING, 2: COMPLETED, 3: FAILED, 4: REVERSED, 5: PARTIALLY_REVERSED
     source_account_id        BIGINT         NOT NULL,
     destination_account_id   BIGINT         NOT NULL,
     amount                   NUMERIC(20, 5) NOT NULL,
@@ -17,11 +98,287 @@ CREATE TABLE IF NOT EXISTS transfers
     source_post_balance      NUMERIC(20, 5)           DEFAULT 0,
     destination_prev_balance NUMERIC(20, 5) NOT NULL,
     destination_post_balance NUMERIC(20, 5)           DEFAULT 0,
+    idempotency_key          VARCHAR(255),
+    currency                 CHAR(3)        NOT NULL DEFAULT 'USD',
+    destination_amount       NUMERIC(20, 5) NOT NULL,
+    destination_currency     CHAR(3)        NOT NULL DEFAULT 'USD',
+    fx_rate_id               BIGINT,
+    fx_rate                  NUMERIC(24, 10),
+    posting_seq              BIGINT,
+    -- Set with posting_seq, from the clock rather than the transaction start, so
+    -- per account it increases in posting order.
+    posted_at                TIMESTAMP WITH TIME ZONE,
+    -- A reversal runs from the original destination back to its source and
+    -- keeps the original fx_rate_id and fx_rate, quoted in the original direction.
+    reversal_of              INT,
+    reversed_amount          NUMERIC(20, 5) NOT NULL  DEFAULT 0,
+    reason                   VARCHAR(255),
+    group_id                 BIGINT,
+    -- A transfer charged a fee records it in fee; the fee itself is posted as a
+    -- separate transfer to the fee account, with fee_of pointing back here.
+    fee                      NUMERIC(20, 5) NOT NULL  DEFAULT 0,
+    fee_of                   INT,
+    kind                     INT            NOT NULL  DEFAULT 1, -- 1: TRANSFER, 2: DEPOSIT, 3: WITHDRAWAL
     created_at               TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
 
+    CONSTRAINT uq_transfers_idempotency_key UNIQUE (idempotency_key),
     CONSTRAINT fk_source FOREIGN KEY (source_account_id) REFERENCES accounts (account_id),
-    CONSTRAINT fk_dest FOREIGN KEY (destination_account_id) REFERENCES accounts (account_id)
+    CONSTRAINT fk_dest FOREIGN KEY (destination_account_id) REFERENCES accounts (account_id),
+    CONSTRAINT fk_transfer_currency FOREIGN KEY (currency) REFERENCES currencies (code),
+    CONSTRAINT fk_transfer_dest_currency FOREIGN KEY (destination_currency) REFERENCES currencies (code),
+    CONSTRAINT fk_transfer_fx_rate FOREIGN KEY (fx_rate_id) REFERENCES fx_rates (rate_id),
+    CONSTRAINT check_fx_rate_present CHECK ((fx_rate_id IS NULL) = (currency = destination_currency)),
+    CONSTRAINT fk_reversal_of FOREIGN KEY (reversal_of) REFERENCES transfers (transfer_id),
+    CONSTRAINT check_reversed_within_amount CHECK (reversed_amount >= 0 AND reversed_amount <= amount),
+    CONSTRAINT check_fee_not_negative CHECK (fee >= 0),
+    CONSTRAINT fk_fee_of FOREIGN KEY (fee_of) REFERENCES transfers (transfer_id)
+);
+
+-- NUMERIC(20, 5) only bounds the widest currency; the real scale is per currency.
+-- TG_ARGV[0] names the amount column and TG_ARGV[1] the currency column it is in.
+CREATE OR REPLACE FUNCTION check_currency_scale() RETURNS TRIGGER AS
+$$
+DECLARE
+    checked NUMERIC := (to_jsonb(NEW) ->> TG_ARGV[0])::NUMERIC;
+    cur CHAR(3) := to_jsonb(NEW) ->> TG_ARGV[1];
+    units SMALLINT;
+BEGIN
+    SELECT minor_units INTO units FROM currencies WHERE code = cur;
+    IF checked <> round(checked, units) THEN
+        RAISE EXCEPTION '% % exceeds % decimal places for %', TG_ARGV[0], checked, units, cur;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_accounts_currency_scale ON accounts;
+CREATE TRIGGER trg_accounts_currency_scale
+    BEFORE INSERT OR UPDATE OF balance, currency ON accounts
+    FOR EACH ROW
+EXECUTE FUNCTION check_currency_scale('balance', 'currency');
+
+-- Every change to an account row bumps its version, so a cached copy can tell
+-- whether it is older than the one being written.
+CREATE OR REPLACE FUNCTION bump_account_version() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.version := OLD.version + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_accounts_version ON accounts;
+CREATE TRIGGER trg_accounts_version
+    BEFORE UPDATE ON accounts
+    FOR EACH ROW
+EXECUTE FUNCTION bump_account_version();
+
+DROP TRIGGER IF EXISTS trg_transfers_currency_scale ON transfers;
+CREATE TRIGGER trg_transfers_currency_scale
+    BEFORE INSERT ON transfers
+    FOR EACH ROW
+EXECUTE FUNCTION check_currency_scale('amount', 'currency');
+
+DROP TRIGGER IF EXISTS trg_transfers_dest_currency_scale ON transfers;
+CREATE TRIGGER trg_transfers_dest_currency_scale
+    BEFORE INSERT ON transfers
+    FOR EACH ROW
+EXECUTE FUNCTION check_currency_scale('destination_amount', 'destination_currency');
+
+-- (account, transfer_id) ordering backs keyset pagination of per-account history.
+CREATE INDEX IF NOT EXISTS idx_transfers_source ON transfers (source_account_id, transfer_id);
+CREATE INDEX IF NOT EXISTS idx_transfers_dest ON transfers (destination_account_id, transfer_id);
+CREATE INDEX IF NOT EXISTS idx_transfers_posting ON transfers (posting_seq);
+CREATE INDEX IF NOT EXISTS idx_transfers_reversal_of ON transfers (reversal_of) WHERE reversal_of IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_transfers_group ON transfers (group_id) WHERE group_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_transfers_fee_of ON transfers (fee_of) WHERE fee_of IS NOT NULL;
+-- Serves the daily and monthly totals checked against transfer limits.
+CREATE INDEX IF NOT EXISTS idx_transfers_source_created ON transfers (source_account_id, created_at);
+-- Find the last posting to an account at or before a point in time.
+CREATE INDEX IF NOT EXISTS idx_transfers_source_posted ON transfers (source_account_id, posted_at DESC, posting_seq DESC);
+CREATE INDEX IF NOT EXISTS idx_transfers_dest_posted ON transfers (destination_account_id, posted_at DESC, posting_seq DESC);
+-- Follow the postings to an account in order for WatchTransfers.
+CREATE INDEX IF NOT EXISTS idx_transfers_source_seq ON transfers (source_account_id, posting_seq) WHERE posting_seq IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_transfers_dest_seq ON transfers (destination_account_id, posting_seq) WHERE posting_seq IS NOT NULL;
+
+-- Funds reserved by an authorized transfer. hold_id is the PENDING transfer
+-- the hold belongs to; capturing completes that transfer in place.
+CREATE TABLE IF NOT EXISTS holds
+(
+    hold_id         INT PRIMARY KEY,
+    account_id      BIGINT         NOT NULL,
+    amount          NUMERIC(20, 5) NOT NULL,
+    captured_amount NUMERIC(20, 5),
+    status          INT            NOT NULL DEFAULT 1, -- 1: ACTIVE, 2: CAPTURED, 3: VOIDED, 4: EXPIRED
+    expires_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at      TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_hold_positive CHECK (amount > 0),
+    CONSTRAINT check_capture_within_hold CHECK (captured_amount > 0 AND captured_amount <= amount),
+    CONSTRAINT fk_hold_transfer FOREIGN KEY (hold_id) REFERENCES transfers (transfer_id),
+    CONSTRAINT fk_hold_account FOREIGN KEY (account_id) REFERENCES accounts (account_id)
+);
+
+-- Serves the expiry sweep, which only ever looks at active holds.
+CREATE INDEX IF NOT EXISTS idx_holds_active_expiry ON holds (expires_at) WHERE status = 1;
+
+-- Double-entry postings beneath accounts.balance: one debit (negative) and one
+-- credit (positive) per transfer. balance = opening_balance + SUM(amount).
+-- Cross-currency journals route through the FX position, stored as a NULL
+-- account_id, so each currency nets to zero on its own.
+CREATE TABLE IF NOT EXISTS ledger_entries
+(
+    entry_id    BIGSERIAL PRIMARY KEY,
+    transfer_id INT            NOT NULL,
+    account_id  BIGINT,
+    amount      NUMERIC(20, 5) NOT NULL,
+    currency    CHAR(3)        NOT NULL,
+    created_at  TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_entry_non_zero CHECK (amount <> 0),
+    CONSTRAINT fk_entry_transfer FOREIGN KEY (transfer_id) REFERENCES transfers (transfer_id),
+    CONSTRAINT fk_entry_account FOREIGN KEY (account_id) REFERENCES accounts (account_id),
+    CONSTRAINT fk_entry_currency FOREIGN KEY (currency) REFERENCES currencies (code)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_account ON ledger_entries (account_id, entry_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_transfer ON ledger_entries (transfer_id);
+
+-- Every journal must sum to zero per currency; checked at commit so all legs are visible.
+CREATE OR REPLACE FUNCTION check_journal_balanced() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF EXISTS (SELECT 1
+               FROM ledger_entries
+               WHERE transfer_id = NEW.transfer_id
+               GROUP BY currency
+               HAVING SUM(amount) <> 0) THEN
+        RAISE EXCEPTION 'ledger journal for transfer % does not sum to zero', NEW.transfer_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_journal_balanced ON ledger_entries;
+CREATE CONSTRAINT TRIGGER trg_journal_balanced
+    AFTER INSERT ON ledger_entries
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW
+EXECUTE FUNCTION check_journal_balanced();
+
+-- Future and recurring transfers. schedule is a cron expression or @every
+-- interval, empty for a one-off; next_run_at is NULL once nothing is left to run.
+CREATE TABLE IF NOT EXISTS scheduled_transfers
+(
+    schedule_id            SERIAL PRIMARY KEY,
+    source_account_id      BIGINT         NOT NULL,
+    destination_account_id BIGINT         NOT NULL,
+    amount                 NUMERIC(20, 5) NOT NULL,
+    currency               CHAR(3),
+    convert                BOOLEAN        NOT NULL DEFAULT FALSE,
+    schedule               VARCHAR(100)   NOT NULL DEFAULT '',
+    status                 INT            NOT NULL DEFAULT 1, -- 1: ACTIVE, 2: CANCELLED, 3: COMPLETED
+    next_run_at            TIMESTAMP WITH TIME ZONE,
+    attempts               INT            NOT NULL DEFAULT 0, -- failed tries of the occurrence due at next_run_at
+    created_at             TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at             TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_schedule_positive CHECK (amount > 0),
+    CONSTRAINT fk_schedule_source FOREIGN KEY (source_account_id) REFERENCES accounts (account_id),
+    CONSTRAINT fk_schedule_dest FOREIGN KEY (destination_account_id) REFERENCES accounts (account_id)
+);
+
+-- Serves the scheduler's due scan, which only ever looks at active schedules.
+CREATE INDEX IF NOT EXISTS idx_scheduled_transfers_due ON scheduled_transfers (next_run_at) WHERE status = 1;
+CREATE INDEX IF NOT EXISTS idx_scheduled_transfers_source ON scheduled_transfers (source_account_id, schedule_id);
+
+-- One row per executed occurrence. The unique key keeps a retried occurrence
+-- from being recorded twice.
+CREATE TABLE IF NOT EXISTS scheduled_transfer_runs
+(
+    run_id        BIGSERIAL PRIMARY KEY,
+    schedule_id   INT                      NOT NULL,
+    scheduled_for TIMESTAMP WITH TIME ZONE NOT NULL,
+    status        INT                      NOT NULL, -- 1: SUCCEEDED, 2: FAILED
+    transfer_id   INT,
+    error         TEXT,
+    executed_at   TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT uq_scheduled_run UNIQUE (schedule_id, scheduled_for),
+    CONSTRAINT fk_run_schedule FOREIGN KEY (schedule_id) REFERENCES scheduled_transfers (schedule_id),
+    CONSTRAINT fk_run_transfer FOREIGN KEY (transfer_id) REFERENCES transfers (transfer_id)
+);
+
+-- Transactional outbox: domain events are written in the transaction that
+-- causes them and published afterwards by the relay in Core, in event_id order.
+-- account_id is the account the event is keyed on (a transfer's source) and
+-- counterparty_id a transfer's destination.
+CREATE TABLE IF NOT EXISTS outbox
+(
+    event_id        BIGSERIAL PRIMARY KEY,
+    account_id      BIGINT                   NOT NULL,
+    counterparty_id BIGINT,
+    event_type      VARCHAR(64)              NOT NULL,
+    event_version   INT                      NOT NULL,
+    payload         JSONB                    NOT NULL,
+    created_at      TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    published_at    TIMESTAMP WITH TIME ZONE
+);
+
+-- Serves the relay's scan, which only ever looks at unpublished events.
+CREATE INDEX IF NOT EXISTS idx_outbox_unpublished ON outbox (event_id) WHERE published_at IS NULL;
+
+-- Webhook subscriptions. account_id NULL subscribes to every account; the
+-- secret signs each delivery with HMAC-SHA256.
+CREATE TABLE IF NOT EXISTS webhook_subscriptions
+(
+    subscription_id SERIAL PRIMARY KEY,
+    url             TEXT                     NOT NULL,
+    secret          VARCHAR(255)             NOT NULL,
+    event_types     TEXT[]                   NOT NULL,
+    account_id      BIGINT,
+    status          INT                      NOT NULL DEFAULT 1, -- 1: ACTIVE, 2: DELETED
+    created_at      TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_webhook_account FOREIGN KEY (account_id) REFERENCES accounts (account_id)
+);
+
+-- One row per event due to an active subscription, written in the statement
+-- that writes the event to the outbox. The unique key keeps redelivery from
+-- queuing an event twice.
+CREATE TABLE IF NOT EXISTS webhook_deliveries
+(
+    delivery_id     BIGSERIAL PRIMARY KEY,
+    subscription_id INT                      NOT NULL,
+    event_id        BIGINT                   NOT NULL,
+    attempts        INT                      NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error      TEXT,
+    delivered_at    TIMESTAMP WITH TIME ZONE,
+    created_at      TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT uq_webhook_delivery UNIQUE (subscription_id, event_id),
+    CONSTRAINT fk_delivery_subscription FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions (subscription_id),
+    CONSTRAINT fk_delivery_event FOREIGN KEY (event_id) REFERENCES outbox (event_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries (next_attempt_at) WHERE delivered_at IS NULL;
+
+-- Deliveries that used up their attempts, moved out of webhook_deliveries.
+-- Redelivering one moves it back with a fresh set of attempts.
+CREATE TABLE IF NOT EXISTS webhook_dead_letters
+(
+    dead_letter_id  BIGSERIAL PRIMARY KEY,
+    subscription_id INT                      NOT NULL,
+    event_id        BIGINT                   NOT NULL,
+    attempts        INT                      NOT NULL,
+    last_error      TEXT                     NOT NULL,
+    failed_at       TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_dead_letter_subscription FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions (subscription_id),
+    CONSTRAINT fk_dead_letter_event FOREIGN KEY (event_id) REFERENCES outbox (event_id)
 );
 
-CREATE INDEX IF NOT EXISTS idx_transfers_source ON transfers (source_account_id);
-CREATE INDEX IF NOT EXISTS idx_transfers_dest ON transfers (destination_account_id);
\ No newline at end of file
+CREATE INDEX IF NOT EXISTS idx_webhook_dead_letters_subscription ON webhook_dead_letters (subscription_id, dead_letter_id);
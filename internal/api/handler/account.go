@@ -1,13 +1,19 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
 	"github.com/jhaprabhatt/account-transfer-project/internal/models"
 	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
 )
@@ -29,7 +35,15 @@ func (h *AccountHandler) CreateAccount(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	grpcReq := &pb.CreateAccountRequest{AccountId: req.ID, Balance: req.Balance.String()}
+	grpcReq := &pb.CreateAccountRequest{
+		AccountId:    req.ID,
+		Balance:      req.Balance.String(),
+		Currency:     req.Currency,
+		AccountClass: req.Class,
+	}
+	if !req.OverdraftLimit.IsZero() {
+		grpcReq.OverdraftLimit = req.OverdraftLimit.String()
+	}
 
 	h.log.Info("Forwarding creation request to Core", zap.Int64("account_id", req.ID))
 
@@ -46,8 +60,8 @@ func (h *AccountHandler) CreateAccount(w http.ResponseWriter, r *http.Request) {
 		}
 
 		if ok && st.Code() == codes.InvalidArgument {
-			h.log.Warn("Negative account balance", zap.Error(err))
-			http.Error(w, "amount must be greater than or equal to zero", http.StatusBadRequest)
+			h.log.Warn("Invalid account creation request", zap.Error(err))
+			http.Error(w, st.Message(), http.StatusBadRequest)
 			return
 		}
 
@@ -68,3 +82,155 @@ func (h *AccountHandler) CreateAccount(w http.ResponseWriter, r *http.Request) {
 		h.log.Error("Failed to write response", zap.Error(err))
 	}
 }
+
+func (h *AccountHandler) GetAccount(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		h.log.Warn("Invalid account id in path", zap.String("id", chi.URLParam(r, "id")))
+		http.Error(w, constants.ErrInvalidAccountID.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.client.GetAccount(r.Context(), &pb.GetAccountRequest{AccountId: id})
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			http.Error(w, "Account not found", http.StatusNotFound)
+		case codes.InvalidArgument:
+			http.Error(w, st.Message(), http.StatusBadRequest)
+		default:
+			h.log.Error("gRPC call failed", zap.Int64("account_id", id), zap.Error(err))
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.log.Error("Failed to write response", zap.Error(err))
+	}
+}
+
+// UpdateAccount changes an account's settings; overdraft_limit is currently the
+// only one.
+func (h *AccountHandler) UpdateAccount(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		h.log.Warn("Invalid account id in path", zap.String("id", chi.URLParam(r, "id")))
+		http.Error(w, constants.ErrInvalidAccountID.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var req models.UpdateAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.log.Warn("Failed to decode JSON", zap.Error(err))
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	if !req.OverdraftLimit.Valid {
+		http.Error(w, "overdraft_limit is required", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.client.UpdateAccount(r.Context(), &pb.UpdateAccountRequest{
+		AccountId:      id,
+		OverdraftLimit: req.OverdraftLimit.Decimal.String(),
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		if st.Code() == codes.Internal || st.Code() == codes.Unknown {
+			h.log.Error("gRPC call failed", zap.Int64("account_id", id), zap.Error(err))
+		}
+		writeGRPCError(w, st)
+		return
+	}
+
+	h.log.Info("Account updated", zap.Int64("account_id", id), zap.String("overdraft_limit", resp.OverdraftLimit))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.log.Error("Failed to write response", zap.Error(err))
+	}
+}
+
+// GetBalanceAt serves GET /accounts/{id}/balance?at=, the account's balance as
+// of an RFC 3339 time.
+func (h *AccountHandler) GetBalanceAt(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		h.log.Warn("Invalid account id in path", zap.String("id", chi.URLParam(r, "id")))
+		http.Error(w, constants.ErrInvalidAccountID.Error(), http.StatusBadRequest)
+		return
+	}
+
+	at := r.URL.Query().Get("at")
+	if _, err := time.Parse(time.RFC3339, at); err != nil {
+		http.Error(w, constants.ErrInvalidTimestamp.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.client.GetBalanceAt(r.Context(), &pb.GetBalanceAtRequest{AccountId: id, At: at})
+	if err != nil {
+		st, _ := status.FromError(err)
+		if st.Code() == codes.Internal || st.Code() == codes.Unknown {
+			h.log.Error("gRPC call failed", zap.Int64("account_id", id), zap.Error(err))
+		}
+		writeGRPCError(w, st)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.log.Error("Failed to write response", zap.Error(err))
+	}
+}
+
+func (h *AccountHandler) FreezeAccount(w http.ResponseWriter, r *http.Request) {
+	h.changeStatus(w, r, h.client.FreezeAccount)
+}
+
+func (h *AccountHandler) UnfreezeAccount(w http.ResponseWriter, r *http.Request) {
+	h.changeStatus(w, r, h.client.UnfreezeAccount)
+}
+
+func (h *AccountHandler) CloseAccount(w http.ResponseWriter, r *http.Request) {
+	h.changeStatus(w, r, h.client.CloseAccount)
+}
+
+type statusChangeFunc func(ctx context.Context, in *pb.AccountStatusRequest, opts ...grpc.CallOption) (*pb.AccountStatusResponse, error)
+
+func (h *AccountHandler) changeStatus(w http.ResponseWriter, r *http.Request, change statusChangeFunc) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		h.log.Warn("Invalid account id in path", zap.String("id", chi.URLParam(r, "id")))
+		http.Error(w, constants.ErrInvalidAccountID.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := change(r.Context(), &pb.AccountStatusRequest{AccountId: id})
+	if err != nil {
+		st, _ := status.FromError(err)
+		if st.Code() == codes.Internal || st.Code() == codes.Unknown {
+			h.log.Error("gRPC call failed", zap.Int64("account_id", id), zap.Error(err))
+		}
+		writeGRPCError(w, st)
+		return
+	}
+
+	h.log.Info("Account status changed", zap.Int64("account_id", id), zap.String("status", resp.Status))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.log.Error("Failed to write response", zap.Error(err))
+	}
+}
@@ -2,16 +2,22 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/jhaprabhatt/account-transfer-project/internal/api/handler/mocks"
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
 	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
 )
 
@@ -65,4 +71,296 @@ func TestAccountHandler_CreateAccount(t *testing.T) {
 
 		assert.Equal(t, http.StatusInternalServerError, rr.Code)
 	})
+
+	t.Run("Success: Currency Forwarded", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		reqBody := `{"account_id": 102, "balance": 1000, "currency": "JPY"}`
+		req, _ := http.NewRequest("POST", "/accounts", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		expectedGrpcReq := &pb.CreateAccountRequest{AccountId: 102, Balance: "1000", Currency: "JPY"}
+		mockClient.On("CreateAccount", mock.Anything, expectedGrpcReq).
+			Return(&pb.CreateAccountResponse{Success: true}, nil)
+
+		h.CreateAccount(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Success: Account Class Forwarded", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		reqBody := `{"account_id": 103, "balance": 10, "account_class": "business"}`
+		req, _ := http.NewRequest("POST", "/accounts", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		expectedGrpcReq := &pb.CreateAccountRequest{AccountId: 103, Balance: "10", AccountClass: "business"}
+		mockClient.On("CreateAccount", mock.Anything, expectedGrpcReq).
+			Return(&pb.CreateAccountResponse{Success: true}, nil)
+
+		h.CreateAccount(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Invalid Currency Scale", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		reqBody := `{"account_id": 102, "balance": 10.5, "currency": "JPY"}`
+		req, _ := http.NewRequest("POST", "/accounts", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		mockClient.On("CreateAccount", mock.Anything, mock.Anything).
+			Return(nil, status.Error(codes.InvalidArgument, constants.ErrInvalidAmountScale.Error()))
+
+		h.CreateAccount(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), constants.ErrInvalidAmountScale.Error())
+	})
+}
+
+func withURLParam(req *http.Request, key, value string) *http.Request {
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add(key, value)
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestAccountHandler_GetAccount(t *testing.T) {
+	t.Run("Success: Account Found", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req, _ := http.NewRequest("GET", "/accounts/101", nil)
+		req = withURLParam(req, "id", "101")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("GetAccount", mock.Anything, &pb.GetAccountRequest{AccountId: 101}).
+			Return(&pb.GetAccountResponse{AccountId: 101, Balance: "500"}, nil)
+
+		h.GetAccount(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"balance":"500"`)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Invalid Account ID", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req, _ := http.NewRequest("GET", "/accounts/abc", nil)
+		req = withURLParam(req, "id", "abc")
+		rr := httptest.NewRecorder()
+
+		h.GetAccount(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockClient.AssertNotCalled(t, "GetAccount")
+	})
+
+	t.Run("Failure: Account Not Found", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req, _ := http.NewRequest("GET", "/accounts/404", nil)
+		req = withURLParam(req, "id", "404")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("GetAccount", mock.Anything, mock.Anything).
+			Return(nil, status.Error(codes.NotFound, "account not found"))
+
+		h.GetAccount(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Failure: gRPC Service Error", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req, _ := http.NewRequest("GET", "/accounts/101", nil)
+		req = withURLParam(req, "id", "101")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("GetAccount", mock.Anything, mock.Anything).
+			Return(nil, errors.New("connection refused"))
+
+		h.GetAccount(rr, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func reasonError(code codes.Code, msg, reason string) error {
+	st, _ := status.New(code, msg).WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: constants.ErrorDomain})
+	return st.Err()
+}
+
+func TestAccountHandler_StatusChanges(t *testing.T) {
+	t.Run("Success: Account Frozen", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req, _ := http.NewRequest("POST", "/accounts/101/freeze", nil)
+		req = withURLParam(req, "id", "101")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("FreezeAccount", mock.Anything, &pb.AccountStatusRequest{AccountId: 101}).
+			Return(&pb.AccountStatusResponse{AccountId: 101, Status: "FROZEN"}, nil)
+
+		h.FreezeAccount(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"status":"FROZEN"`)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Success: Account Unfrozen", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req, _ := http.NewRequest("POST", "/accounts/101/unfreeze", nil)
+		req = withURLParam(req, "id", "101")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("UnfreezeAccount", mock.Anything, &pb.AccountStatusRequest{AccountId: 101}).
+			Return(&pb.AccountStatusResponse{AccountId: 101, Status: "ACTIVE"}, nil)
+
+		h.UnfreezeAccount(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Invalid Account ID", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req, _ := http.NewRequest("POST", "/accounts/abc/close", nil)
+		req = withURLParam(req, "id", "abc")
+		rr := httptest.NewRecorder()
+
+		h.CloseAccount(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockClient.AssertNotCalled(t, "CloseAccount")
+	})
+
+	t.Run("Failure: Close With Balance", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req, _ := http.NewRequest("POST", "/accounts/101/close", nil)
+		req = withURLParam(req, "id", "101")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("CloseAccount", mock.Anything, mock.Anything).
+			Return(nil, reasonError(codes.FailedPrecondition, "account balance must be zero to close", constants.ReasonBalanceNotZero))
+
+		h.CloseAccount(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("Failure: Already Closed", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req, _ := http.NewRequest("POST", "/accounts/101/freeze", nil)
+		req = withURLParam(req, "id", "101")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("FreezeAccount", mock.Anything, mock.Anything).
+			Return(nil, reasonError(codes.FailedPrecondition, "invalid account status transition", constants.ReasonInvalidStatusTransition))
+
+		h.FreezeAccount(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func TestAccountHandler_UpdateAccount(t *testing.T) {
+	t.Run("Success: Overdraft Limit Updated", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req, _ := http.NewRequest("PATCH", "/accounts/101", bytes.NewBufferString(`{"overdraft_limit": "250.50"}`))
+		req = withURLParam(req, "id", "101")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("UpdateAccount", mock.Anything, &pb.UpdateAccountRequest{AccountId: 101, OverdraftLimit: "250.5"}).
+			Return(&pb.GetAccountResponse{AccountId: 101, Balance: "-20", OverdraftLimit: "250.5", AvailableBalance: "230.5"}, nil)
+
+		h.UpdateAccount(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"overdraft_limit":"250.5"`)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Overdraft Limit Missing", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req, _ := http.NewRequest("PATCH", "/accounts/101", bytes.NewBufferString(`{}`))
+		req = withURLParam(req, "id", "101")
+		rr := httptest.NewRecorder()
+
+		h.UpdateAccount(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockClient.AssertNotCalled(t, "UpdateAccount")
+	})
+
+	t.Run("Failure: Limit Below Drawn Balance", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req, _ := http.NewRequest("PATCH", "/accounts/101", bytes.NewBufferString(`{"overdraft_limit": 0}`))
+		req = withURLParam(req, "id", "101")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("UpdateAccount", mock.Anything, mock.Anything).
+			Return(nil, status.Error(codes.FailedPrecondition, constants.ErrOverdraftLimitTooLow.Error()))
+
+		h.UpdateAccount(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+}
+
+func TestAccountHandler_GetBalanceAt(t *testing.T) {
+	t.Run("Success: Balance Returned", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req := withURLParam(httptest.NewRequest("GET", "/accounts/101/balance?at=2026-03-01T14:00:00Z", nil), "id", "101")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("GetBalanceAt", mock.Anything, &pb.GetBalanceAtRequest{AccountId: 101, At: "2026-03-01T14:00:00Z"}).
+			Return(&pb.GetBalanceAtResponse{AccountId: 101, Balance: "450", Currency: "USD", At: "2026-03-01T14:00:00Z", TransferId: 42}, nil)
+
+		h.GetBalanceAt(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"balance":"450"`)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Missing At", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req := withURLParam(httptest.NewRequest("GET", "/accounts/101/balance", nil), "id", "101")
+		rr := httptest.NewRecorder()
+
+		h.GetBalanceAt(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockClient.AssertNotCalled(t, "GetBalanceAt")
+	})
+
+	t.Run("Failure: Account Not Open Yet", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req := withURLParam(httptest.NewRequest("GET", "/accounts/101/balance?at=2020-01-01T00:00:00Z", nil), "id", "101")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("GetBalanceAt", mock.Anything, mock.Anything).
+			Return(nil, status.Error(codes.NotFound, constants.ErrAccountNotOpenYet.Error()))
+
+		h.GetBalanceAt(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
 }
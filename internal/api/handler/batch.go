@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+// MakeBatchTransfer serves POST /transfers/batch. A failed all-or-nothing batch
+// answers with the error of the leg that failed it; otherwise the response
+// lists the outcome of every leg.
+func (h *TransactionHandler) MakeBatchTransfer(w http.ResponseWriter, r *http.Request) {
+	var req models.BatchTransferRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.log.Warn("Failed to decode batch transfer request", zap.Error(err))
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.log.Warn("Invalid batch transfer request", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	grpcReq := &pb.BatchTransferRequest{
+		Legs:       make([]*pb.TransferRequest, len(req.Legs)),
+		BestEffort: req.BestEffort,
+	}
+	for i, leg := range req.Legs {
+		// A best-effort batch reports invalid legs in its results instead.
+		if !req.BestEffort {
+			if err := leg.Validate(); err != nil {
+				h.log.Warn("Invalid batch transfer leg", zap.Int("leg", i), zap.Error(err))
+				http.Error(w, (&models.LegError{Index: i, Err: err}).Error(), http.StatusBadRequest)
+				return
+			}
+		}
+		grpcReq.Legs[i] = &pb.TransferRequest{
+			SourceId:      leg.SourceID,
+			DestinationId: leg.DestinationID,
+			Amount:        leg.Amount.String(),
+			Currency:      leg.Currency,
+			Convert:       leg.Convert,
+		}
+	}
+
+	resp, err := h.client.MakeBatchTransfer(r.Context(), grpcReq)
+	if err != nil {
+		st, _ := status.FromError(err)
+		h.log.Error("Batch transfer failed via gRPC",
+			zap.Int("legs", len(req.Legs)),
+			zap.String("grpc_code", st.Code().String()),
+			zap.Error(err),
+		)
+		writeGRPCError(w, st)
+		return
+	}
+
+	h.writeJSON(w, resp)
+}
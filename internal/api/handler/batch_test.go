@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/api/handler/mocks"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+func TestTransactionHandler_MakeBatchTransfer(t *testing.T) {
+	t.Run("Success: Legs Forwarded", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		reqBody := `{"legs": [
+			{"source_account_id": 1, "destination_account_id": 2, "amount": 10},
+			{"source_account_id": 1, "destination_account_id": 3, "amount": 0}
+		], "best_effort": true}`
+		req := httptest.NewRequest("POST", "/transfers/batch", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		mockClient.On("MakeBatchTransfer", mock.Anything, &pb.BatchTransferRequest{
+			Legs: []*pb.TransferRequest{
+				{SourceId: 1, DestinationId: 2, Amount: "10"},
+				{SourceId: 1, DestinationId: 3, Amount: "0"},
+			},
+			BestEffort: true,
+		}).Return(&pb.BatchTransferResponse{
+			Results: []*pb.BatchLegResult{
+				{Index: 0, Transfer: &pb.TransferResponse{AuditId: 10}},
+				{Index: 1, Error: "amount must be positive", ErrorCode: "InvalidArgument"},
+			},
+			Succeeded: 1,
+			Failed:    1,
+		}, nil)
+
+		h.MakeBatchTransfer(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"failed":1`)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Atomic Batch With Invalid Leg", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		reqBody := `{"legs": [
+			{"source_account_id": 1, "destination_account_id": 2, "amount": 10},
+			{"source_account_id": 1, "destination_account_id": 1, "amount": 5}
+		]}`
+		req := httptest.NewRequest("POST", "/transfers/batch", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		h.MakeBatchTransfer(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "leg 1:")
+		mockClient.AssertNotCalled(t, "MakeBatchTransfer", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure: Empty Batch", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		req := httptest.NewRequest("POST", "/transfers/batch", bytes.NewBufferString(`{"legs": []}`))
+		rr := httptest.NewRecorder()
+
+		h.MakeBatchTransfer(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure: Leg Fails In Core", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		reqBody := `{"legs": [{"source_account_id": 1, "destination_account_id": 2, "amount": 10}]}`
+		req := httptest.NewRequest("POST", "/transfers/batch", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		mockClient.On("MakeBatchTransfer", mock.Anything, mock.Anything).
+			Return(nil, status.Error(codes.FailedPrecondition, "leg 0: insufficient funds"))
+
+		h.MakeBatchTransfer(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+		assert.Contains(t, rr.Body.String(), "leg 0: insufficient funds")
+	})
+}
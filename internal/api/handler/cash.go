@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+// Deposit serves POST /accounts/{id}/deposit.
+func (h *TransactionHandler) Deposit(w http.ResponseWriter, r *http.Request) {
+	h.moveCash(w, r, h.client.Deposit)
+}
+
+// Withdraw serves POST /accounts/{id}/withdraw.
+func (h *TransactionHandler) Withdraw(w http.ResponseWriter, r *http.Request) {
+	h.moveCash(w, r, h.client.Withdraw)
+}
+
+func (h *TransactionHandler) moveCash(
+	w http.ResponseWriter,
+	r *http.Request,
+	move func(ctx context.Context, in *pb.CashRequest, opts ...grpc.CallOption) (*pb.CashResponse, error),
+) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		http.Error(w, constants.ErrInvalidAccountID.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var req models.CashRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.log.Warn("Failed to decode cash request", zap.Error(err))
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	req.AccountID = id
+	req.IdempotencyKey = r.Header.Get(IdempotencyKeyHeader)
+
+	if err := req.Validate(); err != nil {
+		h.log.Warn("Invalid cash request", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := move(r.Context(), &pb.CashRequest{
+		AccountId:      id,
+		Amount:         req.Amount.String(),
+		Currency:       req.Currency,
+		IdempotencyKey: req.IdempotencyKey,
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		h.log.Error("Cash movement failed via gRPC",
+			zap.Int64("account_id", id),
+			zap.String("grpc_code", st.Code().String()),
+			zap.Error(err),
+		)
+		writeGRPCError(w, st)
+		return
+	}
+
+	h.writeJSON(w, resp)
+}
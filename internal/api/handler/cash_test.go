@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/api/handler/mocks"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+func TestTransactionHandler_Cash(t *testing.T) {
+	t.Run("Success: Deposit Forwarded With Idempotency Key", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		req := withURLParam(httptest.NewRequest("POST", "/accounts/101/deposit", bytes.NewBufferString(`{"amount": 50.25}`)), "id", "101")
+		req.Header.Set(IdempotencyKeyHeader, "dep-1")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("Deposit", mock.Anything, &pb.CashRequest{AccountId: 101, Amount: "50.25", IdempotencyKey: "dep-1"}).
+			Return(&pb.CashResponse{AuditId: 10, AccountId: 101, Kind: "DEPOSIT", NewBalance: "150.25"}, nil)
+
+		h.Deposit(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"new_balance":"150.25"`)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Non-Positive Amount", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		req := withURLParam(httptest.NewRequest("POST", "/accounts/101/withdraw", bytes.NewBufferString(`{"amount": 0}`)), "id", "101")
+		rr := httptest.NewRecorder()
+
+		h.Withdraw(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockClient.AssertNotCalled(t, "Withdraw")
+	})
+
+	t.Run("Failure: Invalid Account ID", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		req := withURLParam(httptest.NewRequest("POST", "/accounts/abc/deposit", bytes.NewBufferString(`{"amount": 5}`)), "id", "abc")
+		rr := httptest.NewRecorder()
+
+		h.Deposit(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure: Withdrawal Insufficient Funds", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		req := withURLParam(httptest.NewRequest("POST", "/accounts/101/withdraw", bytes.NewBufferString(`{"amount": 5000}`)), "id", "101")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("Withdraw", mock.Anything, mock.Anything).
+			Return(nil, status.Error(codes.FailedPrecondition, "insufficient funds"))
+
+		h.Withdraw(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+}
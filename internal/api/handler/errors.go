@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+)
+
+// writeGRPCError translates a Core service status into the matching HTTP response.
+// Reasons attached by Core take precedence over the bare status code.
+func writeGRPCError(w http.ResponseWriter, st *status.Status) {
+	info := errorInfo(st)
+	switch info.GetReason() {
+	case constants.ReasonAccountFrozen:
+		http.Error(w, st.Message(), http.StatusLocked)
+		return
+	case constants.ReasonAccountClosed, constants.ReasonHoldExpired:
+		http.Error(w, st.Message(), http.StatusGone)
+		return
+	case constants.ReasonLimitExceeded:
+		writeLimitError(w, st, info)
+		return
+	case constants.ReasonBalanceNotZero, constants.ReasonHoldsOutstanding, constants.ReasonInvalidStatusTransition, constants.ReasonHoldNotActive,
+		constants.ReasonTransferNotReversible, constants.ReasonScheduleNotActive, constants.ReasonWebhookNotActive:
+		http.Error(w, st.Message(), http.StatusConflict)
+		return
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		http.Error(w, st.Message(), http.StatusNotFound)
+	case codes.FailedPrecondition:
+		http.Error(w, st.Message(), http.StatusUnprocessableEntity)
+	case codes.InvalidArgument:
+		http.Error(w, st.Message(), http.StatusBadRequest)
+	case codes.AlreadyExists:
+		http.Error(w, st.Message(), http.StatusConflict)
+	default:
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
+
+// limitErrorResponse is the body of a 422 for a broken transfer limit, so
+// clients can tell which limit it was without parsing the message.
+type limitErrorResponse struct {
+	Error     string `json:"error"`
+	Reason    string `json:"reason"`
+	Limit     string `json:"limit,omitempty"`
+	Remaining string `json:"remaining,omitempty"`
+}
+
+func writeLimitError(w http.ResponseWriter, st *status.Status, info *errdetails.ErrorInfo) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnprocessableEntity)
+	_ = json.NewEncoder(w).Encode(limitErrorResponse{
+		Error:     st.Message(),
+		Reason:    info.GetReason(),
+		Limit:     info.GetMetadata()["limit"],
+		Remaining: info.GetMetadata()["remaining"],
+	})
+}
+
+// errorInfo returns the ErrorInfo attached by Core, or nil if there is none.
+func errorInfo(st *status.Status) *errdetails.ErrorInfo {
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.GetDomain() == constants.ErrorDomain {
+			return info
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+// LastEventIDHeader is sent by an EventSource reconnecting after a drop.
+const LastEventIDHeader = "Last-Event-ID"
+
+// StreamAccountEvents serves GET /accounts/{id}/events, a Server-Sent Events
+// stream of the transfers posted to the account, bridged from WatchTransfers.
+// Each event id is the transfer_id, so a reconnecting client resumes through
+// Last-Event-ID; a first connection may pass ?since= to replay history.
+func (h *TransactionHandler) StreamAccountEvents(w http.ResponseWriter, r *http.Request) {
+	req, err := parseWatchTransfersRequest(r)
+	if err != nil {
+		h.log.Warn("Invalid account events request", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		h.log.Error("Response writer cannot stream")
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	stream, err := h.client.WatchTransfers(r.Context(), req)
+	if err == nil {
+		err = watchAccepted(stream)
+	}
+	if err != nil {
+		st, _ := status.FromError(err)
+		h.log.Warn("Watch transfers failed via gRPC",
+			zap.Int64("account_id", req.AccountId),
+			zap.String("grpc_code", st.Code().String()),
+			zap.Error(err),
+		)
+		writeGRPCError(w, st)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		t, err := stream.Recv()
+		if err != nil {
+			// The client leaving cancels the stream; anything else ends it
+			// early and the client reconnects from its last event.
+			if r.Context().Err() == nil {
+				h.log.Warn("Account event stream ended", zap.Int64("account_id", req.AccountId), zap.Error(err))
+			}
+			return
+		}
+
+		data, err := json.Marshal(t)
+		if err != nil {
+			h.log.Error("Failed to encode transfer event", zap.Int64("transfer_id", t.TransferId), zap.Error(err))
+			return
+		}
+		if _, err := fmt.Fprintf(w, "id: %d\nevent: transfer\ndata: %s\n\n", t.TransferId, data); err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// watchAccepted waits for Core to accept the watch. Core sends headers once it
+// does; without them the call was rejected and its status surfaces on Recv.
+func watchAccepted(stream pb.TransferService_WatchTransfersClient) error {
+	md, err := stream.Header()
+	if err != nil || md != nil {
+		return err
+	}
+	_, err = stream.Recv()
+	return err
+}
+
+func parseWatchTransfersRequest(r *http.Request) (*pb.WatchTransfersRequest, error) {
+	accountID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || accountID <= 0 {
+		return nil, constants.ErrInvalidAccountID
+	}
+
+	req := &pb.WatchTransfersRequest{AccountId: accountID}
+
+	since := r.Header.Get(LastEventIDHeader)
+	if since == "" {
+		since = r.URL.Query().Get("since")
+	}
+	if since != "" {
+		if req.SinceTransferId, err = strconv.ParseInt(since, 10, 64); err != nil || req.SinceTransferId < 0 {
+			return nil, constants.ErrInvalidTransferID
+		}
+	}
+
+	return req, nil
+}
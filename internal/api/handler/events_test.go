@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/api/handler/mocks"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+func TestTransactionHandler_StreamAccountEvents(t *testing.T) {
+	logger := zap.NewNop()
+
+	t.Run("Success: Transfers Written As Events", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		stream := new(mocks.MockTransferStream)
+		h := NewTransactionHandler(mockClient, logger)
+
+		mockClient.On("WatchTransfers", mock.Anything, &pb.WatchTransfersRequest{AccountId: 100, SinceTransferId: 7}).
+			Return(stream, nil)
+		stream.On("Header").Return(metadata.MD{}, nil)
+		stream.On("Recv").Return(&pb.Transfer{TransferId: 8, Amount: "10"}, nil).Once()
+		stream.On("Recv").Return(&pb.Transfer{TransferId: 9, Amount: "5"}, nil).Once()
+		stream.On("Recv").Return(nil, io.EOF).Once()
+
+		r := withURLParam(httptest.NewRequest(http.MethodGet, "/accounts/100/events", nil), "id", "100")
+		r.Header.Set(LastEventIDHeader, "7")
+		w := httptest.NewRecorder()
+
+		h.StreamAccountEvents(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+		assert.Equal(t,
+			"id: 8\nevent: transfer\ndata: {\"transfer_id\":8,\"amount\":\"10\"}\n\n"+
+				"id: 9\nevent: transfer\ndata: {\"transfer_id\":9,\"amount\":\"5\"}\n\n",
+			w.Body.String())
+		assert.True(t, w.Flushed)
+	})
+
+	t.Run("Success: Since Query Used Without Last-Event-ID", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		stream := new(mocks.MockTransferStream)
+		h := NewTransactionHandler(mockClient, logger)
+
+		mockClient.On("WatchTransfers", mock.Anything, &pb.WatchTransfersRequest{AccountId: 100, SinceTransferId: 3}).
+			Return(stream, nil)
+		stream.On("Header").Return(metadata.MD{}, nil)
+		stream.On("Recv").Return(nil, io.EOF)
+
+		r := withURLParam(httptest.NewRequest(http.MethodGet, "/accounts/100/events?since=3", nil), "id", "100")
+		w := httptest.NewRecorder()
+
+		h.StreamAccountEvents(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Watch Rejected By Core", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		stream := new(mocks.MockTransferStream)
+		h := NewTransactionHandler(mockClient, logger)
+
+		mockClient.On("WatchTransfers", mock.Anything, mock.Anything).Return(stream, nil)
+		stream.On("Header").Return(nil, nil)
+		stream.On("Recv").Return(nil, status.Error(codes.NotFound, "transfer not found"))
+
+		r := withURLParam(httptest.NewRequest(http.MethodGet, "/accounts/100/events?since=7", nil), "id", "100")
+		w := httptest.NewRecorder()
+
+		h.StreamAccountEvents(w, r)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "transfer not found")
+	})
+
+	t.Run("Failure: Invalid Since", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, logger)
+
+		r := withURLParam(httptest.NewRequest(http.MethodGet, "/accounts/100/events?since=abc", nil), "id", "100")
+		w := httptest.NewRecorder()
+
+		h.StreamAccountEvents(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockClient.AssertNotCalled(t, "WatchTransfers", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure: Invalid Account ID", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, logger)
+
+		r := withURLParam(httptest.NewRequest(http.MethodGet, "/accounts/abc/events", nil), "id", "abc")
+		w := httptest.NewRecorder()
+
+		h.StreamAccountEvents(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+// AuthorizeTransfer serves POST /transfers/authorize.
+func (h *TransactionHandler) AuthorizeTransfer(w http.ResponseWriter, r *http.Request) {
+	var req models.AuthorizeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.log.Warn("Failed to decode authorize request", zap.Error(err))
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	if err := req.Validate(time.Now()); err != nil {
+		h.log.Warn("Invalid authorize request", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	grpcReq := &pb.AuthorizeTransferRequest{
+		SourceId:      req.SourceID,
+		DestinationId: req.DestinationID,
+		Amount:        req.Amount.String(),
+		Currency:      req.Currency,
+	}
+	if !req.ExpiresAt.IsZero() {
+		grpcReq.ExpiresAt = req.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+
+	resp, err := h.client.AuthorizeTransfer(r.Context(), grpcReq)
+	if err != nil {
+		st, _ := status.FromError(err)
+		h.log.Error("Authorize failed via gRPC",
+			zap.Int64("source", req.SourceID),
+			zap.String("grpc_code", st.Code().String()),
+			zap.Error(err),
+		)
+		writeGRPCError(w, st)
+		return
+	}
+
+	h.writeJSON(w, resp.Hold)
+}
+
+// CaptureTransfer serves POST /transfers/{id}/capture. An empty body, or one
+// without an amount, captures the full hold.
+func (h *TransactionHandler) CaptureTransfer(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.holdID(w, r)
+	if !ok {
+		return
+	}
+
+	var req models.CaptureRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.log.Warn("Failed to decode capture request", zap.Error(err))
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	grpcReq := &pb.CaptureTransferRequest{HoldId: id}
+	if !req.Amount.IsZero() {
+		grpcReq.Amount = req.Amount.String()
+	}
+
+	resp, err := h.client.CaptureTransfer(r.Context(), grpcReq)
+	if err != nil {
+		st, _ := status.FromError(err)
+		h.log.Error("Capture failed via gRPC",
+			zap.Int64("hold_id", id),
+			zap.String("grpc_code", st.Code().String()),
+			zap.Error(err),
+		)
+		writeGRPCError(w, st)
+		return
+	}
+
+	h.writeJSON(w, resp)
+}
+
+// VoidTransfer serves POST /transfers/{id}/void.
+func (h *TransactionHandler) VoidTransfer(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.holdID(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.client.VoidTransfer(r.Context(), &pb.VoidTransferRequest{HoldId: id})
+	if err != nil {
+		st, _ := status.FromError(err)
+		h.log.Warn("Void failed via gRPC",
+			zap.Int64("hold_id", id),
+			zap.String("grpc_code", st.Code().String()),
+			zap.Error(err),
+		)
+		writeGRPCError(w, st)
+		return
+	}
+
+	h.writeJSON(w, resp.Hold)
+}
+
+func (h *TransactionHandler) holdID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		http.Error(w, constants.ErrInvalidHoldID.Error(), http.StatusBadRequest)
+		return 0, false
+	}
+	return id, true
+}
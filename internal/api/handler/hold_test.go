@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/api/handler/mocks"
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+func TestTransactionHandler_AuthorizeTransfer(t *testing.T) {
+	t.Run("Success: Hold Returned", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		reqBody := `{"source_account_id": 100, "destination_account_id": 200, "amount": 80, "expires_at": "2099-01-01T00:00:00Z"}`
+		req, _ := http.NewRequest("POST", "/transfers/authorize", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		mockClient.On("AuthorizeTransfer", mock.Anything, mock.MatchedBy(func(r *pb.AuthorizeTransferRequest) bool {
+			return r.SourceId == 100 && r.Amount == "80" && r.ExpiresAt == "2099-01-01T00:00:00Z"
+		})).Return(&pb.HoldResponse{Hold: &pb.Hold{HoldId: 7, Status: "ACTIVE"}}, nil)
+
+		h.AuthorizeTransfer(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"hold_id":7`)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Expiry In The Past", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		reqBody := `{"source_account_id": 100, "destination_account_id": 200, "amount": 80, "expires_at": "2000-01-01T00:00:00Z"}`
+		req, _ := http.NewRequest("POST", "/transfers/authorize", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		h.AuthorizeTransfer(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockClient.AssertNotCalled(t, "AuthorizeTransfer", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTransactionHandler_CaptureTransfer(t *testing.T) {
+	t.Run("Success: Empty Body Captures Full Hold", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		req := withURLParam(httptest.NewRequest("POST", "/transfers/7/capture", nil), "id", "7")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("CaptureTransfer", mock.Anything, mock.MatchedBy(func(r *pb.CaptureTransferRequest) bool {
+			return r.HoldId == 7 && r.Amount == ""
+		})).Return(&pb.TransferResponse{Success: true, AuditId: 7}, nil)
+
+		h.CaptureTransfer(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Success: Partial Amount Forwarded", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		req := withURLParam(httptest.NewRequest("POST", "/transfers/7/capture", bytes.NewBufferString(`{"amount": 50.5}`)), "id", "7")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("CaptureTransfer", mock.Anything, mock.MatchedBy(func(r *pb.CaptureTransferRequest) bool {
+			return r.Amount == "50.5"
+		})).Return(&pb.TransferResponse{Success: true, AuditId: 7}, nil)
+
+		h.CaptureTransfer(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Expired Hold Returns 410", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		req := withURLParam(httptest.NewRequest("POST", "/transfers/7/capture", nil), "id", "7")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("CaptureTransfer", mock.Anything, mock.Anything).
+			Return(nil, reasonError(codes.FailedPrecondition, "hold has expired", constants.ReasonHoldExpired))
+
+		h.CaptureTransfer(rr, req)
+
+		assert.Equal(t, http.StatusGone, rr.Code)
+	})
+
+	t.Run("Failure: Invalid Hold ID", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		req := withURLParam(httptest.NewRequest("POST", "/transfers/abc/capture", nil), "id", "abc")
+		rr := httptest.NewRecorder()
+
+		h.CaptureTransfer(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestTransactionHandler_VoidTransfer(t *testing.T) {
+	t.Run("Success: Hold Voided", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		req := withURLParam(httptest.NewRequest("POST", "/transfers/7/void", nil), "id", "7")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("VoidTransfer", mock.Anything, &pb.VoidTransferRequest{HoldId: 7}).
+			Return(&pb.HoldResponse{Hold: &pb.Hold{HoldId: 7, Status: "VOIDED"}}, nil)
+
+		h.VoidTransfer(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "VOIDED")
+	})
+
+	t.Run("Failure: Hold No Longer Active Returns 409", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		req := withURLParam(httptest.NewRequest("POST", "/transfers/7/void", nil), "id", "7")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("VoidTransfer", mock.Anything, mock.Anything).
+			Return(nil, reasonError(codes.FailedPrecondition, "hold is no longer active", constants.ReasonHoldNotActive))
+
+		h.VoidTransfer(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+// SetAccountLimits serves PUT /accounts/{id}/limits, replacing the limits set
+// for the account. Limits left out fall back to the defaults.
+func (h *AccountHandler) SetAccountLimits(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.accountIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	var req models.TransferLimits
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.log.Warn("Failed to decode JSON", zap.Error(err))
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.client.SetAccountLimits(r.Context(), &pb.SetAccountLimitsRequest{
+		AccountId:     id,
+		MaxSingle:     limitAmount(req.MaxSingle),
+		DailyAmount:   limitAmount(req.DailyAmount),
+		DailyCount:    req.DailyCount,
+		MonthlyAmount: limitAmount(req.MonthlyAmount),
+		MonthlyCount:  req.MonthlyCount,
+	})
+	h.writeLimits(w, id, resp, err)
+}
+
+// GetAccountLimits serves GET /accounts/{id}/limits, the limits set for the
+// account itself.
+func (h *AccountHandler) GetAccountLimits(w http.ResponseWriter, r *http.Request) {
+	h.accountLimits(w, r, h.client.GetAccountLimits)
+}
+
+// ClearAccountLimits serves DELETE /accounts/{id}/limits, putting the account
+// back on the defaults.
+func (h *AccountHandler) ClearAccountLimits(w http.ResponseWriter, r *http.Request) {
+	h.accountLimits(w, r, h.client.ClearAccountLimits)
+}
+
+type accountLimitsFunc func(ctx context.Context, in *pb.AccountLimitsRequest, opts ...grpc.CallOption) (*pb.AccountLimitsResponse, error)
+
+func (h *AccountHandler) accountLimits(w http.ResponseWriter, r *http.Request, call accountLimitsFunc) {
+	id, ok := h.accountIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := call(r.Context(), &pb.AccountLimitsRequest{AccountId: id})
+	h.writeLimits(w, id, resp, err)
+}
+
+func (h *AccountHandler) writeLimits(w http.ResponseWriter, id int64, resp *pb.AccountLimitsResponse, err error) {
+	if err != nil {
+		st, _ := status.FromError(err)
+		if st.Code() == codes.Internal || st.Code() == codes.Unknown {
+			h.log.Error("gRPC call failed", zap.Int64("account_id", id), zap.Error(err))
+		}
+		writeGRPCError(w, st)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.log.Error("Failed to write response", zap.Error(err))
+	}
+}
+
+func (h *AccountHandler) accountIDParam(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		h.log.Warn("Invalid account id in path", zap.String("id", chi.URLParam(r, "id")))
+		http.Error(w, constants.ErrInvalidAccountID.Error(), http.StatusBadRequest)
+		return 0, false
+	}
+	return id, true
+}
+
+// limitAmount sends an unset amount as empty, which Core reads as no limit.
+func limitAmount(d decimal.NullDecimal) string {
+	if !d.Valid {
+		return ""
+	}
+	return d.Decimal.String()
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/api/handler/mocks"
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+func TestAccountHandler_Limits(t *testing.T) {
+	t.Run("Success: Limits Set", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req, _ := http.NewRequest("PUT", "/accounts/101/limits", bytes.NewBufferString(`{"daily_amount": "5000.00", "daily_count": 20}`))
+		req = withURLParam(req, "id", "101")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("SetAccountLimits", mock.Anything, &pb.SetAccountLimitsRequest{AccountId: 101, DailyAmount: "5000", DailyCount: 20}).
+			Return(&pb.AccountLimitsResponse{AccountId: 101, DailyAmount: "5000", DailyCount: 20}, nil)
+
+		h.SetAccountLimits(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"account_id": 101, "daily_amount": "5000", "daily_count": 20}`, rr.Body.String())
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Invalid JSON", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req, _ := http.NewRequest("PUT", "/accounts/101/limits", bytes.NewBufferString(`{"max_single": "lots"}`))
+		req = withURLParam(req, "id", "101")
+		rr := httptest.NewRecorder()
+
+		h.SetAccountLimits(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockClient.AssertNotCalled(t, "SetAccountLimits")
+	})
+
+	t.Run("Failure: Invalid Limit", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req, _ := http.NewRequest("PUT", "/accounts/101/limits", bytes.NewBufferString(`{"max_single": 0}`))
+		req = withURLParam(req, "id", "101")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("SetAccountLimits", mock.Anything, mock.Anything).
+			Return(nil, status.Error(codes.InvalidArgument, constants.ErrInvalidLimit.Error()))
+
+		h.SetAccountLimits(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Success: Limits Read", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req, _ := http.NewRequest("GET", "/accounts/101/limits", nil)
+		req = withURLParam(req, "id", "101")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("GetAccountLimits", mock.Anything, &pb.AccountLimitsRequest{AccountId: 101}).
+			Return(&pb.AccountLimitsResponse{AccountId: 101, MaxSingle: "250"}, nil)
+
+		h.GetAccountLimits(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"max_single":"250"`)
+	})
+
+	t.Run("Failure: Account Not Found", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req, _ := http.NewRequest("DELETE", "/accounts/404/limits", nil)
+		req = withURLParam(req, "id", "404")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("ClearAccountLimits", mock.Anything, &pb.AccountLimitsRequest{AccountId: 404}).
+			Return(nil, status.Error(codes.NotFound, "account not found"))
+
+		h.ClearAccountLimits(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Failure: Invalid Account ID", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req, _ := http.NewRequest("GET", "/accounts/abc/limits", nil)
+		req = withURLParam(req, "id", "abc")
+		rr := httptest.NewRecorder()
+
+		h.GetAccountLimits(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockClient.AssertNotCalled(t, "GetAccountLimits")
+	})
+}
@@ -19,3 +19,83 @@ func (m *MockAccountServiceClient) CreateAccount(ctx context.Context, in *pb.Cre
 	}
 	return args.Get(0).(*pb.CreateAccountResponse), args.Error(1)
 }
+
+func (m *MockAccountServiceClient) GetAccount(ctx context.Context, in *pb.GetAccountRequest, opts ...grpc.CallOption) (*pb.GetAccountResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.GetAccountResponse), args.Error(1)
+}
+
+func (m *MockAccountServiceClient) FreezeAccount(ctx context.Context, in *pb.AccountStatusRequest, opts ...grpc.CallOption) (*pb.AccountStatusResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.AccountStatusResponse), args.Error(1)
+}
+
+func (m *MockAccountServiceClient) UnfreezeAccount(ctx context.Context, in *pb.AccountStatusRequest, opts ...grpc.CallOption) (*pb.AccountStatusResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.AccountStatusResponse), args.Error(1)
+}
+
+func (m *MockAccountServiceClient) CloseAccount(ctx context.Context, in *pb.AccountStatusRequest, opts ...grpc.CallOption) (*pb.AccountStatusResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.AccountStatusResponse), args.Error(1)
+}
+
+func (m *MockAccountServiceClient) UpdateAccount(ctx context.Context, in *pb.UpdateAccountRequest, opts ...grpc.CallOption) (*pb.GetAccountResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.GetAccountResponse), args.Error(1)
+}
+
+func (m *MockAccountServiceClient) GetBalanceAt(ctx context.Context, in *pb.GetBalanceAtRequest, opts ...grpc.CallOption) (*pb.GetBalanceAtResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.GetBalanceAtResponse), args.Error(1)
+}
+
+func (m *MockAccountServiceClient) GetStatement(ctx context.Context, in *pb.GetStatementRequest, opts ...grpc.CallOption) (*pb.GetStatementResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.GetStatementResponse), args.Error(1)
+}
+
+func (m *MockAccountServiceClient) SetAccountLimits(ctx context.Context, in *pb.SetAccountLimitsRequest, opts ...grpc.CallOption) (*pb.AccountLimitsResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.AccountLimitsResponse), args.Error(1)
+}
+
+func (m *MockAccountServiceClient) GetAccountLimits(ctx context.Context, in *pb.AccountLimitsRequest, opts ...grpc.CallOption) (*pb.AccountLimitsResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.AccountLimitsResponse), args.Error(1)
+}
+
+func (m *MockAccountServiceClient) ClearAccountLimits(ctx context.Context, in *pb.AccountLimitsRequest, opts ...grpc.CallOption) (*pb.AccountLimitsResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.AccountLimitsResponse), args.Error(1)
+}
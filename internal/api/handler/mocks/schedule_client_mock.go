@@ -0,0 +1,38 @@
+package mocks
+
+import (
+	"context"
+
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+)
+
+type MockScheduleServiceClient struct {
+	mock.Mock
+}
+
+func (m *MockScheduleServiceClient) ScheduleTransfer(ctx context.Context, in *pb.ScheduleTransferRequest, opts ...grpc.CallOption) (*pb.ScheduledTransferResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.ScheduledTransferResponse), args.Error(1)
+}
+
+func (m *MockScheduleServiceClient) CancelScheduledTransfer(ctx context.Context, in *pb.CancelScheduledTransferRequest, opts ...grpc.CallOption) (*pb.ScheduledTransferResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.ScheduledTransferResponse), args.Error(1)
+}
+
+func (m *MockScheduleServiceClient) ListScheduledTransfers(ctx context.Context, in *pb.ListScheduledTransfersRequest, opts ...grpc.CallOption) (*pb.ListScheduledTransfersResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.ListScheduledTransfersResponse), args.Error(1)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 type MockTransferServiceClient struct {
@@ -19,3 +20,112 @@ func (m *MockTransferServiceClient) MakeTransfer(ctx context.Context, in *pb.Tra
 	}
 	return args.Get(0).(*pb.TransferResponse), args.Error(1)
 }
+
+func (m *MockTransferServiceClient) GetTransfer(ctx context.Context, in *pb.GetTransferRequest, opts ...grpc.CallOption) (*pb.GetTransferResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.GetTransferResponse), args.Error(1)
+}
+
+func (m *MockTransferServiceClient) ListTransfers(ctx context.Context, in *pb.ListTransfersRequest, opts ...grpc.CallOption) (*pb.ListTransfersResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.ListTransfersResponse), args.Error(1)
+}
+
+func (m *MockTransferServiceClient) AuthorizeTransfer(ctx context.Context, in *pb.AuthorizeTransferRequest, opts ...grpc.CallOption) (*pb.HoldResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.HoldResponse), args.Error(1)
+}
+
+func (m *MockTransferServiceClient) CaptureTransfer(ctx context.Context, in *pb.CaptureTransferRequest, opts ...grpc.CallOption) (*pb.TransferResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.TransferResponse), args.Error(1)
+}
+
+func (m *MockTransferServiceClient) VoidTransfer(ctx context.Context, in *pb.VoidTransferRequest, opts ...grpc.CallOption) (*pb.HoldResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.HoldResponse), args.Error(1)
+}
+
+func (m *MockTransferServiceClient) ReverseTransfer(ctx context.Context, in *pb.ReverseTransferRequest, opts ...grpc.CallOption) (*pb.ReverseTransferResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.ReverseTransferResponse), args.Error(1)
+}
+
+func (m *MockTransferServiceClient) MakeBatchTransfer(ctx context.Context, in *pb.BatchTransferRequest, opts ...grpc.CallOption) (*pb.BatchTransferResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.BatchTransferResponse), args.Error(1)
+}
+
+func (m *MockTransferServiceClient) MakeMultiLegTransfer(ctx context.Context, in *pb.MultiLegTransferRequest, opts ...grpc.CallOption) (*pb.MultiLegTransferResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.MultiLegTransferResponse), args.Error(1)
+}
+
+func (m *MockTransferServiceClient) Deposit(ctx context.Context, in *pb.CashRequest, opts ...grpc.CallOption) (*pb.CashResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.CashResponse), args.Error(1)
+}
+
+func (m *MockTransferServiceClient) Withdraw(ctx context.Context, in *pb.CashRequest, opts ...grpc.CallOption) (*pb.CashResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.CashResponse), args.Error(1)
+}
+
+func (m *MockTransferServiceClient) WatchTransfers(ctx context.Context, in *pb.WatchTransfersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[pb.Transfer], error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(grpc.ServerStreamingClient[pb.Transfer]), args.Error(1)
+}
+
+// MockTransferStream is the client side of a WatchTransfers stream. Only
+// Header and Recv are mocked; the embedded ClientStream is left nil.
+type MockTransferStream struct {
+	mock.Mock
+	grpc.ClientStream
+}
+
+func (m *MockTransferStream) Header() (metadata.MD, error) {
+	args := m.Called()
+	md, _ := args.Get(0).(metadata.MD)
+	return md, args.Error(1)
+}
+
+func (m *MockTransferStream) Recv() (*pb.Transfer, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.Transfer), args.Error(1)
+}
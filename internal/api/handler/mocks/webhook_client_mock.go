@@ -0,0 +1,62 @@
+package mocks
+
+import (
+	"context"
+
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+)
+
+type MockWebhookServiceClient struct {
+	mock.Mock
+}
+
+func (m *MockWebhookServiceClient) CreateWebhook(ctx context.Context, in *pb.CreateWebhookRequest, opts ...grpc.CallOption) (*pb.WebhookResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.WebhookResponse), args.Error(1)
+}
+
+func (m *MockWebhookServiceClient) GetWebhook(ctx context.Context, in *pb.GetWebhookRequest, opts ...grpc.CallOption) (*pb.WebhookResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.WebhookResponse), args.Error(1)
+}
+
+func (m *MockWebhookServiceClient) ListWebhooks(ctx context.Context, in *pb.ListWebhooksRequest, opts ...grpc.CallOption) (*pb.ListWebhooksResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.ListWebhooksResponse), args.Error(1)
+}
+
+func (m *MockWebhookServiceClient) DeleteWebhook(ctx context.Context, in *pb.DeleteWebhookRequest, opts ...grpc.CallOption) (*pb.WebhookResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.WebhookResponse), args.Error(1)
+}
+
+func (m *MockWebhookServiceClient) ListDeadLetters(ctx context.Context, in *pb.ListDeadLettersRequest, opts ...grpc.CallOption) (*pb.ListDeadLettersResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.ListDeadLettersResponse), args.Error(1)
+}
+
+func (m *MockWebhookServiceClient) RedeliverDeadLetter(ctx context.Context, in *pb.RedeliverDeadLetterRequest, opts ...grpc.CallOption) (*pb.RedeliverDeadLetterResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.RedeliverDeadLetterResponse), args.Error(1)
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+// MakeMultiLegTransfer serves POST /transfers/multi-leg.
+func (h *TransactionHandler) MakeMultiLegTransfer(w http.ResponseWriter, r *http.Request) {
+	var req models.MultiLegTransferRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.log.Warn("Failed to decode multi-leg transfer request", zap.Error(err))
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.log.Warn("Invalid multi-leg transfer request", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.client.MakeMultiLegTransfer(r.Context(), &pb.MultiLegTransferRequest{
+		Debits:   toPbLegs(req.Debits),
+		Credits:  toPbLegs(req.Credits),
+		Currency: req.Currency,
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		h.log.Error("Multi-leg transfer failed via gRPC",
+			zap.String("grpc_code", st.Code().String()),
+			zap.Error(err),
+		)
+		writeGRPCError(w, st)
+		return
+	}
+
+	h.writeJSON(w, resp)
+}
+
+func toPbLegs(legs []models.Leg) []*pb.TransferLeg {
+	out := make([]*pb.TransferLeg, len(legs))
+	for i, leg := range legs {
+		out[i] = &pb.TransferLeg{AccountId: leg.AccountID, Amount: leg.Amount.String()}
+	}
+	return out
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/api/handler/mocks"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+func TestTransactionHandler_MakeMultiLegTransfer(t *testing.T) {
+	const reqBody = `{
+		"debits": [{"account_id": 1, "amount": 100}],
+		"credits": [{"account_id": 2, "amount": 95}, {"account_id": 3, "amount": 5}],
+		"currency": "USD"
+	}`
+
+	t.Run("Success: Legs Forwarded", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		req := httptest.NewRequest("POST", "/transfers/multi-leg", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		mockClient.On("MakeMultiLegTransfer", mock.Anything, &pb.MultiLegTransferRequest{
+			Debits:   []*pb.TransferLeg{{AccountId: 1, Amount: "100"}},
+			Credits:  []*pb.TransferLeg{{AccountId: 2, Amount: "95"}, {AccountId: 3, Amount: "5"}},
+			Currency: "USD",
+		}).Return(&pb.MultiLegTransferResponse{GroupId: 5}, nil)
+
+		h.MakeMultiLegTransfer(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"group_id":5`)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Unbalanced Legs", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		body := `{"debits": [{"account_id": 1, "amount": 100}], "credits": [{"account_id": 2, "amount": 90}]}`
+		req := httptest.NewRequest("POST", "/transfers/multi-leg", bytes.NewBufferString(body))
+		rr := httptest.NewRecorder()
+
+		h.MakeMultiLegTransfer(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockClient.AssertNotCalled(t, "MakeMultiLegTransfer", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure: Insufficient Funds", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		req := httptest.NewRequest("POST", "/transfers/multi-leg", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		mockClient.On("MakeMultiLegTransfer", mock.Anything, mock.Anything).
+			Return(nil, status.Error(codes.FailedPrecondition, "insufficient funds"))
+
+		h.MakeMultiLegTransfer(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+}
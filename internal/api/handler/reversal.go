@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+// ReverseTransfer serves POST /transfers/{id}/reverse. Without an amount the
+// whole remaining reversible amount is refunded. A retry with the same
+// Idempotency-Key returns the reversal already posted.
+func (h *TransactionHandler) ReverseTransfer(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		http.Error(w, constants.ErrInvalidTransferID.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var req models.ReversalRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.log.Warn("Failed to decode reversal request", zap.Error(err))
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	req.TransferID = id
+	req.IdempotencyKey = r.Header.Get(IdempotencyKeyHeader)
+
+	if err := req.Validate(); err != nil {
+		h.log.Warn("Invalid reversal request", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	grpcReq := &pb.ReverseTransferRequest{TransferId: id, Reason: req.Reason, IdempotencyKey: req.IdempotencyKey}
+	if !req.Amount.IsZero() {
+		grpcReq.Amount = req.Amount.String()
+	}
+
+	resp, err := h.client.ReverseTransfer(r.Context(), grpcReq)
+	if err != nil {
+		st, _ := status.FromError(err)
+		h.log.Error("Reversal failed via gRPC",
+			zap.Int64("transfer_id", id),
+			zap.String("grpc_code", st.Code().String()),
+			zap.Error(err),
+		)
+		writeGRPCError(w, st)
+		return
+	}
+
+	h.writeJSON(w, resp)
+}
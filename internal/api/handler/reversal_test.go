@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/api/handler/mocks"
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+func TestTransactionHandler_ReverseTransfer(t *testing.T) {
+	t.Run("Success: Partial Reversal Forwarded", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		reqBody := `{"amount": 4, "reason": "duplicate charge"}`
+		req := withURLParam(httptest.NewRequest("POST", "/transfers/7/reverse", bytes.NewBufferString(reqBody)), "id", "7")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("ReverseTransfer", mock.Anything, &pb.ReverseTransferRequest{
+			TransferId: 7, Amount: "4", Reason: "duplicate charge",
+		}).Return(&pb.ReverseTransferResponse{
+			Reversal: &pb.Transfer{TransferId: 8, ReversalOf: 7},
+			Original: &pb.Transfer{TransferId: 7, Status: "PARTIALLY_REVERSED"},
+		}, nil)
+
+		h.ReverseTransfer(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"status":"PARTIALLY_REVERSED"`)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Success: Idempotency-Key Forwarded", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		req := withURLParam(httptest.NewRequest("POST", "/transfers/7/reverse", bytes.NewBufferString(`{"reason": "refund"}`)), "id", "7")
+		req.Header.Set(IdempotencyKeyHeader, "refund-7")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("ReverseTransfer", mock.Anything, &pb.ReverseTransferRequest{TransferId: 7, Reason: "refund", IdempotencyKey: "refund-7"}).
+			Return(&pb.ReverseTransferResponse{}, nil)
+
+		h.ReverseTransfer(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Idempotency Key Too Long", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		req := withURLParam(httptest.NewRequest("POST", "/transfers/7/reverse", bytes.NewBufferString(`{"reason": "refund"}`)), "id", "7")
+		req.Header.Set(IdempotencyKeyHeader, strings.Repeat("k", 256))
+		rr := httptest.NewRecorder()
+
+		h.ReverseTransfer(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockClient.AssertNotCalled(t, "ReverseTransfer")
+	})
+
+	t.Run("Success: No Amount Reverses Remainder", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		req := withURLParam(httptest.NewRequest("POST", "/transfers/7/reverse", bytes.NewBufferString(`{"reason": "refund"}`)), "id", "7")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("ReverseTransfer", mock.Anything, &pb.ReverseTransferRequest{TransferId: 7, Reason: "refund"}).
+			Return(&pb.ReverseTransferResponse{}, nil)
+
+		h.ReverseTransfer(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Missing Reason", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		req := withURLParam(httptest.NewRequest("POST", "/transfers/7/reverse", bytes.NewBufferString(`{"amount": 4}`)), "id", "7")
+		rr := httptest.NewRecorder()
+
+		h.ReverseTransfer(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockClient.AssertNotCalled(t, "ReverseTransfer", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure: Invalid Transfer ID", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		req := withURLParam(httptest.NewRequest("POST", "/transfers/abc/reverse", bytes.NewBufferString(`{"reason": "x"}`)), "id", "abc")
+		rr := httptest.NewRecorder()
+
+		h.ReverseTransfer(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure: Already Reversed Maps To 409", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		req := withURLParam(httptest.NewRequest("POST", "/transfers/7/reverse", bytes.NewBufferString(`{"reason": "x"}`)), "id", "7")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("ReverseTransfer", mock.Anything, mock.Anything).
+			Return(nil, reasonError(codes.FailedPrecondition, "transfer cannot be reversed", constants.ReasonTransferNotReversible))
+
+		h.ReverseTransfer(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("Failure: Insufficient Funds Maps To 422", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		req := withURLParam(httptest.NewRequest("POST", "/transfers/7/reverse", bytes.NewBufferString(`{"reason": "x"}`)), "id", "7")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("ReverseTransfer", mock.Anything, mock.Anything).
+			Return(nil, status.Error(codes.FailedPrecondition, "insufficient funds"))
+
+		h.ReverseTransfer(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+	t.Run("Failure: Refund Exceeds Remaining Maps To 400", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		req := withURLParam(httptest.NewRequest("POST", "/transfers/7/reverse", bytes.NewBufferString(`{"amount": 50, "reason": "x"}`)), "id", "7")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("ReverseTransfer", mock.Anything, mock.Anything).
+			Return(nil, status.Error(codes.InvalidArgument, constants.ErrReversalTooLarge.Error()))
+
+		h.ReverseTransfer(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), constants.ErrReversalTooLarge.Error())
+	})
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+type ScheduleHandler struct {
+	client pb.ScheduleServiceClient
+	log    *zap.Logger
+}
+
+func NewScheduleHandler(client pb.ScheduleServiceClient, log *zap.Logger) *ScheduleHandler {
+	return &ScheduleHandler{client: client, log: log}
+}
+
+// ScheduleTransfer serves POST /schedules.
+func (h *ScheduleHandler) ScheduleTransfer(w http.ResponseWriter, r *http.Request) {
+	var req models.ScheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.log.Warn("Failed to decode schedule request", zap.Error(err))
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	if _, err := req.FirstRun(time.Now()); err != nil {
+		h.log.Warn("Invalid schedule request", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	grpcReq := &pb.ScheduleTransferRequest{
+		SourceId:      req.SourceID,
+		DestinationId: req.DestinationID,
+		Amount:        req.Amount.String(),
+		Currency:      req.Currency,
+		Convert:       req.Convert,
+		Schedule:      req.Schedule,
+	}
+	if !req.StartAt.IsZero() {
+		grpcReq.StartAt = req.StartAt.UTC().Format(time.RFC3339)
+	}
+
+	resp, err := h.client.ScheduleTransfer(r.Context(), grpcReq)
+	if err != nil {
+		h.fail(w, err, "Scheduling transfer failed via gRPC", zap.Int64("source", req.SourceID))
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, resp.ScheduledTransfer)
+}
+
+// CancelScheduledTransfer serves POST /schedules/{id}/cancel.
+func (h *ScheduleHandler) CancelScheduledTransfer(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		http.Error(w, constants.ErrInvalidScheduleID.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.client.CancelScheduledTransfer(r.Context(), &pb.CancelScheduledTransferRequest{ScheduleId: id})
+	if err != nil {
+		h.fail(w, err, "Cancelling scheduled transfer failed via gRPC", zap.Int64("schedule_id", id))
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, resp.ScheduledTransfer)
+}
+
+// ListScheduledTransfers serves GET /accounts/{id}/schedules.
+func (h *ScheduleHandler) ListScheduledTransfers(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		http.Error(w, constants.ErrInvalidAccountID.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.client.ListScheduledTransfers(r.Context(), &pb.ListScheduledTransfersRequest{AccountId: id})
+	if err != nil {
+		h.fail(w, err, "Listing scheduled transfers failed via gRPC", zap.Int64("account_id", id))
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *ScheduleHandler) fail(w http.ResponseWriter, err error, msg string, fields ...zap.Field) {
+	st, _ := status.FromError(err)
+	if st.Code() == codes.Internal || st.Code() == codes.Unknown {
+		h.log.Error(msg, append(fields, zap.Error(err))...)
+	}
+	writeGRPCError(w, st)
+}
+
+func (h *ScheduleHandler) writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		h.log.Error("Failed to write response", zap.Error(err))
+	}
+}
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/api/handler/mocks"
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+func TestScheduleHandler_ScheduleTransfer(t *testing.T) {
+	t.Run("Success: Created", func(t *testing.T) {
+		mockClient := new(mocks.MockScheduleServiceClient)
+		h := NewScheduleHandler(mockClient, zap.NewNop())
+
+		reqBody := `{"source_account_id": 1, "destination_account_id": 2, "amount": 25, "schedule": "0 9 1 * *"}`
+		req := httptest.NewRequest("POST", "/schedules", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		mockClient.On("ScheduleTransfer", mock.Anything, &pb.ScheduleTransferRequest{
+			SourceId: 1, DestinationId: 2, Amount: "25", Schedule: "0 9 1 * *",
+		}).Return(&pb.ScheduledTransferResponse{ScheduledTransfer: &pb.ScheduledTransfer{ScheduleId: 7}}, nil)
+
+		h.ScheduleTransfer(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"schedule_id":7`)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Start Time In The Past", func(t *testing.T) {
+		mockClient := new(mocks.MockScheduleServiceClient)
+		h := NewScheduleHandler(mockClient, zap.NewNop())
+
+		past := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+		reqBody := `{"source_account_id": 1, "destination_account_id": 2, "amount": 25, "start_at": "` + past + `"}`
+		req := httptest.NewRequest("POST", "/schedules", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		h.ScheduleTransfer(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockClient.AssertNotCalled(t, "ScheduleTransfer", mock.Anything, mock.Anything)
+	})
+}
+
+func TestScheduleHandler_CancelScheduledTransfer(t *testing.T) {
+	t.Run("Failure: Invalid ID", func(t *testing.T) {
+		h := NewScheduleHandler(new(mocks.MockScheduleServiceClient), zap.NewNop())
+
+		req := withURLParam(httptest.NewRequest("POST", "/schedules/abc/cancel", nil), "id", "abc")
+		rr := httptest.NewRecorder()
+
+		h.CancelScheduledTransfer(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure: Already Completed", func(t *testing.T) {
+		mockClient := new(mocks.MockScheduleServiceClient)
+		h := NewScheduleHandler(mockClient, zap.NewNop())
+
+		mockClient.On("CancelScheduledTransfer", mock.Anything, &pb.CancelScheduledTransferRequest{ScheduleId: 7}).
+			Return(nil, reasonError(codes.FailedPrecondition, constants.ErrScheduleNotActive.Error(), constants.ReasonScheduleNotActive))
+
+		req := withURLParam(httptest.NewRequest("POST", "/schedules/7/cancel", nil), "id", "7")
+		rr := httptest.NewRecorder()
+
+		h.CancelScheduledTransfer(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func TestScheduleHandler_ListScheduledTransfers(t *testing.T) {
+	mockClient := new(mocks.MockScheduleServiceClient)
+	h := NewScheduleHandler(mockClient, zap.NewNop())
+
+	mockClient.On("ListScheduledTransfers", mock.Anything, &pb.ListScheduledTransfersRequest{AccountId: 1}).
+		Return(&pb.ListScheduledTransfersResponse{ScheduledTransfers: []*pb.ScheduledTransfer{{ScheduleId: 7}}}, nil)
+
+	req := withURLParam(httptest.NewRequest("GET", "/accounts/1/schedules", nil), "id", "1")
+	rr := httptest.NewRecorder()
+
+	h.ListScheduledTransfers(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"schedule_id":7`)
+}
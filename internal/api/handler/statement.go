@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+// GetStatement serves GET /accounts/{id}/statement?from=&to=&format=, the
+// account's statement between two RFC 3339 times as json (default), csv or mt940.
+func (h *AccountHandler) GetStatement(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		h.log.Warn("Invalid account id in path", zap.String("id", chi.URLParam(r, "id")))
+		http.Error(w, constants.ErrInvalidAccountID.Error(), http.StatusBadRequest)
+		return
+	}
+
+	query := r.URL.Query()
+	format, err := models.ParseStatementFormat(query.Get("format"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.GetStatementRequest{AccountId: id, From: query.Get("from"), To: query.Get("to")}
+	for _, v := range []string{req.From, req.To} {
+		if _, err := time.Parse(time.RFC3339, v); err != nil {
+			http.Error(w, constants.ErrInvalidTimestamp.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	resp, err := h.client.GetStatement(r.Context(), req)
+	if err != nil {
+		st, _ := status.FromError(err)
+		if st.Code() == codes.Internal || st.Code() == codes.Unknown {
+			h.log.Error("gRPC call failed", zap.Int64("account_id", id), zap.Error(err))
+		}
+		writeGRPCError(w, st)
+		return
+	}
+
+	statement, err := toStatement(resp)
+	if err != nil {
+		h.log.Error("Malformed statement from core", zap.Int64("account_id", id), zap.Error(err))
+		http.Error(w, "internal system error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	w.WriteHeader(http.StatusOK)
+
+	if err := statement.Write(w, format); err != nil {
+		h.log.Error("Failed to write response", zap.Error(err))
+	}
+}
+
+// toStatement rebuilds the statement from the gRPC response so every format is
+// rendered by the same code the CLI uses.
+func toStatement(resp *pb.GetStatementResponse) (*models.Statement, error) {
+	st := &models.Statement{AccountID: resp.AccountId, Currency: resp.Currency}
+
+	var err error
+	if st.From, err = time.Parse(time.RFC3339Nano, resp.From); err != nil {
+		return nil, fmt.Errorf("from: %w", err)
+	}
+	if st.To, err = time.Parse(time.RFC3339Nano, resp.To); err != nil {
+		return nil, fmt.Errorf("to: %w", err)
+	}
+	if st.OpeningBalance, err = decimal.NewFromString(resp.OpeningBalance); err != nil {
+		return nil, fmt.Errorf("opening_balance: %w", err)
+	}
+	st.ClosingBalance = st.OpeningBalance
+
+	for _, l := range resp.Lines {
+		line := models.StatementLine{TransferID: l.TransferId, Type: l.Type, CounterpartyID: l.CounterpartyId}
+		if line.PostedAt, err = time.Parse(time.RFC3339Nano, l.PostedAt); err != nil {
+			return nil, fmt.Errorf("line %d posted_at: %w", l.TransferId, err)
+		}
+		if line.Balance, err = decimal.NewFromString(l.Balance); err != nil {
+			return nil, fmt.Errorf("line %d balance: %w", l.TransferId, err)
+		}
+		if l.Debit != "" {
+			line.Debit, err = decimal.NewFromString(l.Debit)
+		} else {
+			line.Credit, err = decimal.NewFromString(l.Credit)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d amount: %w", l.TransferId, err)
+		}
+		st.Add(line)
+	}
+
+	return st, nil
+}
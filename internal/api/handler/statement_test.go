@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/api/handler/mocks"
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+func TestAccountHandler_GetStatement(t *testing.T) {
+	const target = "/accounts/101/statement?from=2026-09-01T00:00:00Z&to=2026-10-01T00:00:00Z"
+	statement := &pb.GetStatementResponse{
+		AccountId: 101, Currency: "USD", From: "2026-09-01T00:00:00Z", To: "2026-10-01T00:00:00Z",
+		OpeningBalance: "300", ClosingBalance: "349", TotalDebits: "51", TotalCredits: "100",
+		Lines: []*pb.StatementLine{
+			{TransferId: 10, PostedAt: "2026-09-01T01:00:00Z", Type: "TRANSFER", CounterpartyId: 202, Debit: "50", Balance: "250"},
+			{TransferId: 11, PostedAt: "2026-09-01T01:00:00Z", Type: "FEE", CounterpartyId: 900, Debit: "1", Balance: "249"},
+			{TransferId: 12, PostedAt: "2026-09-02T09:30:00Z", Type: "DEPOSIT", CounterpartyId: 800, Credit: "100", Balance: "349"},
+		},
+	}
+	wantReq := &pb.GetStatementRequest{AccountId: 101, From: "2026-09-01T00:00:00Z", To: "2026-10-01T00:00:00Z"}
+
+	tests := []struct {
+		name        string
+		format      string
+		contentType string
+		want        []string
+	}{
+		{
+			name: "JSON By Default", contentType: "application/json",
+			want: []string{`"opening_balance": "300"`, `"closing_balance": "349"`, `"debit": "50"`},
+		},
+		{
+			name: "CSV", format: "csv", contentType: "text/csv",
+			want: []string{
+				"posted_at,transfer_id,type,counterparty_id,debit,credit,balance,currency\n",
+				"2026-09-01T00:00:00Z,,OPENING_BALANCE,,,,300,USD\n",
+				"2026-09-02T09:30:00Z,12,DEPOSIT,800,,100,349,USD\n",
+				"2026-10-01T00:00:00Z,,CLOSING_BALANCE,,51,100,349,USD\n",
+			},
+		},
+		{
+			name: "MT940", format: "mt940", contentType: "text/plain",
+			want: []string{
+				":25:101\r\n",
+				":60F:C260901USD300,00\r\n",
+				":61:2609010901D50,00NTRF10\r\n",
+				":61:2609010901D1,00NCHG11\r\n",
+				":61:2609020902C100,00NTRF12\r\n:86:DEPOSIT COUNTERPARTY 800\r\n",
+				":62F:C261001USD349,00\r\n",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run("Success: "+tt.name, func(t *testing.T) {
+			mockClient := new(mocks.MockAccountServiceClient)
+			h := NewAccountHandler(mockClient, zap.NewNop())
+			url := target
+			if tt.format != "" {
+				url += "&format=" + tt.format
+			}
+			req := withURLParam(httptest.NewRequest("GET", url, nil), "id", "101")
+			rr := httptest.NewRecorder()
+
+			mockClient.On("GetStatement", mock.Anything, wantReq).Return(statement, nil)
+
+			h.GetStatement(rr, req)
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, tt.contentType, rr.Header().Get("Content-Type"))
+			for _, want := range tt.want {
+				assert.Contains(t, rr.Body.String(), want)
+			}
+			mockClient.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Failure: Unknown Format", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req := withURLParam(httptest.NewRequest("GET", target+"&format=xml", nil), "id", "101")
+		rr := httptest.NewRecorder()
+
+		h.GetStatement(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, constants.ErrInvalidStatementFormat.Error(), strings.TrimSpace(rr.Body.String()))
+		mockClient.AssertNotCalled(t, "GetStatement")
+	})
+
+	t.Run("Failure: Missing To", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req := withURLParam(httptest.NewRequest("GET", "/accounts/101/statement?from=2026-09-01T00:00:00Z", nil), "id", "101")
+		rr := httptest.NewRecorder()
+
+		h.GetStatement(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockClient.AssertNotCalled(t, "GetStatement")
+	})
+
+	t.Run("Failure: Range Too Long", func(t *testing.T) {
+		mockClient := new(mocks.MockAccountServiceClient)
+		h := NewAccountHandler(mockClient, zap.NewNop())
+		req := withURLParam(httptest.NewRequest("GET", target, nil), "id", "101")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("GetStatement", mock.Anything, mock.Anything).
+			Return(nil, status.Error(codes.InvalidArgument, constants.ErrStatementRangeTooLong.Error()))
+
+		h.GetStatement(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -4,15 +4,20 @@ import (
 	"encoding/json"
 	"github.com/jhaprabhatt/account-transfer-project/internal/api/middleware"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
 	"github.com/jhaprabhatt/account-transfer-project/internal/models"
 	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
 )
 
+const IdempotencyKeyHeader = "Idempotency-Key"
+
 type TransactionHandler struct {
 	client pb.TransferServiceClient
 	log    *zap.Logger
@@ -35,6 +40,7 @@ func (h *TransactionHandler) MakeTransfer(w http.ResponseWriter, r *http.Request
 		http.Error(w, "Invalid JSON", http.StatusBadRequest)
 		return
 	}
+	req.IdempotencyKey = r.Header.Get(IdempotencyKeyHeader)
 
 	if err := req.Validate(); err != nil {
 		h.log.Warn("Invalid transfer request", zap.Error(err))
@@ -43,15 +49,19 @@ func (h *TransactionHandler) MakeTransfer(w http.ResponseWriter, r *http.Request
 	}
 
 	grpcReq := &pb.TransferRequest{
-		SourceId:      req.SourceID,
-		DestinationId: req.DestinationID,
-		Amount:        req.Amount.String(),
+		SourceId:       req.SourceID,
+		DestinationId:  req.DestinationID,
+		Amount:         req.Amount.String(),
+		Currency:       req.Currency,
+		Convert:        req.Convert,
+		IdempotencyKey: req.IdempotencyKey,
 	}
 
 	h.log.Info("Initiating transfer",
 		zap.String("correlation_id", correlationID),
 		zap.Int64("source", req.SourceID),
 		zap.Int64("destination", req.DestinationID),
+		zap.String("idempotency_key", req.IdempotencyKey),
 	)
 
 	resp, err := h.client.MakeTransfer(r.Context(), grpcReq)
@@ -64,18 +74,7 @@ func (h *TransactionHandler) MakeTransfer(w http.ResponseWriter, r *http.Request
 			zap.Error(err),
 		)
 
-		switch st.Code() {
-		case codes.NotFound:
-			http.Error(w, st.Message(), http.StatusNotFound)
-		case codes.FailedPrecondition:
-			http.Error(w, st.Message(), http.StatusUnprocessableEntity)
-		case codes.InvalidArgument:
-			http.Error(w, st.Message(), http.StatusBadRequest)
-		case codes.AlreadyExists:
-			http.Error(w, st.Message(), http.StatusConflict)
-		default:
-			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
-		}
+		writeGRPCError(w, st)
 		return
 	}
 
@@ -85,3 +84,106 @@ func (h *TransactionHandler) MakeTransfer(w http.ResponseWriter, r *http.Request
 		h.log.Error("Failed to write response", zap.Error(err))
 	}
 }
+
+func (h *TransactionHandler) GetTransfer(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		http.Error(w, constants.ErrInvalidTransferID.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.client.GetTransfer(r.Context(), &pb.GetTransferRequest{TransferId: id})
+	if err != nil {
+		st, _ := status.FromError(err)
+		h.log.Warn("Get transfer failed via gRPC",
+			zap.Int64("transfer_id", id),
+			zap.String("grpc_code", st.Code().String()),
+			zap.Error(err),
+		)
+		writeGRPCError(w, st)
+		return
+	}
+
+	h.writeJSON(w, resp.Transfer)
+}
+
+// ListTransfers serves GET /accounts/{id}/transfers?direction=&from=&to=&cursor=&limit=
+func (h *TransactionHandler) ListTransfers(w http.ResponseWriter, r *http.Request) {
+	grpcReq, err := parseListTransfersRequest(r)
+	if err != nil {
+		h.log.Warn("Invalid list transfers request", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.client.ListTransfers(r.Context(), grpcReq)
+	if err != nil {
+		st, _ := status.FromError(err)
+		h.log.Warn("List transfers failed via gRPC",
+			zap.Int64("account_id", grpcReq.AccountId),
+			zap.String("grpc_code", st.Code().String()),
+			zap.Error(err),
+		)
+		writeGRPCError(w, st)
+		return
+	}
+
+	h.writeJSON(w, resp)
+}
+
+func parseListTransfersRequest(r *http.Request) (*pb.ListTransfersRequest, error) {
+	accountID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || accountID <= 0 {
+		return nil, constants.ErrInvalidAccountID
+	}
+
+	query := r.URL.Query()
+	req := &pb.ListTransfersRequest{AccountId: accountID}
+
+	switch query.Get("direction") {
+	case "", "all":
+		req.Direction = pb.TransferDirection_TRANSFER_DIRECTION_ALL
+	case "out":
+		req.Direction = pb.TransferDirection_TRANSFER_DIRECTION_OUTGOING
+	case "in":
+		req.Direction = pb.TransferDirection_TRANSFER_DIRECTION_INCOMING
+	default:
+		return nil, constants.ErrInvalidDirection
+	}
+
+	for _, p := range []struct {
+		name string
+		dst  *string
+	}{{"from", &req.From}, {"to", &req.To}} {
+		if v := query.Get(p.name); v != "" {
+			if _, err := time.Parse(time.RFC3339, v); err != nil {
+				return nil, constants.ErrInvalidTimestamp
+			}
+			*p.dst = v
+		}
+	}
+
+	if v := query.Get("cursor"); v != "" {
+		if req.Cursor, err = strconv.ParseInt(v, 10, 64); err != nil || req.Cursor < 0 {
+			return nil, constants.ErrInvalidCursor
+		}
+	}
+
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || limit <= 0 {
+			return nil, constants.ErrInvalidPageLimit
+		}
+		req.Limit = int32(limit)
+	}
+
+	return req, nil
+}
+
+func (h *TransactionHandler) writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		h.log.Error("Failed to write response", zap.Error(err))
+	}
+}
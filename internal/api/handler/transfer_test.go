@@ -5,13 +5,18 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/jhaprabhatt/account-transfer-project/internal/api/handler/mocks"
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
 	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
 )
 
@@ -42,6 +47,210 @@ func TestTransactionHandler_MakeTransfer(t *testing.T) {
 		mockClient.AssertExpectations(t)
 	})
 
+	t.Run("Success: Idempotency-Key Forwarded", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		logger := zap.NewNop()
+		h := NewTransactionHandler(mockClient, logger)
+
+		reqBody := `{"source_account_id": 100, "destination_account_id": 200, "amount": 50.00}`
+		req, _ := http.NewRequest("POST", "/transfer", bytes.NewBufferString(reqBody))
+		req.Header.Set(IdempotencyKeyHeader, "retry-abc")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("MakeTransfer", mock.Anything, mock.MatchedBy(func(req *pb.TransferRequest) bool {
+			return req.IdempotencyKey == "retry-abc"
+		})).Return(&pb.TransferResponse{Success: true, TransactionId: 12345}, nil)
+
+		h.MakeTransfer(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Success: Convert Forwarded", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		logger := zap.NewNop()
+		h := NewTransactionHandler(mockClient, logger)
+
+		reqBody := `{"source_account_id": 100, "destination_account_id": 200, "amount": 100, "convert": true}`
+		req, _ := http.NewRequest("POST", "/transfer", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		mockClient.On("MakeTransfer", mock.Anything, mock.MatchedBy(func(req *pb.TransferRequest) bool {
+			return req.Convert
+		})).Return(&pb.TransferResponse{
+			Success:             true,
+			TransactionId:       12345,
+			DestinationAmount:   "92.34",
+			DestinationCurrency: "EUR",
+			FxRate:              "0.92345",
+		}, nil)
+
+		h.MakeTransfer(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"destination_amount":"92.34"`)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Idempotency Key Reused", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		logger := zap.NewNop()
+		h := NewTransactionHandler(mockClient, logger)
+
+		reqBody := `{"source_account_id": 100, "destination_account_id": 200, "amount": 75.00}`
+		req, _ := http.NewRequest("POST", "/transfer", bytes.NewBufferString(reqBody))
+		req.Header.Set(IdempotencyKeyHeader, "retry-abc")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("MakeTransfer", mock.Anything, mock.Anything).
+			Return(nil, status.Error(codes.AlreadyExists, "idempotency key already used with a different payload"))
+
+		h.MakeTransfer(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Transfer Limit Exceeded", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		reqBody := `{"source_account_id": 100, "destination_account_id": 200, "amount": 75.00}`
+		req, _ := http.NewRequest("POST", "/transfer", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		st, _ := status.New(codes.ResourceExhausted, "transfer limit exceeded: daily_amount, remaining 30").WithDetails(&errdetails.ErrorInfo{
+			Reason:   constants.ReasonLimitExceeded,
+			Domain:   constants.ErrorDomain,
+			Metadata: map[string]string{"limit": "daily_amount", "remaining": "30"},
+		})
+		mockClient.On("MakeTransfer", mock.Anything, mock.Anything).Return(nil, st.Err())
+
+		h.MakeTransfer(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+		assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{
+			"error": "transfer limit exceeded: daily_amount, remaining 30",
+			"reason": "LIMIT_EXCEEDED",
+			"limit": "daily_amount",
+			"remaining": "30"
+		}`, rr.Body.String())
+	})
+
+	t.Run("Failure: Source Account Frozen", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		reqBody := `{"source_account_id": 100, "destination_account_id": 200, "amount": 75.00}`
+		req, _ := http.NewRequest("POST", "/transfer", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		mockClient.On("MakeTransfer", mock.Anything, mock.Anything).
+			Return(nil, reasonError(codes.FailedPrecondition, "account is frozen", constants.ReasonAccountFrozen))
+
+		h.MakeTransfer(rr, req)
+
+		assert.Equal(t, http.StatusLocked, rr.Code)
+	})
+
+	t.Run("Failure: Destination Account Closed", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		reqBody := `{"source_account_id": 100, "destination_account_id": 200, "amount": 75.00}`
+		req, _ := http.NewRequest("POST", "/transfer", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		mockClient.On("MakeTransfer", mock.Anything, mock.Anything).
+			Return(nil, reasonError(codes.FailedPrecondition, "account is closed", constants.ReasonAccountClosed))
+
+		h.MakeTransfer(rr, req)
+
+		assert.Equal(t, http.StatusGone, rr.Code)
+	})
+
+	t.Run("Failure: Currency Mismatch", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		reqBody := `{"source_account_id": 100, "destination_account_id": 200, "amount": 75.00, "currency": "EUR"}`
+		req, _ := http.NewRequest("POST", "/transfer", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		mockClient.On("MakeTransfer", mock.Anything, mock.MatchedBy(func(r *pb.TransferRequest) bool {
+			return r.Currency == "EUR"
+		})).Return(nil, reasonError(codes.FailedPrecondition, "source and destination currencies differ", constants.ReasonCurrencyMismatch))
+
+		h.MakeTransfer(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Success: Lower-Case Currency Normalized", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		reqBody := `{"source_account_id": 100, "destination_account_id": 200, "amount": 75.00, "currency": "usd"}`
+		req, _ := http.NewRequest("POST", "/transfer", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		mockClient.On("MakeTransfer", mock.Anything, mock.MatchedBy(func(r *pb.TransferRequest) bool {
+			return r.Currency == "USD"
+		})).Return(&pb.TransferResponse{Success: true}, nil)
+
+		h.MakeTransfer(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Unsupported Currency", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		reqBody := `{"source_account_id": 100, "destination_account_id": 200, "amount": 75.00, "currency": "xyz"}`
+		req, _ := http.NewRequest("POST", "/transfer", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		h.MakeTransfer(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockClient.AssertNotCalled(t, "MakeTransfer")
+	})
+
+	t.Run("Failure: Amount Exceeds Currency Scale", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+
+		reqBody := `{"source_account_id": 100, "destination_account_id": 200, "amount": 1.5, "currency": "JPY"}`
+		req, _ := http.NewRequest("POST", "/transfer", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		h.MakeTransfer(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockClient.AssertNotCalled(t, "MakeTransfer")
+	})
+
+	t.Run("Failure: Idempotency Key Too Long", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		logger := zap.NewNop()
+		h := NewTransactionHandler(mockClient, logger)
+
+		reqBody := `{"source_account_id": 100, "destination_account_id": 200, "amount": 50.00}`
+		req, _ := http.NewRequest("POST", "/transfer", bytes.NewBufferString(reqBody))
+		req.Header.Set(IdempotencyKeyHeader, strings.Repeat("k", 256))
+		rr := httptest.NewRecorder()
+
+		h.MakeTransfer(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockClient.AssertNotCalled(t, "MakeTransfer")
+	})
+
 	t.Run("Failure: Invalid JSON", func(t *testing.T) {
 		mockClient := new(mocks.MockTransferServiceClient)
 		logger := zap.NewNop()
@@ -111,3 +320,104 @@ func TestTransactionHandler_MakeTransfer(t *testing.T) {
 		mockClient.AssertNotCalled(t, "MakeTransfer")
 	})
 }
+
+func TestTransactionHandler_GetTransfer(t *testing.T) {
+	t.Run("Success: Transfer Found", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+		req := withURLParam(httptest.NewRequest("GET", "/transfers/7", nil), "id", "7")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("GetTransfer", mock.Anything, &pb.GetTransferRequest{TransferId: 7}).
+			Return(&pb.GetTransferResponse{Transfer: &pb.Transfer{TransferId: 7, Status: "COMPLETED"}}, nil)
+
+		h.GetTransfer(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"status":"COMPLETED"`)
+	})
+
+	t.Run("Failure: Not Found", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+		req := withURLParam(httptest.NewRequest("GET", "/transfers/7", nil), "id", "7")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("GetTransfer", mock.Anything, mock.Anything).
+			Return(nil, status.Error(codes.NotFound, "transfer not found"))
+
+		h.GetTransfer(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Failure: Invalid ID", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+		req := withURLParam(httptest.NewRequest("GET", "/transfers/-1", nil), "id", "-1")
+		rr := httptest.NewRecorder()
+
+		h.GetTransfer(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockClient.AssertNotCalled(t, "GetTransfer")
+	})
+}
+
+func TestTransactionHandler_ListTransfers(t *testing.T) {
+	t.Run("Success: Query Parameters Forwarded", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+		req := withURLParam(httptest.NewRequest("GET",
+			"/accounts/100/transfers?direction=in&from=2026-03-01T00:00:00Z&cursor=20&limit=10", nil), "id", "100")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("ListTransfers", mock.Anything, mock.MatchedBy(func(r *pb.ListTransfersRequest) bool {
+			return r.AccountId == 100 &&
+				r.Direction == pb.TransferDirection_TRANSFER_DIRECTION_INCOMING &&
+				r.From == "2026-03-01T00:00:00Z" && r.To == "" &&
+				r.Cursor == 20 && r.Limit == 10
+		})).Return(&pb.ListTransfersResponse{NextCursor: 11}, nil)
+
+		h.ListTransfers(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"next_cursor":11`)
+		mockClient.AssertExpectations(t)
+	})
+
+	for _, tc := range []struct {
+		name, query string
+	}{
+		{"Failure: Invalid Direction", "direction=sideways"},
+		{"Failure: Invalid Timestamp", "to=yesterday"},
+		{"Failure: Invalid Cursor", "cursor=-5"},
+		{"Failure: Invalid Limit", "limit=0"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mockClient := new(mocks.MockTransferServiceClient)
+			h := NewTransactionHandler(mockClient, zap.NewNop())
+			req := withURLParam(httptest.NewRequest("GET", "/accounts/100/transfers?"+tc.query, nil), "id", "100")
+			rr := httptest.NewRecorder()
+
+			h.ListTransfers(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			mockClient.AssertNotCalled(t, "ListTransfers")
+		})
+	}
+
+	t.Run("Failure: Account Not Found", func(t *testing.T) {
+		mockClient := new(mocks.MockTransferServiceClient)
+		h := NewTransactionHandler(mockClient, zap.NewNop())
+		req := withURLParam(httptest.NewRequest("GET", "/accounts/100/transfers", nil), "id", "100")
+		rr := httptest.NewRecorder()
+
+		mockClient.On("ListTransfers", mock.Anything, mock.Anything).
+			Return(nil, status.Error(codes.NotFound, "account not found"))
+
+		h.ListTransfers(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
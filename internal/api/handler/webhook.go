@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+type WebhookHandler struct {
+	client pb.WebhookServiceClient
+	log    *zap.Logger
+}
+
+func NewWebhookHandler(client pb.WebhookServiceClient, log *zap.Logger) *WebhookHandler {
+	return &WebhookHandler{client: client, log: log}
+}
+
+// CreateWebhook serves POST /webhooks. The response is the only one that
+// includes the signing secret.
+func (h *WebhookHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	var req models.WebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.log.Warn("Failed to decode webhook request", zap.Error(err))
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.log.Warn("Invalid webhook request", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	grpcReq := &pb.CreateWebhookRequest{
+		Url:        req.URL,
+		Secret:     req.Secret,
+		EventTypes: make([]string, 0, len(req.EventTypes)),
+		AccountId:  req.AccountID,
+	}
+	for _, t := range req.EventTypes {
+		grpcReq.EventTypes = append(grpcReq.EventTypes, string(t))
+	}
+
+	resp, err := h.client.CreateWebhook(r.Context(), grpcReq)
+	if err != nil {
+		h.fail(w, err, "Creating webhook failed via gRPC", zap.String("url", req.URL))
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, resp.Webhook)
+}
+
+// ListWebhooks serves GET /webhooks.
+func (h *WebhookHandler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.client.ListWebhooks(r.Context(), &pb.ListWebhooksRequest{})
+	if err != nil {
+		h.fail(w, err, "Listing webhooks failed via gRPC")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// GetWebhook serves GET /webhooks/{id}.
+func (h *WebhookHandler) GetWebhook(w http.ResponseWriter, r *http.Request) {
+	id, ok := webhookIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.client.GetWebhook(r.Context(), &pb.GetWebhookRequest{WebhookId: id})
+	if err != nil {
+		h.fail(w, err, "Fetching webhook failed via gRPC", zap.Int64("webhook_id", id))
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, resp.Webhook)
+}
+
+// DeleteWebhook serves DELETE /webhooks/{id}. Deliveries still queued for the
+// webhook are no longer attempted.
+func (h *WebhookHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	id, ok := webhookIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.client.DeleteWebhook(r.Context(), &pb.DeleteWebhookRequest{WebhookId: id})
+	if err != nil {
+		h.fail(w, err, "Deleting webhook failed via gRPC", zap.Int64("webhook_id", id))
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, resp.Webhook)
+}
+
+// ListDeadLetters serves GET /webhooks/{id}/dead-letters.
+func (h *WebhookHandler) ListDeadLetters(w http.ResponseWriter, r *http.Request) {
+	id, ok := webhookIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.client.ListDeadLetters(r.Context(), &pb.ListDeadLettersRequest{WebhookId: id})
+	if err != nil {
+		h.fail(w, err, "Listing dead letters failed via gRPC", zap.Int64("webhook_id", id))
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// RedeliverDeadLetter serves POST /webhooks/dead-letters/{id}/redeliver. The
+// event is queued for delivery again; 202 does not mean it has been sent.
+func (h *WebhookHandler) RedeliverDeadLetter(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		http.Error(w, constants.ErrInvalidDeadLetterID.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.client.RedeliverDeadLetter(r.Context(), &pb.RedeliverDeadLetterRequest{DeadLetterId: id})
+	if err != nil {
+		h.fail(w, err, "Redelivering dead letter failed via gRPC", zap.Int64("dead_letter_id", id))
+		return
+	}
+
+	h.writeJSON(w, http.StatusAccepted, resp)
+}
+
+func webhookIDParam(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		http.Error(w, constants.ErrInvalidWebhookID.Error(), http.StatusBadRequest)
+		return 0, false
+	}
+	return id, true
+}
+
+func (h *WebhookHandler) fail(w http.ResponseWriter, err error, msg string, fields ...zap.Field) {
+	st, _ := status.FromError(err)
+	if st.Code() == codes.Internal || st.Code() == codes.Unknown {
+		h.log.Error(msg, append(fields, zap.Error(err))...)
+	}
+	writeGRPCError(w, st)
+}
+
+func (h *WebhookHandler) writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		h.log.Error("Failed to write response", zap.Error(err))
+	}
+}
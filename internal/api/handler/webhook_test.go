@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/api/handler/mocks"
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+func TestWebhookHandler_CreateWebhook(t *testing.T) {
+	t.Run("Success: Created", func(t *testing.T) {
+		mockClient := new(mocks.MockWebhookServiceClient)
+		h := NewWebhookHandler(mockClient, zap.NewNop())
+
+		reqBody := `{"url": "https://partner.example/hooks", "event_types": ["TransferCompleted", "TransferFailed"], "account_id": 101}`
+		req := httptest.NewRequest("POST", "/webhooks", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		mockClient.On("CreateWebhook", mock.Anything, &pb.CreateWebhookRequest{
+			Url: "https://partner.example/hooks", EventTypes: []string{"TransferCompleted", "TransferFailed"}, AccountId: 101,
+		}).Return(&pb.WebhookResponse{Webhook: &pb.Webhook{WebhookId: 3, Secret: "generated-secret-0123"}}, nil)
+
+		h.CreateWebhook(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"webhook_id":3`)
+		assert.Contains(t, rr.Body.String(), `"secret":"generated-secret-0123"`)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Unknown Event Type", func(t *testing.T) {
+		mockClient := new(mocks.MockWebhookServiceClient)
+		h := NewWebhookHandler(mockClient, zap.NewNop())
+
+		reqBody := `{"url": "https://partner.example/hooks", "event_types": ["TransferPending"]}`
+		req := httptest.NewRequest("POST", "/webhooks", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		h.CreateWebhook(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockClient.AssertNotCalled(t, "CreateWebhook", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure: Short Secret", func(t *testing.T) {
+		mockClient := new(mocks.MockWebhookServiceClient)
+		h := NewWebhookHandler(mockClient, zap.NewNop())
+
+		reqBody := `{"url": "https://partner.example/hooks", "secret": "hunter2", "event_types": ["TransferCompleted"]}`
+		req := httptest.NewRequest("POST", "/webhooks", bytes.NewBufferString(reqBody))
+		rr := httptest.NewRecorder()
+
+		h.CreateWebhook(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), constants.ErrInvalidWebhookSecret.Error())
+	})
+}
+
+func TestWebhookHandler_DeleteWebhook(t *testing.T) {
+	t.Run("Failure: Invalid ID", func(t *testing.T) {
+		h := NewWebhookHandler(new(mocks.MockWebhookServiceClient), zap.NewNop())
+
+		req := withURLParam(httptest.NewRequest("DELETE", "/webhooks/abc", nil), "id", "abc")
+		rr := httptest.NewRecorder()
+
+		h.DeleteWebhook(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure: Already Deleted", func(t *testing.T) {
+		mockClient := new(mocks.MockWebhookServiceClient)
+		h := NewWebhookHandler(mockClient, zap.NewNop())
+
+		mockClient.On("DeleteWebhook", mock.Anything, &pb.DeleteWebhookRequest{WebhookId: 3}).
+			Return(nil, reasonError(codes.FailedPrecondition, constants.ErrWebhookNotActive.Error(), constants.ReasonWebhookNotActive))
+
+		req := withURLParam(httptest.NewRequest("DELETE", "/webhooks/3", nil), "id", "3")
+		rr := httptest.NewRecorder()
+
+		h.DeleteWebhook(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func TestWebhookHandler_GetWebhook(t *testing.T) {
+	mockClient := new(mocks.MockWebhookServiceClient)
+	h := NewWebhookHandler(mockClient, zap.NewNop())
+
+	mockClient.On("GetWebhook", mock.Anything, &pb.GetWebhookRequest{WebhookId: 3}).
+		Return(nil, status.Error(codes.NotFound, constants.ErrWebhookNotFound.Error()))
+
+	req := withURLParam(httptest.NewRequest("GET", "/webhooks/3", nil), "id", "3")
+	rr := httptest.NewRecorder()
+
+	h.GetWebhook(rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestWebhookHandler_ListDeadLetters(t *testing.T) {
+	mockClient := new(mocks.MockWebhookServiceClient)
+	h := NewWebhookHandler(mockClient, zap.NewNop())
+
+	mockClient.On("ListDeadLetters", mock.Anything, &pb.ListDeadLettersRequest{WebhookId: 3}).
+		Return(&pb.ListDeadLettersResponse{DeadLetters: []*pb.DeadLetter{
+			{DeadLetterId: 5, WebhookId: 3, EventId: 42, Attempts: 8, LastError: "unexpected status 503"},
+		}}, nil)
+
+	req := withURLParam(httptest.NewRequest("GET", "/webhooks/3/dead-letters", nil), "id", "3")
+	rr := httptest.NewRecorder()
+
+	h.ListDeadLetters(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"dead_letter_id":5`)
+	assert.Contains(t, rr.Body.String(), `"last_error":"unexpected status 503"`)
+}
+
+func TestWebhookHandler_RedeliverDeadLetter(t *testing.T) {
+	t.Run("Success: Accepted", func(t *testing.T) {
+		mockClient := new(mocks.MockWebhookServiceClient)
+		h := NewWebhookHandler(mockClient, zap.NewNop())
+
+		mockClient.On("RedeliverDeadLetter", mock.Anything, &pb.RedeliverDeadLetterRequest{DeadLetterId: 5}).
+			Return(&pb.RedeliverDeadLetterResponse{DeliveryId: 12}, nil)
+
+		req := withURLParam(httptest.NewRequest("POST", "/webhooks/dead-letters/5/redeliver", nil), "id", "5")
+		rr := httptest.NewRecorder()
+
+		h.RedeliverDeadLetter(rr, req)
+
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"delivery_id":12`)
+	})
+
+	t.Run("Failure: Subscription Deleted", func(t *testing.T) {
+		mockClient := new(mocks.MockWebhookServiceClient)
+		h := NewWebhookHandler(mockClient, zap.NewNop())
+
+		mockClient.On("RedeliverDeadLetter", mock.Anything, &pb.RedeliverDeadLetterRequest{DeadLetterId: 5}).
+			Return(nil, reasonError(codes.FailedPrecondition, constants.ErrWebhookNotActive.Error(), constants.ReasonWebhookNotActive))
+
+		req := withURLParam(httptest.NewRequest("POST", "/webhooks/dead-letters/5/redeliver", nil), "id", "5")
+		rr := httptest.NewRecorder()
+
+		h.RedeliverDeadLetter(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
@@ -0,0 +1,11 @@
+package config
+
+type CacheConfig struct {
+	MissingTTL string
+}
+
+func LoadCacheConfig() CacheConfig {
+	return CacheConfig{
+		MissingTTL: GetEnv("ACCOUNT_CACHE_MISSING_TTL", "30s"),
+	}
+}
@@ -0,0 +1,15 @@
+package config
+
+// FeeConfig holds the fee policies per account class as JSON and the
+// fee-revenue accounts as CURRENCY:account_id pairs; empty values charge no fees.
+type FeeConfig struct {
+	Policies string
+	Accounts string
+}
+
+func LoadFeeConfig() FeeConfig {
+	return FeeConfig{
+		Policies: GetEnv("FEE_POLICIES", ""),
+		Accounts: GetEnv("FEE_ACCOUNTS", ""),
+	}
+}
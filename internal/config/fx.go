@@ -0,0 +1,11 @@
+package config
+
+type FxConfig struct {
+	RoundingMode string
+}
+
+func LoadFxConfig() FxConfig {
+	return FxConfig{
+		RoundingMode: GetEnv("FX_ROUNDING_MODE", "half_even"),
+	}
+}
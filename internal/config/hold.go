@@ -0,0 +1,11 @@
+package config
+
+type HoldConfig struct {
+	ExpiryInterval string
+}
+
+func LoadHoldConfig() HoldConfig {
+	return HoldConfig{
+		ExpiryInterval: GetEnv("HOLD_EXPIRY_INTERVAL", "1m"),
+	}
+}
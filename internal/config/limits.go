@@ -0,0 +1,21 @@
+package config
+
+// LimitsConfig holds the default transfer limits: amounts as CURRENCY:amount
+// pairs and counts as plain numbers. Empty values mean no limit.
+type LimitsConfig struct {
+	MaxSingle     string
+	DailyAmount   string
+	DailyCount    string
+	MonthlyAmount string
+	MonthlyCount  string
+}
+
+func LoadLimitsConfig() LimitsConfig {
+	return LimitsConfig{
+		MaxSingle:     GetEnv("LIMIT_MAX_SINGLE", ""),
+		DailyAmount:   GetEnv("LIMIT_DAILY_AMOUNT", ""),
+		DailyCount:    GetEnv("LIMIT_DAILY_COUNT", ""),
+		MonthlyAmount: GetEnv("LIMIT_MONTHLY_AMOUNT", ""),
+		MonthlyCount:  GetEnv("LIMIT_MONTHLY_COUNT", ""),
+	}
+}
@@ -0,0 +1,11 @@
+package config
+
+type MetricsConfig struct {
+	Addr string
+}
+
+func LoadMetricsConfig() MetricsConfig {
+	return MetricsConfig{
+		Addr: GetEnv("METRICS_ADDR", ":9090"),
+	}
+}
@@ -0,0 +1,20 @@
+package config
+
+type OutboxConfig struct {
+	// Publisher is stdout, file or none; with none events stay in the outbox
+	// for an external reader such as a CDC connector. It defaults to none
+	// because stdout also carries the JSON logs.
+	Publisher     string
+	File          string
+	RelayInterval string
+	BatchSize     string
+}
+
+func LoadOutboxConfig() OutboxConfig {
+	return OutboxConfig{
+		Publisher:     GetEnv("OUTBOX_PUBLISHER", "none"),
+		File:          GetEnv("OUTBOX_FILE", "events.jsonl"),
+		RelayInterval: GetEnv("OUTBOX_RELAY_INTERVAL", "1s"),
+		BatchSize:     GetEnv("OUTBOX_BATCH_SIZE", "100"),
+	}
+}
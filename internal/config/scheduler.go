@@ -0,0 +1,11 @@
+package config
+
+type SchedulerConfig struct {
+	Interval string
+}
+
+func LoadSchedulerConfig() SchedulerConfig {
+	return SchedulerConfig{
+		Interval: GetEnv("SCHEDULER_INTERVAL", "30s"),
+	}
+}
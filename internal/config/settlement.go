@@ -0,0 +1,13 @@
+package config
+
+// SettlementConfig holds the settlement account of each currency as
+// CURRENCY:account_id pairs; empty disables deposits and withdrawals.
+type SettlementConfig struct {
+	Accounts string
+}
+
+func LoadSettlementConfig() SettlementConfig {
+	return SettlementConfig{
+		Accounts: GetEnv("SETTLEMENT_ACCOUNTS", ""),
+	}
+}
@@ -0,0 +1,15 @@
+package config
+
+type TxConfig struct {
+	Isolation      string
+	MaxAttempts    string
+	RetryBaseDelay string
+}
+
+func LoadTxConfig() TxConfig {
+	return TxConfig{
+		Isolation:      GetEnv("TX_ISOLATION", "serializable"),
+		MaxAttempts:    GetEnv("TX_MAX_ATTEMPTS", "3"),
+		RetryBaseDelay: GetEnv("TX_RETRY_BASE_DELAY", "10ms"),
+	}
+}
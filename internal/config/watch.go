@@ -0,0 +1,11 @@
+package config
+
+type WatchConfig struct {
+	PollInterval string
+}
+
+func LoadWatchConfig() WatchConfig {
+	return WatchConfig{
+		PollInterval: GetEnv("WATCH_POLL_INTERVAL", "1s"),
+	}
+}
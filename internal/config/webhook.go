@@ -0,0 +1,23 @@
+package config
+
+type WebhookConfig struct {
+	DeliveryInterval string
+	MaxAttempts      string
+	BackoffBase      string
+	BackoffMax       string
+	Timeout          string
+	Workers          string
+	AllowPrivateURLs string
+}
+
+func LoadWebhookConfig() WebhookConfig {
+	return WebhookConfig{
+		DeliveryInterval: GetEnv("WEBHOOK_DELIVERY_INTERVAL", "1s"),
+		MaxAttempts:      GetEnv("WEBHOOK_MAX_ATTEMPTS", "8"),
+		BackoffBase:      GetEnv("WEBHOOK_BACKOFF_BASE", "10s"),
+		BackoffMax:       GetEnv("WEBHOOK_BACKOFF_MAX", "1h"),
+		Timeout:          GetEnv("WEBHOOK_TIMEOUT", "10s"),
+		Workers:          GetEnv("WEBHOOK_WORKERS", "8"),
+		AllowPrivateURLs: GetEnv("WEBHOOK_ALLOW_PRIVATE_URLS", "false"),
+	}
+}
@@ -0,0 +1,22 @@
+package constants
+
+type AccountStatus int
+
+const (
+	AccountActive AccountStatus = iota + 1
+	AccountFrozen
+	AccountClosed
+)
+
+func (s AccountStatus) String() string {
+	switch s {
+	case AccountActive:
+		return "ACTIVE"
+	case AccountFrozen:
+		return "FROZEN"
+	case AccountClosed:
+		return "CLOSED"
+	default:
+		return "UNKNOWN"
+	}
+}
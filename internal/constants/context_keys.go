@@ -0,0 +1,8 @@
+package constants
+
+type contextKey string
+
+// CorrelationKey carries a request's correlation ID, an int64, from the gRPC
+// interceptor down to the repository, which writes it to the transfers audit
+// rows and transaction retry logs.
+const CorrelationKey contextKey = "correlation_id"
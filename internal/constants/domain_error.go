@@ -0,0 +1,24 @@
+package constants
+
+import "errors"
+
+// DomainError is a request turned down by the rules of the ledger, such as
+// insufficient funds or an unknown account. Retrying the same request fails the
+// same way, unlike an error reaching the database or cache.
+type DomainError struct {
+	msg string
+}
+
+func domainError(msg string) error {
+	return &DomainError{msg: msg}
+}
+
+func (e *DomainError) Error() string {
+	return e.msg
+}
+
+// IsDomainError reports whether err, or any error it wraps, is a DomainError.
+func IsDomainError(err error) bool {
+	var de *DomainError
+	return errors.As(err, &de)
+}
@@ -2,13 +2,87 @@ package constants
 
 import "errors"
 
+// Requests turned down by a business rule. Each is a DomainError, so callers
+// can tell them apart from failures to reach an answer.
 var (
-	ErrAmountMustNotBeNegative = errors.New("amount must be greater than or equal to zero")
-	ErrAmountMustBePositive    = errors.New("amount must be greater than zero")
-	ErrInvalidAccountID        = errors.New("invalid account_id: must be positive")
-	ErrSameAccount             = errors.New("source and destination account cannot be the same")
-	ErrInsufficientFunds       = errors.New("insufficient funds")
-	ErrAccountNotFound         = errors.New("account not found")
-	ErrSystem                  = errors.New("internal system error")
-	ErrAccountAlreadyExists    = errors.New("account already exists")
+	ErrAmountMustNotBeNegative = domainError("amount must be greater than or equal to zero")
+	ErrAmountMustBePositive    = domainError("amount must be greater than zero")
+	ErrInvalidAccountID        = domainError("invalid account_id: must be positive")
+	ErrSameAccount             = domainError("source and destination account cannot be the same")
+	ErrInsufficientFunds       = domainError("insufficient funds")
+	ErrAccountNotFound         = domainError("account not found")
+	ErrAccountAlreadyExists    = domainError("account already exists")
+	ErrInvalidIdempotencyKey   = domainError("invalid Idempotency-Key: must be at most 255 characters")
+	ErrIdempotencyKeyReused    = domainError("idempotency key already used with a different payload")
+	ErrInvalidTransferID       = domainError("invalid transfer_id: must be positive")
+	ErrTransferNotFound        = domainError("transfer not found")
+	ErrInvalidDirection        = domainError("invalid direction: must be one of all, in, out")
+	ErrInvalidTimeRange        = domainError("invalid time range: from must not be after to")
+	ErrInvalidTimestamp        = domainError("invalid timestamp: must be RFC 3339")
+	ErrInvalidCursor           = domainError("invalid cursor: must be a non-negative transfer_id")
+	ErrInvalidPageLimit        = domainError("invalid limit: must be a positive integer")
+	ErrAccountFrozen           = domainError("account is frozen")
+	ErrAccountClosed           = domainError("account is closed")
+	ErrAccountBalanceNotZero   = domainError("account balance must be zero to close")
+	ErrAccountHasHolds         = domainError("account has active holds: capture or void them to close")
+	ErrInvalidStatusTransition = domainError("invalid account status transition")
+	ErrUnsupportedCurrency     = domainError("unsupported currency: must be a supported ISO 4217 code")
+	ErrInvalidAmountScale      = domainError("amount has more decimal places than the currency allows")
+	ErrCurrencyMismatch        = domainError("source and destination currencies differ")
+	ErrInvalidFxRate           = domainError("invalid fx rate: currencies must differ and rate must be positive")
+	ErrFxRateNotFound          = domainError("no fx rate available for currency pair")
+	ErrConvertedAmountTooSmall = domainError("converted amount rounds to zero")
+	ErrInvalidHoldID           = domainError("invalid hold_id: must be positive")
+	ErrHoldNotFound            = domainError("hold not found")
+	ErrHoldNotActive           = domainError("hold is no longer active")
+	ErrHoldExpired             = domainError("hold has expired")
+	ErrInvalidHoldExpiry       = domainError("invalid expires_at: must be in the future")
+	ErrCaptureExceedsHold      = domainError("capture amount exceeds held amount")
+	ErrHoldCurrencyMismatch    = domainError("holds do not support currency conversion")
+	ErrTransferNotReversible   = domainError("transfer cannot be reversed")
+	ErrReversalTooLarge        = domainError("reversal amount exceeds the remaining reversible amount")
+	ErrInvalidReversalReason   = domainError("invalid reason: must be 1 to 255 characters")
+	ErrEmptyBatch              = domainError("batch must contain at least one leg")
+	ErrBatchTooLarge           = domainError("batch exceeds the maximum number of legs")
+	ErrBatchIdempotencyKey     = domainError("idempotency keys are not supported on batch legs")
+	ErrInvalidLegs             = domainError("multi-leg transfer needs at least one debit and one credit")
+	ErrTooManyLegs             = domainError("multi-leg transfer exceeds the maximum number of legs")
+	ErrUnbalancedLegs          = domainError("debits and credits must total the same amount")
+	ErrDuplicateLegAccount     = domainError("an account may appear in only one leg")
+	ErrInvalidSchedule         = domainError("invalid schedule: must be a 5-field cron expression, @hourly, @daily, @weekly, @monthly or @every <duration> of at least 1m")
+	ErrInvalidStartAt          = domainError("invalid start_at: must be in the future")
+	ErrInvalidScheduleID       = domainError("invalid schedule_id: must be positive")
+	ErrScheduleNotFound        = domainError("scheduled transfer not found")
+	ErrScheduleNotActive       = domainError("scheduled transfer is no longer active")
+	ErrLimitExceeded           = domainError("transfer limit exceeded")
+	ErrInvalidLimit            = domainError("invalid transfer limit: amounts must be positive and counts positive integers")
+	ErrInvalidAccountClass     = domainError("invalid account_class: must be 1 to 32 characters of a-z, 0-9, _ or -")
+	ErrFeeAccountMissing       = domainError("no fee account configured for currency")
+	ErrFeeNotSupported         = domainError("source account pays transfer fees, which this kind of transfer cannot charge")
+	ErrInvalidOverdraftLimit   = domainError("invalid overdraft_limit: must not be negative")
+	ErrOverdraftLimitTooLow    = domainError("overdraft_limit does not cover the balance already drawn and held")
+	ErrSettlementMissing       = domainError("no settlement account configured for currency")
+	ErrBalanceTimeInFuture     = domainError("invalid at: must not be in the future")
+	ErrAccountNotOpenYet       = domainError("account did not exist at the requested time")
+	ErrInvalidStatementFormat  = domainError("invalid format: must be one of json, csv, mt940")
+	ErrStatementRangeTooLong   = domainError("invalid time range: a statement covers at most 366 days")
+	ErrInvalidWebhookURL       = domainError("invalid url: must be an absolute http or https URL")
+	ErrWebhookURLNotAllowed    = domainError("invalid url: must not resolve to a loopback, private or link-local address")
+	ErrInvalidWebhookSecret    = domainError("invalid secret: must be 16 to 255 characters")
+	ErrInvalidEventTypes       = domainError("invalid event_types: must list AccountCreated, TransferCompleted or TransferFailed")
+	ErrInvalidWebhookID        = domainError("invalid webhook_id: must be positive")
+	ErrWebhookNotFound         = domainError("webhook subscription not found")
+	ErrWebhookNotActive        = domainError("webhook subscription has been deleted")
+	ErrInvalidDeadLetterID     = domainError("invalid dead_letter_id: must be positive")
+	ErrDeadLetterNotFound      = domainError("dead letter not found")
+)
+
+// Failures of the system or its configuration rather than of the request.
+var (
+	ErrSystem                = errors.New("internal system error")
+	ErrUnbalancedJournal     = errors.New("ledger journal entries do not sum to zero")
+	ErrInvalidRoundingMode   = errors.New("invalid rounding mode: must be one of half_even, half_up, down")
+	ErrInvalidIsolationLevel = errors.New("invalid isolation level: must be one of read_committed, repeatable_read, serializable")
+	ErrInvalidFeePolicy      = errors.New("invalid fee policy")
+	ErrInvalidSettlement     = errors.New("invalid settlement account configuration")
 )
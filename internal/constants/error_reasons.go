@@ -0,0 +1,22 @@
+package constants
+
+// ErrorDomain and the reasons below are attached to gRPC statuses as
+// google.rpc.ErrorInfo so the API service can tell apart errors that share a
+// gRPC code but map to different HTTP statuses.
+const ErrorDomain = "account-transfer"
+
+const (
+	ReasonAccountFrozen           = "ACCOUNT_FROZEN"
+	ReasonAccountClosed           = "ACCOUNT_CLOSED"
+	ReasonBalanceNotZero          = "BALANCE_NOT_ZERO"
+	ReasonHoldsOutstanding        = "HOLDS_OUTSTANDING"
+	ReasonInvalidStatusTransition = "INVALID_STATUS_TRANSITION"
+	ReasonCurrencyMismatch        = "CURRENCY_MISMATCH"
+	ReasonFxRateNotFound          = "FX_RATE_NOT_FOUND"
+	ReasonHoldNotActive           = "HOLD_NOT_ACTIVE"
+	ReasonHoldExpired             = "HOLD_EXPIRED"
+	ReasonTransferNotReversible   = "TRANSFER_NOT_REVERSIBLE"
+	ReasonScheduleNotActive       = "SCHEDULE_NOT_ACTIVE"
+	ReasonLimitExceeded           = "LIMIT_EXCEEDED"
+	ReasonWebhookNotActive        = "WEBHOOK_NOT_ACTIVE"
+)
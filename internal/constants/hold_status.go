@@ -0,0 +1,27 @@
+package constants
+
+// HoldStatus tracks a hold placed by an authorized transfer. While a hold is
+// active its transfer stays StatusPending.
+type HoldStatus int
+
+const (
+	HoldActive HoldStatus = iota + 1
+	HoldCaptured
+	HoldVoided
+	HoldExpired
+)
+
+func (s HoldStatus) String() string {
+	switch s {
+	case HoldActive:
+		return "ACTIVE"
+	case HoldCaptured:
+		return "CAPTURED"
+	case HoldVoided:
+		return "VOIDED"
+	case HoldExpired:
+		return "EXPIRED"
+	default:
+		return "UNKNOWN"
+	}
+}
@@ -0,0 +1,43 @@
+package constants
+
+// ScheduleStatus tracks a scheduled transfer. Only active schedules have a
+// next run; a one-off schedule completes after its single run.
+type ScheduleStatus int
+
+const (
+	ScheduleActive ScheduleStatus = iota + 1
+	ScheduleCancelled
+	ScheduleCompleted
+)
+
+func (s ScheduleStatus) String() string {
+	switch s {
+	case ScheduleActive:
+		return "ACTIVE"
+	case ScheduleCancelled:
+		return "CANCELLED"
+	case ScheduleCompleted:
+		return "COMPLETED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// RunStatus is the outcome of one occurrence of a scheduled transfer.
+type RunStatus int
+
+const (
+	RunSucceeded RunStatus = iota + 1
+	RunFailed
+)
+
+func (s RunStatus) String() string {
+	switch s {
+	case RunSucceeded:
+		return "SUCCEEDED"
+	case RunFailed:
+		return "FAILED"
+	default:
+		return "UNKNOWN"
+	}
+}
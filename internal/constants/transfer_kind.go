@@ -0,0 +1,24 @@
+package constants
+
+// TransferKind tells plain transfers between accounts apart from deposits and
+// withdrawals, which move money in and out through a settlement account.
+type TransferKind int
+
+const (
+	KindTransfer TransferKind = iota + 1
+	KindDeposit
+	KindWithdrawal
+)
+
+func (k TransferKind) String() string {
+	switch k {
+	case KindTransfer:
+		return "TRANSFER"
+	case KindDeposit:
+		return "DEPOSIT"
+	case KindWithdrawal:
+		return "WITHDRAWAL"
+	default:
+		return "UNKNOWN"
+	}
+}
@@ -6,4 +6,23 @@ const (
 	StatusPending TransferStatus = iota + 1
 	StatusCompleted
 	StatusFailed
+	StatusReversed
+	StatusPartiallyReversed
 )
+
+func (s TransferStatus) String() string {
+	switch s {
+	case StatusPending:
+		return "PENDING"
+	case StatusCompleted:
+		return "COMPLETED"
+	case StatusFailed:
+		return "FAILED"
+	case StatusReversed:
+		return "REVERSED"
+	case StatusPartiallyReversed:
+		return "PARTIALLY_REVERSED"
+	default:
+		return "UNKNOWN"
+	}
+}
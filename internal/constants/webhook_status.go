@@ -0,0 +1,21 @@
+package constants
+
+// WebhookStatus tracks a webhook subscription. Deleted subscriptions are kept
+// so their dead letters still resolve, but nothing more is delivered to them.
+type WebhookStatus int
+
+const (
+	WebhookActive WebhookStatus = iota + 1
+	WebhookDeleted
+)
+
+func (s WebhookStatus) String() string {
+	switch s {
+	case WebhookActive:
+		return "ACTIVE"
+	case WebhookDeleted:
+		return "DELETED"
+	default:
+		return "UNKNOWN"
+	}
+}
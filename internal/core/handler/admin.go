@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+type ReconcileUseCase interface {
+	Reconcile(ctx context.Context) (*models.ReconciliationReport, error)
+}
+
+type FxRateUseCase interface {
+	LoadRates(ctx context.Context, rates []models.FxRate) error
+}
+
+type AdminHandler struct {
+	pb.UnimplementedAdminServiceServer
+
+	reconciler ReconcileUseCase
+	fxRates    FxRateUseCase
+
+	log *zap.Logger
+}
+
+func NewAdminHandler(reconciler ReconcileUseCase, fxRates FxRateUseCase, log *zap.Logger) *AdminHandler {
+	return &AdminHandler{
+		reconciler: reconciler,
+		fxRates:    fxRates,
+		log:        log,
+	}
+}
+
+func (h *AdminHandler) Reconcile(ctx context.Context, _ *pb.ReconcileRequest) (*pb.ReconcileResponse, error) {
+	report, err := h.reconciler.Reconcile(ctx)
+	if err != nil {
+		h.log.Error("Reconciliation failed", zap.Error(err))
+		return nil, status.Error(codes.Internal, "internal system error")
+	}
+
+	resp := &pb.ReconcileResponse{
+		Consistent:       report.Consistent(),
+		AccountsChecked:  int32(report.AccountsChecked),
+		TransfersChecked: int32(report.TransfersChecked),
+	}
+
+	for _, m := range report.Mismatches {
+		resp.Mismatches = append(resp.Mismatches, &pb.BalanceMismatch{
+			AccountId:       m.AccountID,
+			ExpectedBalance: m.Expected.String(),
+			ActualBalance:   m.Actual.String(),
+		})
+	}
+
+	for _, b := range report.BrokenChains {
+		resp.BrokenChains = append(resp.BrokenChains, &pb.ChainBreak{
+			AccountId:  b.AccountID,
+			TransferId: b.TransferID,
+			Reason:     b.Reason,
+			Expected:   b.Expected.String(),
+			Actual:     b.Actual.String(),
+		})
+	}
+
+	return resp, nil
+}
+
+func (h *AdminHandler) LoadFxRates(ctx context.Context, req *pb.LoadFxRatesRequest) (*pb.LoadFxRatesResponse, error) {
+	rates := make([]models.FxRate, 0, len(req.Rates))
+	for _, r := range req.Rates {
+		rate, err := decimal.NewFromString(r.Rate)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid rate format")
+		}
+		effectiveAt, err := parseOptionalTime(r.EffectiveAt)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, constants.ErrInvalidTimestamp.Error())
+		}
+		rates = append(rates, models.FxRate{
+			Base:        r.BaseCurrency,
+			Quote:       r.QuoteCurrency,
+			Rate:        rate,
+			EffectiveAt: effectiveAt,
+		})
+	}
+
+	if err := h.fxRates.LoadRates(ctx, rates); err != nil {
+		if errors.Is(err, constants.ErrInvalidFxRate) || errors.Is(err, constants.ErrUnsupportedCurrency) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		h.log.Error("FX rate load failed", zap.Error(err))
+		return nil, status.Error(codes.Internal, "internal system error")
+	}
+
+	return &pb.LoadFxRatesResponse{Loaded: int32(len(rates))}, nil
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/core/handler/mocks"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+func TestAdminHandler_Reconcile(t *testing.T) {
+	logger := zap.NewNop()
+
+	t.Run("Success: Report Translated", func(t *testing.T) {
+		mockRec := new(mocks.MockReconciler)
+		h := NewAdminHandler(mockRec, nil, logger)
+
+		mockRec.On("Reconcile", mock.Anything).Return(&models.ReconciliationReport{
+			AccountsChecked:  2,
+			TransfersChecked: 5,
+			Mismatches: []models.BalanceMismatch{
+				{AccountID: 1, Expected: decimal.NewFromInt(20), Actual: decimal.NewFromInt(30)},
+			},
+			BrokenChains: []models.ChainBreak{
+				{AccountID: 1, TransferID: 2, Reason: models.ChainBreakPrevBalance, Expected: decimal.NewFromInt(50), Actual: decimal.NewFromInt(60)},
+			},
+		}, nil)
+
+		resp, err := h.Reconcile(context.Background(), &pb.ReconcileRequest{})
+
+		assert.NoError(t, err)
+		assert.False(t, resp.Consistent)
+		assert.Equal(t, int32(5), resp.TransfersChecked)
+		assert.Equal(t, "20", resp.Mismatches[0].ExpectedBalance)
+		assert.Equal(t, "prev_balance_mismatch", resp.BrokenChains[0].Reason)
+	})
+
+	t.Run("Failure: Reconciler Error", func(t *testing.T) {
+		mockRec := new(mocks.MockReconciler)
+		h := NewAdminHandler(mockRec, nil, logger)
+
+		mockRec.On("Reconcile", mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := h.Reconcile(context.Background(), &pb.ReconcileRequest{})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.Internal, st.Code())
+	})
+}
+
+func TestAdminHandler_LoadFxRates(t *testing.T) {
+	logger := zap.NewNop()
+
+	t.Run("Success: Rates Translated", func(t *testing.T) {
+		mockFx := new(mocks.MockFxRateService)
+		h := NewAdminHandler(nil, mockFx, logger)
+
+		mockFx.On("LoadRates", mock.Anything, mock.MatchedBy(func(rates []models.FxRate) bool {
+			return len(rates) == 2 &&
+				rates[0].Base == "USD" && rates[0].Quote == "EUR" && rates[0].Rate.String() == "0.92" &&
+				rates[0].EffectiveAt.IsZero() &&
+				rates[1].EffectiveAt.Equal(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC))
+		})).Return(nil)
+
+		resp, err := h.LoadFxRates(context.Background(), &pb.LoadFxRatesRequest{Rates: []*pb.FxRate{
+			{BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: "0.92"},
+			{BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: "1.087", EffectiveAt: "2026-01-02T00:00:00Z"},
+		}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), resp.Loaded)
+		mockFx.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Malformed Rate", func(t *testing.T) {
+		mockFx := new(mocks.MockFxRateService)
+		h := NewAdminHandler(nil, mockFx, logger)
+
+		_, err := h.LoadFxRates(context.Background(), &pb.LoadFxRatesRequest{Rates: []*pb.FxRate{
+			{BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: "abc"},
+		}})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		mockFx.AssertNotCalled(t, "LoadRates")
+	})
+
+	t.Run("Failure: Invalid Rate Rejected By Service", func(t *testing.T) {
+		mockFx := new(mocks.MockFxRateService)
+		h := NewAdminHandler(nil, mockFx, logger)
+
+		mockFx.On("LoadRates", mock.Anything, mock.Anything).Return(constants.ErrInvalidFxRate)
+
+		_, err := h.LoadFxRates(context.Background(), &pb.LoadFxRatesRequest{Rates: []*pb.FxRate{
+			{BaseCurrency: "USD", QuoteCurrency: "USD", Rate: "1"},
+		}})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+}
@@ -0,0 +1,208 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+// statusWithReason builds a gRPC status error carrying a google.rpc.ErrorInfo
+// so callers can distinguish errors that share a code.
+func statusWithReason(code codes.Code, msg, reason string) error {
+	return statusWithInfo(code, msg, &errdetails.ErrorInfo{Reason: reason, Domain: constants.ErrorDomain})
+}
+
+func statusWithInfo(code codes.Code, msg string, info *errdetails.ErrorInfo) error {
+	st := status.New(code, msg)
+	if detailed, err := st.WithDetails(info); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// limitError reports a broken transfer limit as ResourceExhausted, with the
+// limit and the allowance left on it in the ErrorInfo metadata.
+func limitError(err error) error {
+	info := &errdetails.ErrorInfo{Reason: constants.ReasonLimitExceeded, Domain: constants.ErrorDomain}
+	var limitErr *models.LimitError
+	if errors.As(err, &limitErr) {
+		info.Metadata = map[string]string{
+			"limit":     string(limitErr.Limit),
+			"remaining": limitErr.Remaining.String(),
+		}
+	}
+	return statusWithInfo(codes.ResourceExhausted, err.Error(), info)
+}
+
+// accountStateError translates account lifecycle errors, returning nil for any other error.
+func accountStateError(err error) error {
+	switch {
+	case errors.Is(err, constants.ErrAccountFrozen):
+		return statusWithReason(codes.FailedPrecondition, err.Error(), constants.ReasonAccountFrozen)
+
+	case errors.Is(err, constants.ErrAccountClosed):
+		return statusWithReason(codes.FailedPrecondition, err.Error(), constants.ReasonAccountClosed)
+
+	case errors.Is(err, constants.ErrAccountBalanceNotZero):
+		return statusWithReason(codes.FailedPrecondition, err.Error(), constants.ReasonBalanceNotZero)
+
+	case errors.Is(err, constants.ErrAccountHasHolds):
+		return statusWithReason(codes.FailedPrecondition, err.Error(), constants.ReasonHoldsOutstanding)
+
+	case errors.Is(err, constants.ErrInvalidStatusTransition):
+		return statusWithReason(codes.FailedPrecondition, err.Error(), constants.ReasonInvalidStatusTransition)
+	}
+	return nil
+}
+
+// transferError translates errors from moving money: transfers, reversals and
+// the authorize, capture and void steps of a hold.
+func transferError(err error) error {
+	switch {
+	case errors.Is(err, constants.ErrAccountNotFound):
+		return status.Error(codes.NotFound, "account not found")
+
+	case errors.Is(err, constants.ErrInsufficientFunds):
+		return status.Error(codes.FailedPrecondition, "insufficient funds")
+
+	case errors.Is(err, constants.ErrSameAccount):
+		return status.Error(codes.InvalidArgument, "source and destination cannot be same")
+
+	case errors.Is(err, constants.ErrIdempotencyKeyReused):
+		return status.Error(codes.AlreadyExists, constants.ErrIdempotencyKeyReused.Error())
+
+	case errors.Is(err, constants.ErrCurrencyMismatch):
+		return statusWithReason(codes.FailedPrecondition, err.Error(), constants.ReasonCurrencyMismatch)
+
+	case errors.Is(err, constants.ErrFxRateNotFound):
+		return statusWithReason(codes.FailedPrecondition, err.Error(), constants.ReasonFxRateNotFound)
+
+	case errors.Is(err, constants.ErrSettlementMissing),
+		errors.Is(err, constants.ErrFeeNotSupported):
+		return status.Error(codes.FailedPrecondition, err.Error())
+
+	case errors.Is(err, constants.ErrUnsupportedCurrency),
+		errors.Is(err, constants.ErrInvalidAmountScale),
+		errors.Is(err, constants.ErrConvertedAmountTooSmall),
+		errors.Is(err, constants.ErrAmountMustBePositive),
+		errors.Is(err, constants.ErrInvalidAccountID),
+		errors.Is(err, constants.ErrInvalidIdempotencyKey),
+		errors.Is(err, constants.ErrInvalidHoldID),
+		errors.Is(err, constants.ErrInvalidHoldExpiry),
+		errors.Is(err, constants.ErrCaptureExceedsHold),
+		errors.Is(err, constants.ErrHoldCurrencyMismatch),
+		errors.Is(err, constants.ErrBatchIdempotencyKey),
+		errors.Is(err, constants.ErrInvalidLegs),
+		errors.Is(err, constants.ErrTooManyLegs),
+		errors.Is(err, constants.ErrUnbalancedLegs),
+		errors.Is(err, constants.ErrDuplicateLegAccount),
+		errors.Is(err, constants.ErrInvalidTransferID),
+		errors.Is(err, constants.ErrReversalTooLarge),
+		errors.Is(err, constants.ErrInvalidReversalReason):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, constants.ErrHoldNotFound):
+		return status.Error(codes.NotFound, "hold not found")
+
+	case errors.Is(err, constants.ErrHoldNotActive):
+		return statusWithReason(codes.FailedPrecondition, err.Error(), constants.ReasonHoldNotActive)
+
+	case errors.Is(err, constants.ErrHoldExpired):
+		return statusWithReason(codes.FailedPrecondition, err.Error(), constants.ReasonHoldExpired)
+
+	case errors.Is(err, constants.ErrTransferNotFound):
+		return status.Error(codes.NotFound, "transfer not found")
+
+	case errors.Is(err, constants.ErrTransferNotReversible):
+		return statusWithReason(codes.FailedPrecondition, err.Error(), constants.ReasonTransferNotReversible)
+
+	case errors.Is(err, constants.ErrLimitExceeded):
+		return limitError(err)
+
+	case accountStateError(err) != nil:
+		return accountStateError(err)
+
+	default:
+		return status.Error(codes.Internal, "internal system error")
+	}
+}
+
+// scheduleError translates errors from scheduling transfers, falling back to
+// transferError for the pre-checks they share with a transfer.
+func scheduleError(err error) error {
+	switch {
+	case errors.Is(err, constants.ErrInvalidSchedule),
+		errors.Is(err, constants.ErrInvalidStartAt),
+		errors.Is(err, constants.ErrInvalidScheduleID):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, constants.ErrScheduleNotFound):
+		return status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, constants.ErrScheduleNotActive):
+		return statusWithReason(codes.FailedPrecondition, err.Error(), constants.ReasonScheduleNotActive)
+
+	default:
+		return transferError(err)
+	}
+}
+
+// webhookError translates errors from managing webhook subscriptions.
+func webhookError(err error) error {
+	switch {
+	case errors.Is(err, constants.ErrInvalidWebhookURL),
+		errors.Is(err, constants.ErrWebhookURLNotAllowed),
+		errors.Is(err, constants.ErrInvalidWebhookSecret),
+		errors.Is(err, constants.ErrInvalidEventTypes),
+		errors.Is(err, constants.ErrInvalidWebhookID),
+		errors.Is(err, constants.ErrInvalidDeadLetterID),
+		errors.Is(err, constants.ErrInvalidAccountID):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, constants.ErrAccountNotFound):
+		return status.Error(codes.NotFound, "account not found")
+
+	case errors.Is(err, constants.ErrWebhookNotFound),
+		errors.Is(err, constants.ErrDeadLetterNotFound):
+		return status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, constants.ErrWebhookNotActive):
+		return statusWithReason(codes.FailedPrecondition, err.Error(), constants.ReasonWebhookNotActive)
+
+	default:
+		return status.Error(codes.Internal, "internal system error")
+	}
+}
+
+// batchError translates a failed batch. A leg error keeps the status the leg
+// would have failed with on its own, with the leg index in the message.
+func batchError(err error) error {
+	switch {
+	case errors.Is(err, constants.ErrEmptyBatch), errors.Is(err, constants.ErrBatchTooLarge):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var legErr *models.LegError
+	if !errors.As(err, &legErr) {
+		return transferError(err)
+	}
+	st := status.Convert(transferError(legErr.Err)).Proto()
+	st.Message = fmt.Sprintf("leg %d: %s", legErr.Index, st.Message)
+	return status.FromProto(st).Err()
+}
+
+// errorReason returns the ErrorInfo reason carried by st, if any.
+func errorReason(st *status.Status) string {
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.GetDomain() == constants.ErrorDomain {
+			return info.GetReason()
+		}
+	}
+	return ""
+}
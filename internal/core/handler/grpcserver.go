@@ -3,13 +3,14 @@ package handler
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
-	"github.com/jhaprabhatt/account-transfer-project/internal/core/interceptors"
 
 	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/jhaprabhatt/account-transfer-project/internal/models"
@@ -18,10 +19,32 @@ import (
 
 type TransferUseCase interface {
 	MakeTransfer(ctx context.Context, req *models.TransferRequest) (*models.TransferResult, error)
+	GetTransfer(ctx context.Context, id int64) (*models.Transfer, error)
+	ListTransfers(ctx context.Context, q models.TransferQuery) (*models.TransferPage, error)
+	AuthorizeTransfer(ctx context.Context, req *models.AuthorizeRequest) (*models.Hold, error)
+	CaptureTransfer(ctx context.Context, req *models.CaptureRequest) (*models.TransferResult, error)
+	VoidTransfer(ctx context.Context, id int64) (*models.Hold, error)
+	ReverseTransfer(ctx context.Context, req *models.ReversalRequest) (*models.Reversal, error)
+	MakeBatchTransfer(ctx context.Context, batch *models.BatchTransferRequest) error
+	MakeMultiLegTransfer(ctx context.Context, req *models.MultiLegTransferRequest) (*models.MultiLegResult, error)
+	Deposit(ctx context.Context, req *models.CashRequest) (*models.CashResult, error)
+	Withdraw(ctx context.Context, req *models.CashRequest) (*models.CashResult, error)
+	WatchCursor(ctx context.Context, accountID, sinceTransferID int64) (models.TransferCursor, error)
+	WatchTransfers(ctx context.Context, cursor models.TransferCursor, send func(*models.Transfer) error) error
 }
 
 type AccountUseCase interface {
 	CreateAccount(ctx context.Context, acc *models.Account) error
+	GetAccount(ctx context.Context, id int64) (*models.Account, error)
+	FreezeAccount(ctx context.Context, id int64) (*models.Account, error)
+	UnfreezeAccount(ctx context.Context, id int64) (*models.Account, error)
+	CloseAccount(ctx context.Context, id int64) (*models.Account, error)
+	UpdateOverdraftLimit(ctx context.Context, id int64, limit decimal.Decimal) (*models.Account, error)
+	GetBalanceAt(ctx context.Context, id int64, at time.Time) (*models.BalanceAt, error)
+	GetStatement(ctx context.Context, q models.StatementQuery) (*models.Statement, error)
+	SetLimits(ctx context.Context, id int64, limits models.TransferLimits) (*models.AccountLimits, error)
+	GetLimits(ctx context.Context, id int64) (*models.AccountLimits, error)
+	ClearLimits(ctx context.Context, id int64) (*models.AccountLimits, error)
 }
 
 type GrpcHandler struct {
@@ -49,9 +72,19 @@ func (h *GrpcHandler) CreateAccount(ctx context.Context, req *pb.CreateAccountRe
 		return nil, status.Error(codes.InvalidArgument, "invalid balance format")
 	}
 
+	var overdraft decimal.Decimal
+	if req.OverdraftLimit != "" {
+		if overdraft, err = decimal.NewFromString(req.OverdraftLimit); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid overdraft_limit format")
+		}
+	}
+
 	acc := &models.Account{
-		ID:      req.AccountId,
-		Balance: balance,
+		ID:             req.AccountId,
+		Balance:        balance,
+		Currency:       req.Currency,
+		Class:          req.AccountClass,
+		OverdraftLimit: overdraft,
 	}
 
 	if err := h.accountService.CreateAccount(ctx, acc); err != nil {
@@ -61,8 +94,12 @@ func (h *GrpcHandler) CreateAccount(ctx context.Context, req *pb.CreateAccountRe
 			return nil, status.Error(codes.AlreadyExists, "account already exists")
 		}
 
-		if errors.Is(err, constants.ErrAmountMustNotBeNegative) {
-			return nil, status.Error(codes.InvalidArgument, "")
+		if errors.Is(err, constants.ErrAmountMustNotBeNegative) ||
+			errors.Is(err, constants.ErrUnsupportedCurrency) ||
+			errors.Is(err, constants.ErrInvalidAmountScale) ||
+			errors.Is(err, constants.ErrInvalidAccountClass) ||
+			errors.Is(err, constants.ErrInvalidOverdraftLimit) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 
 		return nil, status.Error(codes.Internal, "internal system error")
@@ -71,8 +108,272 @@ func (h *GrpcHandler) CreateAccount(ctx context.Context, req *pb.CreateAccountRe
 	return &pb.CreateAccountResponse{Success: true}, nil
 }
 
+func (h *GrpcHandler) GetAccount(ctx context.Context, req *pb.GetAccountRequest) (*pb.GetAccountResponse, error) {
+	if req.AccountId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, constants.ErrInvalidAccountID.Error())
+	}
+
+	acc, err := h.accountService.GetAccount(ctx, req.AccountId)
+	if err != nil {
+		if errors.Is(err, constants.ErrAccountNotFound) {
+			return nil, status.Error(codes.NotFound, "account not found")
+		}
+
+		h.log.Error("Failed to get account", zap.Int64("account_id", req.AccountId), zap.Error(err))
+		return nil, status.Error(codes.Internal, "internal system error")
+	}
+
+	return toPbAccount(acc), nil
+}
+
+func (h *GrpcHandler) UpdateAccount(ctx context.Context, req *pb.UpdateAccountRequest) (*pb.GetAccountResponse, error) {
+	if req.AccountId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, constants.ErrInvalidAccountID.Error())
+	}
+
+	limit, err := decimal.NewFromString(req.OverdraftLimit)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid overdraft_limit format")
+	}
+
+	acc, err := h.accountService.UpdateOverdraftLimit(ctx, req.AccountId, limit)
+	if err != nil {
+		h.log.Warn("Account update failed", zap.Int64("account_id", req.AccountId), zap.Error(err))
+
+		if stErr := accountStateError(err); stErr != nil {
+			return nil, stErr
+		}
+		switch {
+		case errors.Is(err, constants.ErrAccountNotFound):
+			return nil, status.Error(codes.NotFound, "account not found")
+		case errors.Is(err, constants.ErrInvalidOverdraftLimit), errors.Is(err, constants.ErrInvalidAmountScale):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, constants.ErrOverdraftLimitTooLow):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "internal system error")
+	}
+
+	return toPbAccount(acc), nil
+}
+
+func (h *GrpcHandler) GetBalanceAt(ctx context.Context, req *pb.GetBalanceAtRequest) (*pb.GetBalanceAtResponse, error) {
+	at, err := time.Parse(time.RFC3339, req.At)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, constants.ErrInvalidTimestamp.Error())
+	}
+
+	balance, err := h.accountService.GetBalanceAt(ctx, req.AccountId, at)
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrInvalidAccountID), errors.Is(err, constants.ErrBalanceTimeInFuture):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, constants.ErrAccountNotFound):
+			return nil, status.Error(codes.NotFound, "account not found")
+		case errors.Is(err, constants.ErrAccountNotOpenYet):
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		h.log.Error("Failed to get balance at time", zap.Int64("account_id", req.AccountId), zap.Error(err))
+		return nil, status.Error(codes.Internal, "internal system error")
+	}
+
+	return &pb.GetBalanceAtResponse{
+		AccountId:  balance.AccountID,
+		Balance:    balance.Balance.String(),
+		Currency:   balance.Currency,
+		At:         balance.At.UTC().Format(time.RFC3339Nano),
+		TransferId: balance.TransferID,
+	}, nil
+}
+
+func (h *GrpcHandler) GetStatement(ctx context.Context, req *pb.GetStatementRequest) (*pb.GetStatementResponse, error) {
+	from, err := time.Parse(time.RFC3339, req.From)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, constants.ErrInvalidTimestamp.Error())
+	}
+	to, err := time.Parse(time.RFC3339, req.To)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, constants.ErrInvalidTimestamp.Error())
+	}
+
+	st, err := h.accountService.GetStatement(ctx, models.StatementQuery{AccountID: req.AccountId, From: from, To: to})
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrInvalidAccountID),
+			errors.Is(err, constants.ErrInvalidTimeRange),
+			errors.Is(err, constants.ErrStatementRangeTooLong):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+
+		case errors.Is(err, constants.ErrAccountNotFound):
+			return nil, status.Error(codes.NotFound, "account not found")
+
+		default:
+			h.log.Error("Failed to get statement", zap.Int64("account_id", req.AccountId), zap.Error(err))
+			return nil, status.Error(codes.Internal, "internal system error")
+		}
+	}
+
+	return toPbStatement(st), nil
+}
+
+func (h *GrpcHandler) SetAccountLimits(ctx context.Context, req *pb.SetAccountLimitsRequest) (*pb.AccountLimitsResponse, error) {
+	limits := models.TransferLimits{DailyCount: req.DailyCount, MonthlyCount: req.MonthlyCount}
+	for _, f := range []struct {
+		value string
+		dst   *decimal.NullDecimal
+	}{
+		{req.MaxSingle, &limits.MaxSingle},
+		{req.DailyAmount, &limits.DailyAmount},
+		{req.MonthlyAmount, &limits.MonthlyAmount},
+	} {
+		if f.value == "" {
+			continue
+		}
+		amount, err := decimal.NewFromString(f.value)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, constants.ErrInvalidLimit.Error())
+		}
+		*f.dst = decimal.NewNullDecimal(amount)
+	}
+
+	set, err := h.accountService.SetLimits(ctx, req.AccountId, limits)
+	if err != nil {
+		return nil, h.accountLimitsError(err, req.AccountId)
+	}
+	return toPbAccountLimits(set), nil
+}
+
+func (h *GrpcHandler) GetAccountLimits(ctx context.Context, req *pb.AccountLimitsRequest) (*pb.AccountLimitsResponse, error) {
+	limits, err := h.accountService.GetLimits(ctx, req.AccountId)
+	if err != nil {
+		return nil, h.accountLimitsError(err, req.AccountId)
+	}
+	return toPbAccountLimits(limits), nil
+}
+
+func (h *GrpcHandler) ClearAccountLimits(ctx context.Context, req *pb.AccountLimitsRequest) (*pb.AccountLimitsResponse, error) {
+	limits, err := h.accountService.ClearLimits(ctx, req.AccountId)
+	if err != nil {
+		return nil, h.accountLimitsError(err, req.AccountId)
+	}
+	return toPbAccountLimits(limits), nil
+}
+
+func (h *GrpcHandler) accountLimitsError(err error, accountID int64) error {
+	if stErr := accountStateError(err); stErr != nil {
+		return stErr
+	}
+	switch {
+	case errors.Is(err, constants.ErrAccountNotFound):
+		return status.Error(codes.NotFound, "account not found")
+	case errors.Is(err, constants.ErrInvalidAccountID),
+		errors.Is(err, constants.ErrInvalidLimit),
+		errors.Is(err, constants.ErrInvalidAmountScale):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	h.log.Error("Account limits request failed", zap.Int64("account_id", accountID), zap.Error(err))
+	return status.Error(codes.Internal, "internal system error")
+}
+
+func toPbAccountLimits(l *models.AccountLimits) *pb.AccountLimitsResponse {
+	resp := &pb.AccountLimitsResponse{
+		AccountId:    l.AccountID,
+		DailyCount:   l.Limits.DailyCount,
+		MonthlyCount: l.Limits.MonthlyCount,
+	}
+	if l.Limits.MaxSingle.Valid {
+		resp.MaxSingle = l.Limits.MaxSingle.Decimal.String()
+	}
+	if l.Limits.DailyAmount.Valid {
+		resp.DailyAmount = l.Limits.DailyAmount.Decimal.String()
+	}
+	if l.Limits.MonthlyAmount.Valid {
+		resp.MonthlyAmount = l.Limits.MonthlyAmount.Decimal.String()
+	}
+	return resp
+}
+
+func toPbStatement(st *models.Statement) *pb.GetStatementResponse {
+	resp := &pb.GetStatementResponse{
+		AccountId:      st.AccountID,
+		Currency:       st.Currency,
+		From:           st.From.UTC().Format(time.RFC3339Nano),
+		To:             st.To.UTC().Format(time.RFC3339Nano),
+		OpeningBalance: st.OpeningBalance.String(),
+		ClosingBalance: st.ClosingBalance.String(),
+		TotalDebits:    st.TotalDebits.String(),
+		TotalCredits:   st.TotalCredits.String(),
+	}
+	for _, l := range st.Lines {
+		line := &pb.StatementLine{
+			TransferId:     l.TransferID,
+			PostedAt:       l.PostedAt.UTC().Format(time.RFC3339Nano),
+			Type:           l.Type,
+			CounterpartyId: l.CounterpartyID,
+			Balance:        l.Balance.String(),
+		}
+		if l.Debit.IsPositive() {
+			line.Debit = l.Debit.String()
+		} else {
+			line.Credit = l.Credit.String()
+		}
+		resp.Lines = append(resp.Lines, line)
+	}
+	return resp
+}
+
+func toPbAccount(acc *models.Account) *pb.GetAccountResponse {
+	return &pb.GetAccountResponse{
+		AccountId:        acc.ID,
+		Balance:          acc.Balance.String(),
+		Status:           acc.Status.String(),
+		Currency:         acc.Currency,
+		HeldBalance:      acc.HeldBalance.String(),
+		AvailableBalance: acc.Available().String(),
+		AccountClass:     acc.Class,
+		OverdraftLimit:   acc.OverdraftLimit.String(),
+	}
+}
+
+func (h *GrpcHandler) FreezeAccount(ctx context.Context, req *pb.AccountStatusRequest) (*pb.AccountStatusResponse, error) {
+	return h.changeAccountStatus(ctx, req, h.accountService.FreezeAccount)
+}
+
+func (h *GrpcHandler) UnfreezeAccount(ctx context.Context, req *pb.AccountStatusRequest) (*pb.AccountStatusResponse, error) {
+	return h.changeAccountStatus(ctx, req, h.accountService.UnfreezeAccount)
+}
+
+func (h *GrpcHandler) CloseAccount(ctx context.Context, req *pb.AccountStatusRequest) (*pb.AccountStatusResponse, error) {
+	return h.changeAccountStatus(ctx, req, h.accountService.CloseAccount)
+}
+
+func (h *GrpcHandler) changeAccountStatus(
+	ctx context.Context,
+	req *pb.AccountStatusRequest,
+	change func(ctx context.Context, id int64) (*models.Account, error),
+) (*pb.AccountStatusResponse, error) {
+	if req.AccountId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, constants.ErrInvalidAccountID.Error())
+	}
+
+	acc, err := change(ctx, req.AccountId)
+	if err != nil {
+		h.log.Warn("Account status change failed", zap.Int64("account_id", req.AccountId), zap.Error(err))
+
+		if stErr := accountStateError(err); stErr != nil {
+			return nil, stErr
+		}
+		if errors.Is(err, constants.ErrAccountNotFound) {
+			return nil, status.Error(codes.NotFound, "account not found")
+		}
+		return nil, status.Error(codes.Internal, "internal system error")
+	}
+
+	return &pb.AccountStatusResponse{AccountId: acc.ID, Status: acc.Status.String()}, nil
+}
+
 func (h *GrpcHandler) MakeTransfer(ctx context.Context, req *pb.TransferRequest) (*pb.TransferResponse, error) {
-	correlationID, _ := ctx.Value(interceptors.CorrelationKey).(int64)
+	correlationID, _ := ctx.Value(constants.CorrelationKey).(int64)
 
 	amount, err := decimal.NewFromString(req.Amount)
 	if err != nil {
@@ -83,9 +384,12 @@ func (h *GrpcHandler) MakeTransfer(ctx context.Context, req *pb.TransferRequest)
 	}
 
 	modelReq := &models.TransferRequest{
-		SourceID:      req.SourceId,
-		DestinationID: req.DestinationId,
-		Amount:        amount,
+		SourceID:       req.SourceId,
+		DestinationID:  req.DestinationId,
+		Amount:         amount,
+		Currency:       req.Currency,
+		Convert:        req.Convert,
+		IdempotencyKey: req.IdempotencyKey,
 	}
 
 	result, err := h.transferService.MakeTransfer(ctx, modelReq)
@@ -93,29 +397,387 @@ func (h *GrpcHandler) MakeTransfer(ctx context.Context, req *pb.TransferRequest)
 		h.log.Error("Transfer execution failed",
 			zap.Error(err),
 			zap.Int64("correlation_id", correlationID))
+		return nil, transferError(err)
+	}
+
+	if result != nil {
+		return toPbTransferResponse(result), nil
+	}
+
+	return nil, err
+}
+
+func (h *GrpcHandler) MakeBatchTransfer(ctx context.Context, req *pb.BatchTransferRequest) (*pb.BatchTransferResponse, error) {
+	batch := &models.BatchTransferRequest{
+		Legs:       make([]models.BatchLeg, len(req.Legs)),
+		BestEffort: req.BestEffort,
+	}
+	for i, leg := range req.Legs {
+		amount, err := decimal.NewFromString(leg.Amount)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "leg %d: invalid amount format", i)
+		}
+		batch.Legs[i].TransferRequest = models.TransferRequest{
+			SourceID:       leg.SourceId,
+			DestinationID:  leg.DestinationId,
+			Amount:         amount,
+			Currency:       leg.Currency,
+			Convert:        leg.Convert,
+			IdempotencyKey: leg.IdempotencyKey,
+		}
+	}
+
+	if err := h.transferService.MakeBatchTransfer(ctx, batch); err != nil {
+		h.log.Error("Batch transfer failed", zap.Int("legs", len(req.Legs)), zap.Error(err))
+		return nil, batchError(err)
+	}
+
+	resp := &pb.BatchTransferResponse{Results: make([]*pb.BatchLegResult, len(batch.Legs))}
+	for i, leg := range batch.Legs {
+		result := &pb.BatchLegResult{Index: int32(i)}
+		if leg.Err != nil {
+			st := status.Convert(transferError(leg.Err))
+			result.Error = st.Message()
+			result.ErrorCode = st.Code().String()
+			result.ErrorReason = errorReason(st)
+			resp.Failed++
+		} else {
+			result.Transfer = toPbTransferResponse(leg.Result)
+			resp.Succeeded++
+		}
+		resp.Results[i] = result
+	}
+
+	return resp, nil
+}
+
+func (h *GrpcHandler) MakeMultiLegTransfer(ctx context.Context, req *pb.MultiLegTransferRequest) (*pb.MultiLegTransferResponse, error) {
+	debits, err := toModelLegs(req.Debits)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid amount format")
+	}
+	credits, err := toModelLegs(req.Credits)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid amount format")
+	}
+
+	result, err := h.transferService.MakeMultiLegTransfer(ctx, &models.MultiLegTransferRequest{
+		Debits:   debits,
+		Credits:  credits,
+		Currency: req.Currency,
+	})
+	if err != nil {
+		h.log.Error("Multi-leg transfer failed",
+			zap.Int("debits", len(req.Debits)),
+			zap.Int("credits", len(req.Credits)),
+			zap.Error(err))
+		return nil, transferError(err)
+	}
+
+	resp := &pb.MultiLegTransferResponse{GroupId: result.GroupID}
+	for i := range result.Transfers {
+		resp.Transfers = append(resp.Transfers, toPbTransferResponse(&result.Transfers[i]))
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) Deposit(ctx context.Context, req *pb.CashRequest) (*pb.CashResponse, error) {
+	return h.moveCash(ctx, req, h.transferService.Deposit)
+}
+
+func (h *GrpcHandler) Withdraw(ctx context.Context, req *pb.CashRequest) (*pb.CashResponse, error) {
+	return h.moveCash(ctx, req, h.transferService.Withdraw)
+}
+
+func (h *GrpcHandler) moveCash(
+	ctx context.Context,
+	req *pb.CashRequest,
+	move func(ctx context.Context, req *models.CashRequest) (*models.CashResult, error),
+) (*pb.CashResponse, error) {
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid amount format")
+	}
+
+	result, err := move(ctx, &models.CashRequest{
+		AccountID:      req.AccountId,
+		Amount:         amount,
+		Currency:       req.Currency,
+		IdempotencyKey: req.IdempotencyKey,
+	})
+	if err != nil {
+		h.log.Error("Cash movement failed", zap.Int64("account_id", req.AccountId), zap.Error(err))
+		return nil, transferError(err)
+	}
+
+	return &pb.CashResponse{
+		AuditId:    result.AuditID,
+		AccountId:  result.AccountID,
+		Kind:       result.Kind.String(),
+		Amount:     result.Amount,
+		Currency:   result.Currency,
+		NewBalance: result.NewBalance,
+	}, nil
+}
+
+func (h *GrpcHandler) AuthorizeTransfer(ctx context.Context, req *pb.AuthorizeTransferRequest) (*pb.HoldResponse, error) {
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid amount format")
+	}
+	expiresAt, err := parseOptionalTime(req.ExpiresAt)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, constants.ErrInvalidTimestamp.Error())
+	}
+
+	hold, err := h.transferService.AuthorizeTransfer(ctx, &models.AuthorizeRequest{
+		TransferRequest: models.TransferRequest{
+			SourceID:      req.SourceId,
+			DestinationID: req.DestinationId,
+			Amount:        amount,
+			Currency:      req.Currency,
+		},
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		h.log.Error("Authorization failed", zap.Int64("source", req.SourceId), zap.Error(err))
+		return nil, transferError(err)
+	}
+
+	return &pb.HoldResponse{Hold: toPbHold(hold)}, nil
+}
+
+func (h *GrpcHandler) CaptureTransfer(ctx context.Context, req *pb.CaptureTransferRequest) (*pb.TransferResponse, error) {
+	var amount decimal.Decimal
+	if req.Amount != "" {
+		parsed, err := decimal.NewFromString(req.Amount)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid amount format")
+		}
+		amount = parsed
+	}
+
+	result, err := h.transferService.CaptureTransfer(ctx, &models.CaptureRequest{HoldID: req.HoldId, Amount: amount})
+	if err != nil {
+		h.log.Error("Capture failed", zap.Int64("hold_id", req.HoldId), zap.Error(err))
+		return nil, transferError(err)
+	}
+
+	return toPbTransferResponse(result), nil
+}
+
+func (h *GrpcHandler) VoidTransfer(ctx context.Context, req *pb.VoidTransferRequest) (*pb.HoldResponse, error) {
+	hold, err := h.transferService.VoidTransfer(ctx, req.HoldId)
+	if err != nil {
+		h.log.Error("Void failed", zap.Int64("hold_id", req.HoldId), zap.Error(err))
+		return nil, transferError(err)
+	}
+
+	return &pb.HoldResponse{Hold: toPbHold(hold)}, nil
+}
+
+func (h *GrpcHandler) ReverseTransfer(ctx context.Context, req *pb.ReverseTransferRequest) (*pb.ReverseTransferResponse, error) {
+	var amount decimal.Decimal
+	if req.Amount != "" {
+		parsed, err := decimal.NewFromString(req.Amount)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid amount format")
+		}
+		amount = parsed
+	}
+
+	reversal, err := h.transferService.ReverseTransfer(ctx, &models.ReversalRequest{
+		TransferID:     req.TransferId,
+		Amount:         amount,
+		Reason:         req.Reason,
+		IdempotencyKey: req.IdempotencyKey,
+	})
+	if err != nil {
+		h.log.Error("Reversal failed", zap.Int64("transfer_id", req.TransferId), zap.Error(err))
+		return nil, transferError(err)
+	}
+
+	return &pb.ReverseTransferResponse{
+		Reversal: toPbTransfer(&reversal.Reversal),
+		Original: toPbTransfer(&reversal.Original),
+	}, nil
+}
+
+func (h *GrpcHandler) GetTransfer(ctx context.Context, req *pb.GetTransferRequest) (*pb.GetTransferResponse, error) {
+	t, err := h.transferService.GetTransfer(ctx, req.TransferId)
+	if err != nil {
 		switch {
+		case errors.Is(err, constants.ErrInvalidTransferID):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+
+		case errors.Is(err, constants.ErrTransferNotFound):
+			return nil, status.Error(codes.NotFound, "transfer not found")
+
+		default:
+			h.log.Error("Failed to get transfer", zap.Int64("transfer_id", req.TransferId), zap.Error(err))
+			return nil, status.Error(codes.Internal, "internal system error")
+		}
+	}
+
+	return &pb.GetTransferResponse{Transfer: toPbTransfer(t)}, nil
+}
+
+func (h *GrpcHandler) ListTransfers(ctx context.Context, req *pb.ListTransfersRequest) (*pb.ListTransfersResponse, error) {
+	from, err := parseOptionalTime(req.From)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, constants.ErrInvalidTimestamp.Error())
+	}
+	to, err := parseOptionalTime(req.To)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, constants.ErrInvalidTimestamp.Error())
+	}
+
+	q := models.TransferQuery{
+		AccountID: req.AccountId,
+		Direction: models.TransferDirection(req.Direction),
+		From:      from,
+		To:        to,
+		Cursor:    req.Cursor,
+		Limit:     int(req.Limit),
+	}
+
+	page, err := h.transferService.ListTransfers(ctx, q)
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrInvalidAccountID),
+			errors.Is(err, constants.ErrInvalidDirection),
+			errors.Is(err, constants.ErrInvalidTimeRange),
+			errors.Is(err, constants.ErrInvalidCursor):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+
 		case errors.Is(err, constants.ErrAccountNotFound):
 			return nil, status.Error(codes.NotFound, "account not found")
 
-		case errors.Is(err, constants.ErrInsufficientFunds):
-			return nil, status.Error(codes.FailedPrecondition, "insufficient funds")
+		default:
+			h.log.Error("Failed to list transfers", zap.Int64("account_id", req.AccountId), zap.Error(err))
+			return nil, status.Error(codes.Internal, "internal system error")
+		}
+	}
+
+	resp := &pb.ListTransfersResponse{NextCursor: page.NextCursor}
+	for i := range page.Transfers {
+		resp.Transfers = append(resp.Transfers, toPbTransfer(&page.Transfers[i]))
+	}
+	return resp, nil
+}
+
+// WatchTransfers streams the transfers posted to an account after
+// since_transfer_id, then new ones as they post, until the client goes away.
+// Headers are sent once the request is accepted, so a client can tell a
+// rejected watch from one that is just quiet.
+func (h *GrpcHandler) WatchTransfers(req *pb.WatchTransfersRequest, stream pb.TransferService_WatchTransfersServer) error {
+	ctx := stream.Context()
+
+	cursor, err := h.transferService.WatchCursor(ctx, req.AccountId, req.SinceTransferId)
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrInvalidAccountID),
+			errors.Is(err, constants.ErrInvalidTransferID):
+			return status.Error(codes.InvalidArgument, err.Error())
+
+		case errors.Is(err, constants.ErrAccountNotFound):
+			return status.Error(codes.NotFound, "account not found")
 
-		case errors.Is(err, constants.ErrSameAccount):
-			return nil, status.Error(codes.InvalidArgument, "source and destination cannot be same")
+		case errors.Is(err, constants.ErrTransferNotFound):
+			return status.Error(codes.NotFound, "transfer not found")
 
 		default:
-			return nil, status.Error(codes.Internal, "internal system error")
+			h.log.Error("Failed to start transfer watch", zap.Int64("account_id", req.AccountId), zap.Error(err))
+			return status.Error(codes.Internal, "internal system error")
 		}
 	}
 
-	if result != nil {
-		return &pb.TransferResponse{
-			Success:          true,
-			TransactionId:    result.CorrelationID,
-			AuditId:          result.AuditID,
-			NewSourceBalance: result.SourcePostBalance,
-		}, nil
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
 	}
 
-	return nil, err
+	err = h.transferService.WatchTransfers(ctx, cursor, func(t *models.Transfer) error {
+		return stream.Send(toPbTransfer(t))
+	})
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	h.log.Error("Transfer watch failed", zap.Int64("account_id", req.AccountId), zap.Error(err))
+	return status.Error(codes.Internal, "internal system error")
+}
+
+func toPbTransferResponse(result *models.TransferResult) *pb.TransferResponse {
+	return &pb.TransferResponse{
+		Success:             true,
+		TransactionId:       result.CorrelationID,
+		AuditId:             result.AuditID,
+		NewSourceBalance:    result.SourcePostBalance,
+		SourceAmount:        result.SourceAmount,
+		SourceCurrency:      result.SourceCurrency,
+		DestinationAmount:   result.DestinationAmount,
+		DestinationCurrency: result.DestinationCurrency,
+		FxRate:              result.FxRate,
+		Fee:                 result.Fee,
+	}
+}
+
+func toPbHold(h *models.Hold) *pb.Hold {
+	return &pb.Hold{
+		HoldId:         h.ID,
+		CorrelationId:  h.CorrelationID,
+		SourceId:       h.SourceID,
+		DestinationId:  h.DestinationID,
+		Amount:         h.Amount.String(),
+		CapturedAmount: h.CapturedAmount.String(),
+		Currency:       h.Currency,
+		Status:         h.Status.String(),
+		ExpiresAt:      h.ExpiresAt.UTC().Format(time.RFC3339Nano),
+		CreatedAt:      h.CreatedAt.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+func toPbTransfer(t *models.Transfer) *pb.Transfer {
+	return &pb.Transfer{
+		TransferId:             t.ID,
+		CorrelationId:          t.CorrelationID,
+		Status:                 t.Status.String(),
+		SourceId:               t.SourceID,
+		DestinationId:          t.DestinationID,
+		Amount:                 t.Amount.String(),
+		Currency:               t.Currency,
+		DestinationAmount:      t.DestinationAmount.String(),
+		DestinationCurrency:    t.DestinationCurrency,
+		FxRate:                 models.NullDecimalString(t.FxRate),
+		SourcePrevBalance:      t.SourcePrevBalance.String(),
+		SourcePostBalance:      t.SourcePostBalance.String(),
+		DestinationPrevBalance: t.DestinationPrevBalance.String(),
+		DestinationPostBalance: t.DestinationPostBalance.String(),
+		ReversalOf:             t.ReversalOf,
+		ReversedAmount:         t.ReversedAmount.String(),
+		Reason:                 t.Reason,
+		GroupId:                t.GroupID,
+		Fee:                    t.Fee.String(),
+		FeeOf:                  t.FeeOf,
+		Kind:                   t.Kind.String(),
+		CreatedAt:              t.CreatedAt.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+func toModelLegs(legs []*pb.TransferLeg) ([]models.Leg, error) {
+	out := make([]models.Leg, len(legs))
+	for i, leg := range legs {
+		amount, err := decimal.NewFromString(leg.Amount)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = models.Leg{AccountID: leg.AccountId, Amount: amount}
+	}
+	return out, nil
+}
+
+func parseOptionalTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, s)
 }
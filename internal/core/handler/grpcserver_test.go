@@ -4,17 +4,20 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
 	"github.com/jhaprabhatt/account-transfer-project/internal/core/handler/mocks"
-	"github.com/jhaprabhatt/account-transfer-project/internal/core/interceptors"
 	"github.com/jhaprabhatt/account-transfer-project/internal/models"
 	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
 )
@@ -27,7 +30,7 @@ func TestGrpcHandler_MakeTransfer(t *testing.T) {
 
 		h := NewGrpcHandler(nil, mockSvc, logger)
 
-		ctx := context.WithValue(context.Background(), interceptors.CorrelationKey, int64(12345))
+		ctx := context.WithValue(context.Background(), constants.CorrelationKey, int64(12345))
 
 		req := &pb.TransferRequest{
 			SourceId:      100,
@@ -101,6 +104,150 @@ func TestGrpcHandler_MakeTransfer(t *testing.T) {
 		assert.Equal(t, codes.FailedPrecondition, st.Code())
 	})
 
+	t.Run("Failure: Idempotency Key Reused (Translation Check)", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		req := &pb.TransferRequest{Amount: "50.00", IdempotencyKey: "key-1"}
+
+		mockSvc.On("MakeTransfer", mock.Anything, mock.MatchedBy(func(r *models.TransferRequest) bool {
+			return r.IdempotencyKey == "key-1"
+		})).Return(nil, constants.ErrIdempotencyKeyReused)
+
+		_, err := h.MakeTransfer(context.Background(), req)
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.AlreadyExists, st.Code())
+	})
+
+	t.Run("Failure: Account Frozen (Reason Attached)", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		req := &pb.TransferRequest{Amount: "50.00"}
+
+		mockSvc.On("MakeTransfer", mock.Anything, mock.Anything).
+			Return(nil, constants.ErrAccountFrozen)
+
+		_, err := h.MakeTransfer(context.Background(), req)
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, constants.ReasonAccountFrozen, errorInfoReason(st))
+	})
+
+	t.Run("Failure: Currency Mismatch (Reason Attached)", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		req := &pb.TransferRequest{Amount: "50.00", Currency: "EUR"}
+
+		mockSvc.On("MakeTransfer", mock.Anything, mock.MatchedBy(func(r *models.TransferRequest) bool {
+			return r.Currency == "EUR"
+		})).Return(nil, constants.ErrCurrencyMismatch)
+
+		_, err := h.MakeTransfer(context.Background(), req)
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, constants.ReasonCurrencyMismatch, errorInfoReason(st))
+	})
+
+	t.Run("Success: Converted Amounts Returned", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		req := &pb.TransferRequest{SourceId: 100, DestinationId: 200, Amount: "100", Convert: true}
+
+		mockSvc.On("MakeTransfer", mock.Anything, mock.MatchedBy(func(r *models.TransferRequest) bool {
+			return r.Convert
+		})).Return(&models.TransferResult{
+			AuditID:             1,
+			SourceAmount:        "100",
+			SourceCurrency:      "USD",
+			DestinationAmount:   "92.34",
+			DestinationCurrency: "EUR",
+			FxRate:              "0.92345",
+		}, nil)
+
+		resp, err := h.MakeTransfer(context.Background(), req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "100", resp.SourceAmount)
+		assert.Equal(t, "USD", resp.SourceCurrency)
+		assert.Equal(t, "92.34", resp.DestinationAmount)
+		assert.Equal(t, "EUR", resp.DestinationCurrency)
+		assert.Equal(t, "0.92345", resp.FxRate)
+	})
+
+	t.Run("Success: Fee Returned", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("MakeTransfer", mock.Anything, mock.Anything).Return(&models.TransferResult{
+			AuditID:           1,
+			SourcePostBalance: "48",
+			SourceAmount:      "50",
+			Fee:               "2",
+		}, nil)
+
+		resp, err := h.MakeTransfer(context.Background(), &pb.TransferRequest{SourceId: 100, DestinationId: 200, Amount: "50"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "2", resp.Fee)
+		assert.Equal(t, "48", resp.NewSourceBalance)
+	})
+
+	t.Run("Failure: FX Rate Not Found (Reason Attached)", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		req := &pb.TransferRequest{Amount: "50.00", Convert: true}
+
+		mockSvc.On("MakeTransfer", mock.Anything, mock.Anything).
+			Return(nil, constants.ErrFxRateNotFound)
+
+		_, err := h.MakeTransfer(context.Background(), req)
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, constants.ReasonFxRateNotFound, errorInfoReason(st))
+	})
+
+	t.Run("Failure: Converted Amount Too Small", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		req := &pb.TransferRequest{Amount: "0.001", Convert: true}
+
+		mockSvc.On("MakeTransfer", mock.Anything, mock.Anything).
+			Return(nil, constants.ErrConvertedAmountTooSmall)
+
+		_, err := h.MakeTransfer(context.Background(), req)
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("Failure: Limit Exceeded (Remaining In Details)", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		req := &pb.TransferRequest{Amount: "50.00"}
+
+		mockSvc.On("MakeTransfer", mock.Anything, mock.Anything).
+			Return(nil, &models.LimitError{Limit: models.LimitDailyAmount, Remaining: decimal.NewFromInt(30)})
+
+		_, err := h.MakeTransfer(context.Background(), req)
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.ResourceExhausted, st.Code())
+		assert.Equal(t, constants.ReasonLimitExceeded, errorInfoReason(st))
+		info := st.Details()[0].(*errdetails.ErrorInfo)
+		assert.Equal(t, "daily_amount", info.Metadata["limit"])
+		assert.Equal(t, "30", info.Metadata["remaining"])
+	})
+
 	t.Run("Failure: System Error (Default Fallback)", func(t *testing.T) {
 		mockSvc := new(mocks.MockTransferService)
 		h := NewGrpcHandler(nil, mockSvc, logger)
@@ -172,4 +319,974 @@ func TestGrpcHandler_CreateAccount(t *testing.T) {
 		st, _ := status.FromError(err)
 		assert.Equal(t, codes.AlreadyExists, st.Code())
 	})
+
+	t.Run("Failure: Unsupported Currency", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		req := &pb.CreateAccountRequest{AccountId: 101, Balance: "500.00", Currency: "XYZ"}
+
+		mockAccSvc.On("CreateAccount", mock.Anything, mock.MatchedBy(func(a *models.Account) bool {
+			return a.Currency == "XYZ"
+		})).Return(constants.ErrUnsupportedCurrency)
+
+		_, err := h.CreateAccount(context.Background(), req)
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, constants.ErrUnsupportedCurrency.Error(), st.Message())
+	})
+
+	t.Run("Failure: Invalid Account Class", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		req := &pb.CreateAccountRequest{AccountId: 101, Balance: "500.00", AccountClass: "no spaces"}
+
+		mockAccSvc.On("CreateAccount", mock.Anything, mock.MatchedBy(func(a *models.Account) bool {
+			return a.Class == "no spaces"
+		})).Return(constants.ErrInvalidAccountClass)
+
+		_, err := h.CreateAccount(context.Background(), req)
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+}
+
+func TestGrpcHandler_GetAccount(t *testing.T) {
+	logger := zap.NewNop()
+
+	t.Run("Success: Account Returned", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		mockAccSvc.On("GetAccount", mock.Anything, int64(101)).
+			Return(&models.Account{
+				ID:          101,
+				Balance:     decimal.NewFromFloat(500.25),
+				HeldBalance: decimal.NewFromFloat(100),
+				Status:      constants.AccountActive,
+				Class:       "premium",
+			}, nil)
+
+		resp, err := h.GetAccount(context.Background(), &pb.GetAccountRequest{AccountId: 101})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(101), resp.AccountId)
+		assert.Equal(t, "500.25", resp.Balance)
+		assert.Equal(t, "100", resp.HeldBalance)
+		assert.Equal(t, "400.25", resp.AvailableBalance)
+		assert.Equal(t, "ACTIVE", resp.Status)
+		assert.Equal(t, "premium", resp.AccountClass)
+	})
+
+	t.Run("Failure: Invalid Account ID", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		_, err := h.GetAccount(context.Background(), &pb.GetAccountRequest{AccountId: 0})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		mockAccSvc.AssertNotCalled(t, "GetAccount")
+	})
+
+	t.Run("Failure: Account Not Found", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		mockAccSvc.On("GetAccount", mock.Anything, int64(404)).
+			Return(nil, constants.ErrAccountNotFound)
+
+		_, err := h.GetAccount(context.Background(), &pb.GetAccountRequest{AccountId: 404})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+
+	t.Run("Failure: System Error", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		mockAccSvc.On("GetAccount", mock.Anything, int64(101)).
+			Return(nil, errors.New("get account failed: connection died"))
+
+		_, err := h.GetAccount(context.Background(), &pb.GetAccountRequest{AccountId: 101})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.Internal, st.Code())
+	})
+}
+
+func TestGrpcHandler_UpdateAccount(t *testing.T) {
+	logger := zap.NewNop()
+
+	t.Run("Success: Overdraft Counts As Available", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		limit := decimal.NewFromInt(100)
+		mockAccSvc.On("UpdateOverdraftLimit", mock.Anything, int64(101), limit).
+			Return(&models.Account{
+				ID:             101,
+				Balance:        decimal.NewFromInt(-30),
+				HeldBalance:    decimal.NewFromInt(10),
+				Status:         constants.AccountActive,
+				OverdraftLimit: limit,
+			}, nil)
+
+		resp, err := h.UpdateAccount(context.Background(), &pb.UpdateAccountRequest{AccountId: 101, OverdraftLimit: "100"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "100", resp.OverdraftLimit)
+		assert.Equal(t, "60", resp.AvailableBalance)
+	})
+
+	t.Run("Failure: Invalid Limit Format", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		_, err := h.UpdateAccount(context.Background(), &pb.UpdateAccountRequest{AccountId: 101, OverdraftLimit: "lots"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		mockAccSvc.AssertNotCalled(t, "UpdateOverdraftLimit")
+	})
+
+	t.Run("Failure: Limit Below Drawn Balance", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		mockAccSvc.On("UpdateOverdraftLimit", mock.Anything, int64(101), mock.Anything).
+			Return(nil, constants.ErrOverdraftLimitTooLow)
+
+		_, err := h.UpdateAccount(context.Background(), &pb.UpdateAccountRequest{AccountId: 101, OverdraftLimit: "0"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+	})
+
+	t.Run("Failure: Account Closed (Reason Attached)", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		mockAccSvc.On("UpdateOverdraftLimit", mock.Anything, int64(101), mock.Anything).
+			Return(nil, constants.ErrAccountClosed)
+
+		_, err := h.UpdateAccount(context.Background(), &pb.UpdateAccountRequest{AccountId: 101, OverdraftLimit: "10"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, constants.ReasonAccountClosed, errorInfoReason(st))
+	})
+}
+
+func TestGrpcHandler_AccountLimits(t *testing.T) {
+	logger := zap.NewNop()
+
+	t.Run("Success: Limits Set", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		limits := models.TransferLimits{MaxSingle: decimal.NewNullDecimal(decimal.NewFromInt(2500)), MonthlyCount: 40}
+		mockAccSvc.On("SetLimits", mock.Anything, int64(101), limits).
+			Return(&models.AccountLimits{AccountID: 101, Limits: limits}, nil)
+
+		resp, err := h.SetAccountLimits(context.Background(), &pb.SetAccountLimitsRequest{AccountId: 101, MaxSingle: "2500", MonthlyCount: 40})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "2500", resp.MaxSingle)
+		assert.Empty(t, resp.DailyAmount)
+		assert.Equal(t, int64(40), resp.MonthlyCount)
+		mockAccSvc.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Invalid Amount Format", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		_, err := h.SetAccountLimits(context.Background(), &pb.SetAccountLimitsRequest{AccountId: 101, DailyAmount: "lots"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		mockAccSvc.AssertNotCalled(t, "SetLimits")
+	})
+
+	t.Run("Failure: Invalid Limit", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		mockAccSvc.On("SetLimits", mock.Anything, int64(101), mock.Anything).Return(nil, constants.ErrInvalidLimit)
+
+		_, err := h.SetAccountLimits(context.Background(), &pb.SetAccountLimitsRequest{AccountId: 101, DailyCount: -1})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("Failure: Account Not Found", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		mockAccSvc.On("GetLimits", mock.Anything, int64(404)).Return(nil, constants.ErrAccountNotFound)
+
+		_, err := h.GetAccountLimits(context.Background(), &pb.AccountLimitsRequest{AccountId: 404})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+
+	t.Run("Success: Limits Cleared", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		mockAccSvc.On("ClearLimits", mock.Anything, int64(101)).Return(&models.AccountLimits{AccountID: 101}, nil)
+
+		resp, err := h.ClearAccountLimits(context.Background(), &pb.AccountLimitsRequest{AccountId: 101})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &pb.AccountLimitsResponse{AccountId: 101}, resp)
+	})
+}
+
+func TestGrpcHandler_GetBalanceAt(t *testing.T) {
+	logger := zap.NewNop()
+	at := time.Date(2026, 3, 1, 14, 0, 0, 0, time.UTC)
+
+	t.Run("Success: Balance Returned", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		mockAccSvc.On("GetBalanceAt", mock.Anything, int64(101), at).Return(&models.BalanceAt{
+			AccountID: 101, Balance: decimal.NewFromInt(450), Currency: "USD", At: at, TransferID: 42,
+		}, nil)
+
+		resp, err := h.GetBalanceAt(context.Background(), &pb.GetBalanceAtRequest{AccountId: 101, At: "2026-03-01T14:00:00Z"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "450", resp.Balance)
+		assert.Equal(t, int64(42), resp.TransferId)
+		assert.Equal(t, "2026-03-01T14:00:00Z", resp.At)
+	})
+
+	t.Run("Failure: Invalid Timestamp", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		_, err := h.GetBalanceAt(context.Background(), &pb.GetBalanceAtRequest{AccountId: 101, At: "yesterday"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		mockAccSvc.AssertNotCalled(t, "GetBalanceAt")
+	})
+
+	t.Run("Failure: Account Not Open Yet", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		mockAccSvc.On("GetBalanceAt", mock.Anything, int64(101), at).Return(nil, constants.ErrAccountNotOpenYet)
+
+		_, err := h.GetBalanceAt(context.Background(), &pb.GetBalanceAtRequest{AccountId: 101, At: "2026-03-01T14:00:00Z"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
+func TestGrpcHandler_GetStatement(t *testing.T) {
+	logger := zap.NewNop()
+	q := models.StatementQuery{
+		AccountID: 101,
+		From:      time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		To:        time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+	}
+	req := &pb.GetStatementRequest{AccountId: 101, From: "2026-09-01T00:00:00Z", To: "2026-10-01T00:00:00Z"}
+
+	t.Run("Success: Statement Returned", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		st := &models.Statement{AccountID: 101, Currency: "USD", From: q.From, To: q.To, OpeningBalance: decimal.NewFromInt(300)}
+		st.ClosingBalance = st.OpeningBalance
+		st.Add(models.StatementLine{TransferID: 10, PostedAt: q.From.Add(time.Hour), Type: "TRANSFER",
+			CounterpartyID: 202, Debit: decimal.NewFromInt(50), Balance: decimal.NewFromInt(250)})
+		st.Add(models.StatementLine{TransferID: 12, PostedAt: q.From.Add(2 * time.Hour), Type: "DEPOSIT",
+			CounterpartyID: 800, Credit: decimal.NewFromInt(100), Balance: decimal.NewFromInt(350)})
+		mockAccSvc.On("GetStatement", mock.Anything, q).Return(st, nil)
+
+		resp, err := h.GetStatement(context.Background(), req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "300", resp.OpeningBalance)
+		assert.Equal(t, "350", resp.ClosingBalance)
+		assert.Equal(t, "50", resp.TotalDebits)
+		assert.Len(t, resp.Lines, 2)
+		assert.Equal(t, "50", resp.Lines[0].Debit)
+		assert.Empty(t, resp.Lines[0].Credit)
+		assert.Equal(t, "100", resp.Lines[1].Credit)
+		assert.Empty(t, resp.Lines[1].Debit)
+	})
+
+	t.Run("Failure: Invalid Timestamp", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		_, err := h.GetStatement(context.Background(), &pb.GetStatementRequest{AccountId: 101, From: "last month"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		mockAccSvc.AssertNotCalled(t, "GetStatement")
+	})
+
+	t.Run("Failure: Account Not Found", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		mockAccSvc.On("GetStatement", mock.Anything, q).Return(nil, constants.ErrAccountNotFound)
+
+		_, err := h.GetStatement(context.Background(), req)
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
+func TestGrpcHandler_AccountStatus(t *testing.T) {
+	logger := zap.NewNop()
+
+	t.Run("Success: Account Frozen", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		mockAccSvc.On("FreezeAccount", mock.Anything, int64(101)).
+			Return(&models.Account{ID: 101, Status: constants.AccountFrozen}, nil)
+
+		resp, err := h.FreezeAccount(context.Background(), &pb.AccountStatusRequest{AccountId: 101})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "FROZEN", resp.Status)
+	})
+
+	t.Run("Success: Account Unfrozen", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		mockAccSvc.On("UnfreezeAccount", mock.Anything, int64(101)).
+			Return(&models.Account{ID: 101, Status: constants.AccountActive}, nil)
+
+		resp, err := h.UnfreezeAccount(context.Background(), &pb.AccountStatusRequest{AccountId: 101})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ACTIVE", resp.Status)
+	})
+
+	t.Run("Failure: Invalid Account ID", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		_, err := h.CloseAccount(context.Background(), &pb.AccountStatusRequest{AccountId: 0})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		mockAccSvc.AssertNotCalled(t, "CloseAccount")
+	})
+
+	t.Run("Failure: Close With Balance", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		mockAccSvc.On("CloseAccount", mock.Anything, int64(101)).
+			Return(nil, constants.ErrAccountBalanceNotZero)
+
+		_, err := h.CloseAccount(context.Background(), &pb.AccountStatusRequest{AccountId: 101})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, constants.ReasonBalanceNotZero, errorInfoReason(st))
+	})
+
+	t.Run("Failure: Close With Active Holds", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		mockAccSvc.On("CloseAccount", mock.Anything, int64(101)).
+			Return(nil, constants.ErrAccountHasHolds)
+
+		_, err := h.CloseAccount(context.Background(), &pb.AccountStatusRequest{AccountId: 101})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, constants.ReasonHoldsOutstanding, errorInfoReason(st))
+	})
+
+	t.Run("Failure: Account Not Found", func(t *testing.T) {
+		mockAccSvc := new(mocks.MockAccountService)
+		h := NewGrpcHandler(mockAccSvc, nil, logger)
+
+		mockAccSvc.On("FreezeAccount", mock.Anything, int64(404)).
+			Return(nil, constants.ErrAccountNotFound)
+
+		_, err := h.FreezeAccount(context.Background(), &pb.AccountStatusRequest{AccountId: 404})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
+func errorInfoReason(st *status.Status) string {
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return info.GetReason()
+		}
+	}
+	return ""
+}
+
+func TestGrpcHandler_GetTransfer(t *testing.T) {
+	logger := zap.NewNop()
+
+	t.Run("Success: Transfer Returned", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("GetTransfer", mock.Anything, int64(7)).Return(&models.Transfer{
+			ID:        7,
+			Status:    constants.StatusCompleted,
+			Amount:    decimal.NewFromInt(10),
+			Fee:       decimal.NewFromFloat(0.5),
+			CreatedAt: time.Date(2026, 3, 1, 14, 0, 0, 0, time.UTC),
+		}, nil)
+
+		resp, err := h.GetTransfer(context.Background(), &pb.GetTransferRequest{TransferId: 7})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "0.5", resp.Transfer.Fee)
+		assert.Equal(t, int64(7), resp.Transfer.TransferId)
+		assert.Equal(t, "COMPLETED", resp.Transfer.Status)
+		assert.Equal(t, "2026-03-01T14:00:00Z", resp.Transfer.CreatedAt)
+	})
+
+	t.Run("Failure: Transfer Not Found", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("GetTransfer", mock.Anything, int64(7)).Return(nil, constants.ErrTransferNotFound)
+
+		_, err := h.GetTransfer(context.Background(), &pb.GetTransferRequest{TransferId: 7})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
+func TestGrpcHandler_ListTransfers(t *testing.T) {
+	logger := zap.NewNop()
+
+	t.Run("Success: Query Translated", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+		mockSvc.On("ListTransfers", mock.Anything, models.TransferQuery{
+			AccountID: 100, Direction: models.DirectionOutgoing, From: from, Cursor: 20, Limit: 10,
+		}).Return(&models.TransferPage{Transfers: []models.Transfer{{ID: 19}, {ID: 18}}, NextCursor: 18}, nil)
+
+		resp, err := h.ListTransfers(context.Background(), &pb.ListTransfersRequest{
+			AccountId: 100,
+			Direction: pb.TransferDirection_TRANSFER_DIRECTION_OUTGOING,
+			From:      "2026-03-01T00:00:00Z",
+			Cursor:    20,
+			Limit:     10,
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.Transfers, 2)
+		assert.Equal(t, int64(18), resp.NextCursor)
+	})
+
+	t.Run("Failure: Invalid Timestamp", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		_, err := h.ListTransfers(context.Background(), &pb.ListTransfersRequest{AccountId: 100, To: "yesterday"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		mockSvc.AssertNotCalled(t, "ListTransfers")
+	})
+
+	t.Run("Failure: Account Not Found", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("ListTransfers", mock.Anything, mock.Anything).Return(nil, constants.ErrAccountNotFound)
+
+		_, err := h.ListTransfers(context.Background(), &pb.ListTransfersRequest{AccountId: 100})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
+// fakeTransferStream records what the handler sends on a WatchTransfers stream.
+type fakeTransferStream struct {
+	grpc.ServerStream
+	ctx        context.Context
+	headerSent bool
+	sent       []*pb.Transfer
+}
+
+func (s *fakeTransferStream) Context() context.Context { return s.ctx }
+
+func (s *fakeTransferStream) SendHeader(metadata.MD) error {
+	s.headerSent = true
+	return nil
+}
+
+func (s *fakeTransferStream) Send(t *pb.Transfer) error {
+	s.sent = append(s.sent, t)
+	return nil
+}
+
+func TestGrpcHandler_WatchTransfers(t *testing.T) {
+	logger := zap.NewNop()
+	cursor := models.TransferCursor{AccountID: 100, PostingSeq: 12}
+
+	t.Run("Success: Streams Until Client Leaves", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		stream := &fakeTransferStream{ctx: ctx}
+		mockSvc.On("WatchCursor", mock.Anything, int64(100), int64(7)).Return(cursor, nil)
+		mockSvc.On("WatchTransfers", mock.Anything, cursor, mock.Anything).
+			Run(func(mock.Arguments) { cancel() }).
+			Return([]models.Transfer{{ID: 8}, {ID: 9}}, context.Canceled)
+
+		err := h.WatchTransfers(&pb.WatchTransfersRequest{AccountId: 100, SinceTransferId: 7}, stream)
+
+		assert.Equal(t, codes.Canceled, status.Code(err))
+		assert.True(t, stream.headerSent)
+		if assert.Len(t, stream.sent, 2) {
+			assert.Equal(t, int64(8), stream.sent[0].TransferId)
+			assert.Equal(t, int64(9), stream.sent[1].TransferId)
+		}
+	})
+
+	t.Run("Failure: Since Transfer Not Found", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		stream := &fakeTransferStream{ctx: context.Background()}
+		mockSvc.On("WatchCursor", mock.Anything, int64(100), int64(7)).
+			Return(models.TransferCursor{}, constants.ErrTransferNotFound)
+
+		err := h.WatchTransfers(&pb.WatchTransfersRequest{AccountId: 100, SinceTransferId: 7}, stream)
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.False(t, stream.headerSent)
+		mockSvc.AssertNotCalled(t, "WatchTransfers", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure: Invalid Account ID", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("WatchCursor", mock.Anything, int64(0), int64(0)).
+			Return(models.TransferCursor{}, constants.ErrInvalidAccountID)
+
+		err := h.WatchTransfers(&pb.WatchTransfersRequest{}, &fakeTransferStream{ctx: context.Background()})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Failure: Poll Error", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		stream := &fakeTransferStream{ctx: context.Background()}
+		mockSvc.On("WatchCursor", mock.Anything, int64(100), int64(0)).Return(cursor, nil)
+		mockSvc.On("WatchTransfers", mock.Anything, cursor, mock.Anything).Return(nil, errors.New("db down"))
+
+		err := h.WatchTransfers(&pb.WatchTransfersRequest{AccountId: 100}, stream)
+
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
+func TestGrpcHandler_Holds(t *testing.T) {
+	logger := zap.NewNop()
+
+	t.Run("Success: Transfer Authorized", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		expiresAt := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+		mockSvc.On("AuthorizeTransfer", mock.Anything, mock.MatchedBy(func(r *models.AuthorizeRequest) bool {
+			return r.SourceID == 100 && r.Amount.Equal(decimal.NewFromInt(80)) && r.ExpiresAt.Equal(expiresAt)
+		})).Return(&models.Hold{
+			ID:        7,
+			SourceID:  100,
+			Amount:    decimal.NewFromInt(80),
+			Status:    constants.HoldActive,
+			ExpiresAt: expiresAt,
+		}, nil)
+
+		resp, err := h.AuthorizeTransfer(context.Background(), &pb.AuthorizeTransferRequest{
+			SourceId: 100, DestinationId: 200, Amount: "80", ExpiresAt: "2026-11-01T00:00:00Z",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), resp.Hold.HoldId)
+		assert.Equal(t, "ACTIVE", resp.Hold.Status)
+		assert.Equal(t, "2026-11-01T00:00:00Z", resp.Hold.ExpiresAt)
+	})
+
+	t.Run("Failure: Authorize With Invalid Expiry Format", func(t *testing.T) {
+		h := NewGrpcHandler(nil, new(mocks.MockTransferService), logger)
+
+		_, err := h.AuthorizeTransfer(context.Background(), &pb.AuthorizeTransferRequest{Amount: "80", ExpiresAt: "tomorrow"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("Success: Empty Amount Captures Full Hold", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("CaptureTransfer", mock.Anything, &models.CaptureRequest{HoldID: 7}).
+			Return(&models.TransferResult{AuditID: 7, SourceAmount: "80"}, nil)
+
+		resp, err := h.CaptureTransfer(context.Background(), &pb.CaptureTransferRequest{HoldId: 7})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), resp.AuditId)
+		assert.Equal(t, "80", resp.SourceAmount)
+	})
+
+	t.Run("Failure: Capture Expired Hold (Reason Attached)", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("CaptureTransfer", mock.Anything, mock.Anything).Return(nil, constants.ErrHoldExpired)
+
+		_, err := h.CaptureTransfer(context.Background(), &pb.CaptureTransferRequest{HoldId: 7, Amount: "50"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, constants.ReasonHoldExpired, errorInfoReason(st))
+	})
+
+	t.Run("Failure: Capture Exceeds Hold", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("CaptureTransfer", mock.Anything, mock.Anything).Return(nil, constants.ErrCaptureExceedsHold)
+
+		_, err := h.CaptureTransfer(context.Background(), &pb.CaptureTransferRequest{HoldId: 7, Amount: "500"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("Failure: Void Settled Hold (Reason Attached)", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("VoidTransfer", mock.Anything, int64(7)).Return(nil, constants.ErrHoldNotActive)
+
+		_, err := h.VoidTransfer(context.Background(), &pb.VoidTransferRequest{HoldId: 7})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, constants.ReasonHoldNotActive, errorInfoReason(st))
+	})
+
+	t.Run("Failure: Void Unknown Hold", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("VoidTransfer", mock.Anything, int64(9)).Return(nil, constants.ErrHoldNotFound)
+
+		_, err := h.VoidTransfer(context.Background(), &pb.VoidTransferRequest{HoldId: 9})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
+func TestGrpcHandler_ReverseTransfer(t *testing.T) {
+	logger := zap.NewNop()
+
+	t.Run("Success: Both Rows Returned", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("ReverseTransfer", mock.Anything, &models.ReversalRequest{
+			TransferID: 7, Amount: decimal.RequireFromString("4"), Reason: "duplicate", IdempotencyKey: "refund-7",
+		}).Return(&models.Reversal{
+			Reversal: models.Transfer{ID: 8, ReversalOf: 7, Status: constants.StatusCompleted, Amount: decimal.NewFromInt(4), Reason: "duplicate"},
+			Original: models.Transfer{ID: 7, Status: constants.StatusPartiallyReversed, ReversedAmount: decimal.NewFromInt(4)},
+		}, nil)
+
+		resp, err := h.ReverseTransfer(context.Background(), &pb.ReverseTransferRequest{TransferId: 7, Amount: "4", Reason: "duplicate", IdempotencyKey: "refund-7"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), resp.Reversal.ReversalOf)
+		assert.Equal(t, "duplicate", resp.Reversal.Reason)
+		assert.Equal(t, "PARTIALLY_REVERSED", resp.Original.Status)
+		assert.Equal(t, "4", resp.Original.ReversedAmount)
+	})
+
+	t.Run("Failure: Invalid Amount Format", func(t *testing.T) {
+		h := NewGrpcHandler(nil, new(mocks.MockTransferService), logger)
+
+		_, err := h.ReverseTransfer(context.Background(), &pb.ReverseTransferRequest{TransferId: 7, Amount: "abc", Reason: "x"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("Failure: Not Reversible (Reason Attached)", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("ReverseTransfer", mock.Anything, mock.Anything).Return(nil, constants.ErrTransferNotReversible)
+
+		_, err := h.ReverseTransfer(context.Background(), &pb.ReverseTransferRequest{TransferId: 7, Reason: "x"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, constants.ReasonTransferNotReversible, errorInfoReason(st))
+	})
+
+	t.Run("Failure: Destination Cannot Cover Refund", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("ReverseTransfer", mock.Anything, mock.Anything).Return(nil, constants.ErrInsufficientFunds)
+
+		_, err := h.ReverseTransfer(context.Background(), &pb.ReverseTransferRequest{TransferId: 7, Reason: "x"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+	})
+
+	t.Run("Failure: Refund Exceeds Remaining Amount", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("ReverseTransfer", mock.Anything, mock.Anything).Return(nil, constants.ErrReversalTooLarge)
+
+		_, err := h.ReverseTransfer(context.Background(), &pb.ReverseTransferRequest{TransferId: 7, Amount: "50", Reason: "x"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, constants.ErrReversalTooLarge.Error(), st.Message())
+	})
+
+	t.Run("Failure: Invalid Reason", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("ReverseTransfer", mock.Anything, mock.Anything).Return(nil, constants.ErrInvalidReversalReason)
+
+		_, err := h.ReverseTransfer(context.Background(), &pb.ReverseTransferRequest{TransferId: 7})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("Failure: Transfer Not Found", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("ReverseTransfer", mock.Anything, mock.Anything).Return(nil, constants.ErrTransferNotFound)
+
+		_, err := h.ReverseTransfer(context.Background(), &pb.ReverseTransferRequest{TransferId: 9, Reason: "x"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
+func TestGrpcHandler_MakeBatchTransfer(t *testing.T) {
+	logger := zap.NewNop()
+	legs := []*pb.TransferRequest{
+		{SourceId: 1, DestinationId: 2, Amount: "10"},
+		{SourceId: 1, DestinationId: 3, Amount: "20"},
+	}
+
+	t.Run("Success: Per-Leg Results", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("MakeBatchTransfer", mock.Anything, mock.MatchedBy(func(b *models.BatchTransferRequest) bool {
+			return b.BestEffort && len(b.Legs) == 2 && b.Legs[1].Amount.Equal(decimal.NewFromInt(20))
+		})).Run(func(args mock.Arguments) {
+			b := args.Get(1).(*models.BatchTransferRequest)
+			b.Legs[0].Result = &models.TransferResult{AuditID: 10, Status: "SUCCESS"}
+			b.Legs[1].Err = constants.ErrAccountFrozen
+		}).Return(nil)
+
+		resp, err := h.MakeBatchTransfer(context.Background(), &pb.BatchTransferRequest{Legs: legs, BestEffort: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), resp.Succeeded)
+		assert.Equal(t, int32(1), resp.Failed)
+		assert.Equal(t, int64(10), resp.Results[0].Transfer.AuditId)
+		assert.Equal(t, int32(1), resp.Results[1].Index)
+		assert.Equal(t, codes.FailedPrecondition.String(), resp.Results[1].ErrorCode)
+		assert.Equal(t, constants.ReasonAccountFrozen, resp.Results[1].ErrorReason)
+	})
+
+	t.Run("Failure: Atomic Batch Reports Failing Leg", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("MakeBatchTransfer", mock.Anything, mock.Anything).
+			Return(&models.LegError{Index: 1, Err: constants.ErrInsufficientFunds})
+
+		_, err := h.MakeBatchTransfer(context.Background(), &pb.BatchTransferRequest{Legs: legs})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, "leg 1: insufficient funds", st.Message())
+	})
+
+	t.Run("Failure: Invalid Amount Format", func(t *testing.T) {
+		h := NewGrpcHandler(nil, new(mocks.MockTransferService), logger)
+
+		_, err := h.MakeBatchTransfer(context.Background(), &pb.BatchTransferRequest{
+			Legs:       []*pb.TransferRequest{{SourceId: 1, DestinationId: 2, Amount: "abc"}},
+			BestEffort: true,
+		})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("Failure: Empty Batch", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("MakeBatchTransfer", mock.Anything, mock.Anything).Return(constants.ErrEmptyBatch)
+
+		_, err := h.MakeBatchTransfer(context.Background(), &pb.BatchTransferRequest{})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+}
+
+func TestGrpcHandler_MakeMultiLegTransfer(t *testing.T) {
+	logger := zap.NewNop()
+	req := &pb.MultiLegTransferRequest{
+		Debits:  []*pb.TransferLeg{{AccountId: 1, Amount: "100"}},
+		Credits: []*pb.TransferLeg{{AccountId: 2, Amount: "95"}, {AccountId: 3, Amount: "5"}},
+	}
+
+	t.Run("Success: Group Returned", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("MakeMultiLegTransfer", mock.Anything, &models.MultiLegTransferRequest{
+			Debits:  []models.Leg{{AccountID: 1, Amount: decimal.RequireFromString("100")}},
+			Credits: []models.Leg{{AccountID: 2, Amount: decimal.RequireFromString("95")}, {AccountID: 3, Amount: decimal.RequireFromString("5")}},
+		}).Return(&models.MultiLegResult{
+			GroupID:   5,
+			Transfers: []models.TransferResult{{AuditID: 10}, {AuditID: 11}},
+		}, nil)
+
+		resp, err := h.MakeMultiLegTransfer(context.Background(), req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), resp.GroupId)
+		assert.Len(t, resp.Transfers, 2)
+		assert.Equal(t, int64(11), resp.Transfers[1].AuditId)
+	})
+
+	t.Run("Failure: Invalid Amount Format", func(t *testing.T) {
+		h := NewGrpcHandler(nil, new(mocks.MockTransferService), logger)
+
+		_, err := h.MakeMultiLegTransfer(context.Background(), &pb.MultiLegTransferRequest{
+			Debits:  []*pb.TransferLeg{{AccountId: 1, Amount: "abc"}},
+			Credits: []*pb.TransferLeg{{AccountId: 2, Amount: "1"}},
+		})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("Failure: Unbalanced Legs", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("MakeMultiLegTransfer", mock.Anything, mock.Anything).Return(nil, constants.ErrUnbalancedLegs)
+
+		_, err := h.MakeMultiLegTransfer(context.Background(), req)
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+}
+
+func TestGrpcHandler_Cash(t *testing.T) {
+	logger := zap.NewNop()
+
+	t.Run("Success: Deposit Returns New Balance", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("Deposit", mock.Anything, &models.CashRequest{
+			AccountID: 1, Amount: decimal.RequireFromString("50"), IdempotencyKey: "dep-1",
+		}).Return(&models.CashResult{
+			AuditID: 10, AccountID: 1, Kind: constants.KindDeposit, Amount: "50", Currency: "USD", NewBalance: "150",
+		}, nil)
+
+		resp, err := h.Deposit(context.Background(), &pb.CashRequest{AccountId: 1, Amount: "50", IdempotencyKey: "dep-1"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), resp.AuditId)
+		assert.Equal(t, "DEPOSIT", resp.Kind)
+		assert.Equal(t, "150", resp.NewBalance)
+	})
+
+	t.Run("Failure: Invalid Amount Format", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		_, err := h.Withdraw(context.Background(), &pb.CashRequest{AccountId: 1, Amount: "abc"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		mockSvc.AssertNotCalled(t, "Withdraw")
+	})
+
+	t.Run("Failure: Withdrawal Insufficient Funds", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("Withdraw", mock.Anything, mock.Anything).Return(nil, constants.ErrInsufficientFunds)
+
+		_, err := h.Withdraw(context.Background(), &pb.CashRequest{AccountId: 1, Amount: "5000"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+	})
+
+	t.Run("Failure: No Settlement Account", func(t *testing.T) {
+		mockSvc := new(mocks.MockTransferService)
+		h := NewGrpcHandler(nil, mockSvc, logger)
+
+		mockSvc.On("Deposit", mock.Anything, mock.Anything).Return(nil, constants.ErrSettlementMissing)
+
+		_, err := h.Deposit(context.Background(), &pb.CashRequest{AccountId: 1, Amount: "50"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, constants.ErrSettlementMissing.Error(), st.Message())
+	})
 }
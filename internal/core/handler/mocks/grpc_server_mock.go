@@ -2,7 +2,9 @@ package mocks
 
 import (
 	"context"
+	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/jhaprabhatt/account-transfer-project/internal/models"
@@ -20,8 +22,52 @@ func (m *MockTransferService) MakeTransfer(ctx context.Context, req *models.Tran
 	return args.Get(0).(*models.TransferResult), args.Error(1)
 }
 
-func (m *MockTransferService) ValidateTransfer(ctx context.Context, sourceID, destID int64) error {
-	return m.Called(ctx, sourceID, destID).Error(0)
+func (m *MockTransferService) GetTransfer(ctx context.Context, id int64) (*models.Transfer, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Transfer), args.Error(1)
+}
+
+func (m *MockTransferService) ListTransfers(ctx context.Context, q models.TransferQuery) (*models.TransferPage, error) {
+	args := m.Called(ctx, q)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.TransferPage), args.Error(1)
+}
+
+func (m *MockTransferService) AuthorizeTransfer(ctx context.Context, req *models.AuthorizeRequest) (*models.Hold, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Hold), args.Error(1)
+}
+
+func (m *MockTransferService) CaptureTransfer(ctx context.Context, req *models.CaptureRequest) (*models.TransferResult, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.TransferResult), args.Error(1)
+}
+
+func (m *MockTransferService) VoidTransfer(ctx context.Context, id int64) (*models.Hold, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Hold), args.Error(1)
+}
+
+func (m *MockTransferService) ReverseTransfer(ctx context.Context, req *models.ReversalRequest) (*models.Reversal, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Reversal), args.Error(1)
 }
 
 type MockAccountService struct {
@@ -32,3 +78,222 @@ func (m *MockAccountService) CreateAccount(ctx context.Context, acc *models.Acco
 	args := m.Called(ctx, acc)
 	return args.Error(0)
 }
+
+func (m *MockAccountService) GetAccount(ctx context.Context, id int64) (*models.Account, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Account), args.Error(1)
+}
+
+func (m *MockAccountService) FreezeAccount(ctx context.Context, id int64) (*models.Account, error) {
+	return m.statusChange("FreezeAccount", ctx, id)
+}
+
+func (m *MockAccountService) UnfreezeAccount(ctx context.Context, id int64) (*models.Account, error) {
+	return m.statusChange("UnfreezeAccount", ctx, id)
+}
+
+func (m *MockAccountService) CloseAccount(ctx context.Context, id int64) (*models.Account, error) {
+	return m.statusChange("CloseAccount", ctx, id)
+}
+
+func (m *MockAccountService) statusChange(method string, ctx context.Context, id int64) (*models.Account, error) {
+	args := m.MethodCalled(method, ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Account), args.Error(1)
+}
+
+func (m *MockAccountService) UpdateOverdraftLimit(ctx context.Context, id int64, limit decimal.Decimal) (*models.Account, error) {
+	args := m.Called(ctx, id, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Account), args.Error(1)
+}
+
+func (m *MockAccountService) GetBalanceAt(ctx context.Context, id int64, at time.Time) (*models.BalanceAt, error) {
+	args := m.Called(ctx, id, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.BalanceAt), args.Error(1)
+}
+
+func (m *MockAccountService) GetStatement(ctx context.Context, q models.StatementQuery) (*models.Statement, error) {
+	args := m.Called(ctx, q)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Statement), args.Error(1)
+}
+
+func (m *MockAccountService) SetLimits(ctx context.Context, id int64, limits models.TransferLimits) (*models.AccountLimits, error) {
+	args := m.Called(ctx, id, limits)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.AccountLimits), args.Error(1)
+}
+
+func (m *MockAccountService) GetLimits(ctx context.Context, id int64) (*models.AccountLimits, error) {
+	return m.limits("GetLimits", ctx, id)
+}
+
+func (m *MockAccountService) ClearLimits(ctx context.Context, id int64) (*models.AccountLimits, error) {
+	return m.limits("ClearLimits", ctx, id)
+}
+
+func (m *MockAccountService) limits(method string, ctx context.Context, id int64) (*models.AccountLimits, error) {
+	args := m.MethodCalled(method, ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.AccountLimits), args.Error(1)
+}
+
+type MockReconciler struct {
+	mock.Mock
+}
+
+func (m *MockReconciler) Reconcile(ctx context.Context) (*models.ReconciliationReport, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ReconciliationReport), args.Error(1)
+}
+
+type MockFxRateService struct {
+	mock.Mock
+}
+
+func (m *MockFxRateService) LoadRates(ctx context.Context, rates []models.FxRate) error {
+	return m.Called(ctx, rates).Error(0)
+}
+
+func (m *MockTransferService) MakeBatchTransfer(ctx context.Context, batch *models.BatchTransferRequest) error {
+	args := m.Called(ctx, batch)
+	return args.Error(0)
+}
+
+func (m *MockTransferService) MakeMultiLegTransfer(ctx context.Context, req *models.MultiLegTransferRequest) (*models.MultiLegResult, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.MultiLegResult), args.Error(1)
+}
+
+func (m *MockTransferService) Deposit(ctx context.Context, req *models.CashRequest) (*models.CashResult, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.CashResult), args.Error(1)
+}
+
+func (m *MockTransferService) Withdraw(ctx context.Context, req *models.CashRequest) (*models.CashResult, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.CashResult), args.Error(1)
+}
+
+func (m *MockTransferService) WatchCursor(ctx context.Context, accountID, sinceTransferID int64) (models.TransferCursor, error) {
+	args := m.Called(ctx, accountID, sinceTransferID)
+	return args.Get(0).(models.TransferCursor), args.Error(1)
+}
+
+// WatchTransfers hands each transfer given to Return to send, then returns the error.
+func (m *MockTransferService) WatchTransfers(ctx context.Context, cursor models.TransferCursor, send func(*models.Transfer) error) error {
+	args := m.Called(ctx, cursor, send)
+	transfers, _ := args.Get(0).([]models.Transfer)
+	for i := range transfers {
+		if err := send(&transfers[i]); err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
+}
+
+type MockScheduleService struct {
+	mock.Mock
+}
+
+func (m *MockScheduleService) ScheduleTransfer(ctx context.Context, req *models.ScheduleRequest) (*models.ScheduledTransfer, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ScheduledTransfer), args.Error(1)
+}
+
+func (m *MockScheduleService) CancelScheduledTransfer(ctx context.Context, id int64) (*models.ScheduledTransfer, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ScheduledTransfer), args.Error(1)
+}
+
+func (m *MockScheduleService) ListScheduledTransfers(ctx context.Context, accountID int64) ([]models.ScheduledTransfer, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ScheduledTransfer), args.Error(1)
+}
+
+type MockWebhookService struct {
+	mock.Mock
+}
+
+func (m *MockWebhookService) CreateWebhook(ctx context.Context, req *models.WebhookRequest) (*models.WebhookSubscription, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockWebhookService) GetWebhook(ctx context.Context, id int64) (*models.WebhookSubscription, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockWebhookService) ListWebhooks(ctx context.Context) ([]models.WebhookSubscription, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockWebhookService) DeleteWebhook(ctx context.Context, id int64) (*models.WebhookSubscription, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockWebhookService) ListDeadLetters(ctx context.Context, webhookID int64) ([]models.WebhookDeadLetter, error) {
+	args := m.Called(ctx, webhookID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.WebhookDeadLetter), args.Error(1)
+}
+
+func (m *MockWebhookService) Redeliver(ctx context.Context, deadLetterID int64) (int64, error) {
+	args := m.Called(ctx, deadLetterID)
+	return args.Get(0).(int64), args.Error(1)
+}
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+type ScheduleUseCase interface {
+	ScheduleTransfer(ctx context.Context, req *models.ScheduleRequest) (*models.ScheduledTransfer, error)
+	CancelScheduledTransfer(ctx context.Context, id int64) (*models.ScheduledTransfer, error)
+	ListScheduledTransfers(ctx context.Context, accountID int64) ([]models.ScheduledTransfer, error)
+}
+
+type ScheduleHandler struct {
+	pb.UnimplementedScheduleServiceServer
+
+	schedules ScheduleUseCase
+
+	log *zap.Logger
+}
+
+func NewScheduleHandler(schedules ScheduleUseCase, log *zap.Logger) *ScheduleHandler {
+	return &ScheduleHandler{
+		schedules: schedules,
+		log:       log,
+	}
+}
+
+func (h *ScheduleHandler) ScheduleTransfer(ctx context.Context, req *pb.ScheduleTransferRequest) (*pb.ScheduledTransferResponse, error) {
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid amount format")
+	}
+	startAt, err := parseOptionalTime(req.StartAt)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, constants.ErrInvalidTimestamp.Error())
+	}
+
+	sched, err := h.schedules.ScheduleTransfer(ctx, &models.ScheduleRequest{
+		TransferRequest: models.TransferRequest{
+			SourceID:      req.SourceId,
+			DestinationID: req.DestinationId,
+			Amount:        amount,
+			Currency:      req.Currency,
+			Convert:       req.Convert,
+		},
+		Schedule: req.Schedule,
+		StartAt:  startAt,
+	})
+	if err != nil {
+		h.log.Error("Scheduling transfer failed", zap.Int64("source", req.SourceId), zap.Error(err))
+		return nil, scheduleError(err)
+	}
+
+	return &pb.ScheduledTransferResponse{ScheduledTransfer: toPbScheduledTransfer(sched)}, nil
+}
+
+func (h *ScheduleHandler) CancelScheduledTransfer(ctx context.Context, req *pb.CancelScheduledTransferRequest) (*pb.ScheduledTransferResponse, error) {
+	sched, err := h.schedules.CancelScheduledTransfer(ctx, req.ScheduleId)
+	if err != nil {
+		h.log.Error("Cancelling scheduled transfer failed", zap.Int64("schedule_id", req.ScheduleId), zap.Error(err))
+		return nil, scheduleError(err)
+	}
+
+	return &pb.ScheduledTransferResponse{ScheduledTransfer: toPbScheduledTransfer(sched)}, nil
+}
+
+func (h *ScheduleHandler) ListScheduledTransfers(ctx context.Context, req *pb.ListScheduledTransfersRequest) (*pb.ListScheduledTransfersResponse, error) {
+	schedules, err := h.schedules.ListScheduledTransfers(ctx, req.AccountId)
+	if err != nil {
+		h.log.Error("Listing scheduled transfers failed", zap.Int64("account_id", req.AccountId), zap.Error(err))
+		return nil, scheduleError(err)
+	}
+
+	resp := &pb.ListScheduledTransfersResponse{ScheduledTransfers: make([]*pb.ScheduledTransfer, 0, len(schedules))}
+	for i := range schedules {
+		resp.ScheduledTransfers = append(resp.ScheduledTransfers, toPbScheduledTransfer(&schedules[i]))
+	}
+	return resp, nil
+}
+
+func toPbScheduledTransfer(s *models.ScheduledTransfer) *pb.ScheduledTransfer {
+	out := &pb.ScheduledTransfer{
+		ScheduleId:    s.ID,
+		SourceId:      s.SourceID,
+		DestinationId: s.DestinationID,
+		Amount:        s.Amount.String(),
+		Currency:      s.Currency,
+		Convert:       s.Convert,
+		Schedule:      s.Schedule,
+		Status:        s.Status.String(),
+		NextRunAt:     formatOptionalTime(s.NextRunAt),
+		CreatedAt:     s.CreatedAt.UTC().Format(time.RFC3339Nano),
+	}
+	if run := s.LastRun; run != nil {
+		out.LastRun = &pb.ScheduledRun{
+			ScheduledFor: run.ScheduledFor.UTC().Format(time.RFC3339Nano),
+			Status:       run.Status.String(),
+			TransferId:   run.TransferID,
+			Error:        run.Error,
+			ExecutedAt:   run.ExecutedAt.UTC().Format(time.RFC3339Nano),
+		}
+	}
+	return out
+}
+
+func formatOptionalTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
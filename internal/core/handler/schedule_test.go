@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/core/handler/mocks"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+func TestScheduleHandler_ScheduleTransfer(t *testing.T) {
+	logger := zap.NewNop()
+	startAt := time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("Success: Request Translated", func(t *testing.T) {
+		mockSvc := new(mocks.MockScheduleService)
+		h := NewScheduleHandler(mockSvc, logger)
+
+		mockSvc.On("ScheduleTransfer", mock.Anything, mock.MatchedBy(func(req *models.ScheduleRequest) bool {
+			return req.SourceID == 1 && req.Amount.Equal(decimal.NewFromInt(25)) &&
+				req.Schedule == "@every 24h" && req.StartAt.Equal(startAt)
+		})).Return(&models.ScheduledTransfer{
+			ID: 7, SourceID: 1, DestinationID: 2, Amount: decimal.NewFromInt(25),
+			Schedule: "@every 24h", Status: constants.ScheduleActive, NextRunAt: startAt, CreatedAt: time.Now(),
+		}, nil)
+
+		resp, err := h.ScheduleTransfer(context.Background(), &pb.ScheduleTransferRequest{
+			SourceId: 1, DestinationId: 2, Amount: "25", Schedule: "@every 24h", StartAt: startAt.Format(time.RFC3339),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), resp.ScheduledTransfer.ScheduleId)
+		assert.Equal(t, "ACTIVE", resp.ScheduledTransfer.Status)
+		assert.Equal(t, "2026-11-01T09:00:00Z", resp.ScheduledTransfer.NextRunAt)
+	})
+
+	t.Run("Failure: Invalid Start Time", func(t *testing.T) {
+		h := NewScheduleHandler(new(mocks.MockScheduleService), logger)
+
+		_, err := h.ScheduleTransfer(context.Background(), &pb.ScheduleTransferRequest{
+			SourceId: 1, DestinationId: 2, Amount: "25", StartAt: "tomorrow",
+		})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("Failure: Invalid Rule", func(t *testing.T) {
+		mockSvc := new(mocks.MockScheduleService)
+		h := NewScheduleHandler(mockSvc, logger)
+
+		mockSvc.On("ScheduleTransfer", mock.Anything, mock.Anything).Return(nil, constants.ErrInvalidSchedule)
+
+		_, err := h.ScheduleTransfer(context.Background(), &pb.ScheduleTransferRequest{
+			SourceId: 1, DestinationId: 2, Amount: "25", Schedule: "every day",
+		})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+}
+
+func TestScheduleHandler_CancelScheduledTransfer(t *testing.T) {
+	logger := zap.NewNop()
+
+	t.Run("Failure: Not Found", func(t *testing.T) {
+		mockSvc := new(mocks.MockScheduleService)
+		h := NewScheduleHandler(mockSvc, logger)
+
+		mockSvc.On("CancelScheduledTransfer", mock.Anything, int64(7)).Return(nil, constants.ErrScheduleNotFound)
+
+		_, err := h.CancelScheduledTransfer(context.Background(), &pb.CancelScheduledTransferRequest{ScheduleId: 7})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+
+	t.Run("Failure: Not Active", func(t *testing.T) {
+		mockSvc := new(mocks.MockScheduleService)
+		h := NewScheduleHandler(mockSvc, logger)
+
+		mockSvc.On("CancelScheduledTransfer", mock.Anything, int64(7)).Return(nil, constants.ErrScheduleNotActive)
+
+		_, err := h.CancelScheduledTransfer(context.Background(), &pb.CancelScheduledTransferRequest{ScheduleId: 7})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, constants.ReasonScheduleNotActive, errorInfoReason(st))
+	})
+}
+
+func TestScheduleHandler_ListScheduledTransfers(t *testing.T) {
+	mockSvc := new(mocks.MockScheduleService)
+	h := NewScheduleHandler(mockSvc, zap.NewNop())
+
+	ranAt := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	mockSvc.On("ListScheduledTransfers", mock.Anything, int64(1)).Return([]models.ScheduledTransfer{
+		{ID: 7, SourceID: 1, DestinationID: 2, Amount: decimal.NewFromInt(25), Status: constants.ScheduleCompleted,
+			LastRun: &models.ScheduledRun{ScheduledFor: ranAt, Status: constants.RunSucceeded, TransferID: 42, ExecutedAt: ranAt}},
+	}, nil)
+
+	resp, err := h.ListScheduledTransfers(context.Background(), &pb.ListScheduledTransfersRequest{AccountId: 1})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.ScheduledTransfers, 1)
+	assert.Empty(t, resp.ScheduledTransfers[0].NextRunAt)
+	assert.Equal(t, "SUCCEEDED", resp.ScheduledTransfers[0].LastRun.Status)
+	assert.Equal(t, int64(42), resp.ScheduledTransfers[0].LastRun.TransferId)
+}
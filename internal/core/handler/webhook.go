@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+type WebhookUseCase interface {
+	CreateWebhook(ctx context.Context, req *models.WebhookRequest) (*models.WebhookSubscription, error)
+	GetWebhook(ctx context.Context, id int64) (*models.WebhookSubscription, error)
+	ListWebhooks(ctx context.Context) ([]models.WebhookSubscription, error)
+	DeleteWebhook(ctx context.Context, id int64) (*models.WebhookSubscription, error)
+	ListDeadLetters(ctx context.Context, webhookID int64) ([]models.WebhookDeadLetter, error)
+	Redeliver(ctx context.Context, deadLetterID int64) (int64, error)
+}
+
+type WebhookHandler struct {
+	pb.UnimplementedWebhookServiceServer
+
+	webhooks WebhookUseCase
+
+	log *zap.Logger
+}
+
+func NewWebhookHandler(webhooks WebhookUseCase, log *zap.Logger) *WebhookHandler {
+	return &WebhookHandler{
+		webhooks: webhooks,
+		log:      log,
+	}
+}
+
+func (h *WebhookHandler) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.WebhookResponse, error) {
+	eventTypes := make([]models.EventType, 0, len(req.EventTypes))
+	for _, t := range req.EventTypes {
+		eventTypes = append(eventTypes, models.EventType(t))
+	}
+
+	w, err := h.webhooks.CreateWebhook(ctx, &models.WebhookRequest{
+		URL:        req.Url,
+		Secret:     req.Secret,
+		EventTypes: eventTypes,
+		AccountID:  req.AccountId,
+	})
+	if err != nil {
+		h.log.Error("Creating webhook failed", zap.String("url", req.Url), zap.Error(err))
+		return nil, webhookError(err)
+	}
+
+	return &pb.WebhookResponse{Webhook: toPbWebhook(w)}, nil
+}
+
+func (h *WebhookHandler) GetWebhook(ctx context.Context, req *pb.GetWebhookRequest) (*pb.WebhookResponse, error) {
+	w, err := h.webhooks.GetWebhook(ctx, req.WebhookId)
+	if err != nil {
+		h.log.Error("Fetching webhook failed", zap.Int64("webhook_id", req.WebhookId), zap.Error(err))
+		return nil, webhookError(err)
+	}
+
+	return &pb.WebhookResponse{Webhook: toPbWebhook(w)}, nil
+}
+
+func (h *WebhookHandler) ListWebhooks(ctx context.Context, _ *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	webhooks, err := h.webhooks.ListWebhooks(ctx)
+	if err != nil {
+		h.log.Error("Listing webhooks failed", zap.Error(err))
+		return nil, webhookError(err)
+	}
+
+	resp := &pb.ListWebhooksResponse{Webhooks: make([]*pb.Webhook, 0, len(webhooks))}
+	for i := range webhooks {
+		resp.Webhooks = append(resp.Webhooks, toPbWebhook(&webhooks[i]))
+	}
+	return resp, nil
+}
+
+func (h *WebhookHandler) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.WebhookResponse, error) {
+	w, err := h.webhooks.DeleteWebhook(ctx, req.WebhookId)
+	if err != nil {
+		h.log.Error("Deleting webhook failed", zap.Int64("webhook_id", req.WebhookId), zap.Error(err))
+		return nil, webhookError(err)
+	}
+
+	return &pb.WebhookResponse{Webhook: toPbWebhook(w)}, nil
+}
+
+func (h *WebhookHandler) ListDeadLetters(ctx context.Context, req *pb.ListDeadLettersRequest) (*pb.ListDeadLettersResponse, error) {
+	letters, err := h.webhooks.ListDeadLetters(ctx, req.WebhookId)
+	if err != nil {
+		h.log.Error("Listing dead letters failed", zap.Int64("webhook_id", req.WebhookId), zap.Error(err))
+		return nil, webhookError(err)
+	}
+
+	resp := &pb.ListDeadLettersResponse{DeadLetters: make([]*pb.DeadLetter, 0, len(letters))}
+	for _, l := range letters {
+		resp.DeadLetters = append(resp.DeadLetters, &pb.DeadLetter{
+			DeadLetterId: l.ID,
+			WebhookId:    l.SubscriptionID,
+			EventId:      l.EventID,
+			EventType:    string(l.EventType),
+			Attempts:     int32(l.Attempts),
+			LastError:    l.LastError,
+			FailedAt:     l.FailedAt.UTC().Format(time.RFC3339Nano),
+		})
+	}
+	return resp, nil
+}
+
+func (h *WebhookHandler) RedeliverDeadLetter(ctx context.Context, req *pb.RedeliverDeadLetterRequest) (*pb.RedeliverDeadLetterResponse, error) {
+	deliveryID, err := h.webhooks.Redeliver(ctx, req.DeadLetterId)
+	if err != nil {
+		h.log.Error("Redelivering dead letter failed", zap.Int64("dead_letter_id", req.DeadLetterId), zap.Error(err))
+		return nil, webhookError(err)
+	}
+
+	return &pb.RedeliverDeadLetterResponse{DeliveryId: deliveryID}, nil
+}
+
+func toPbWebhook(w *models.WebhookSubscription) *pb.Webhook {
+	eventTypes := make([]string, 0, len(w.EventTypes))
+	for _, t := range w.EventTypes {
+		eventTypes = append(eventTypes, string(t))
+	}
+	return &pb.Webhook{
+		WebhookId:  w.ID,
+		Url:        w.URL,
+		Secret:     w.Secret,
+		EventTypes: eventTypes,
+		AccountId:  w.AccountID,
+		Status:     w.Status.String(),
+		CreatedAt:  w.CreatedAt.UTC().Format(time.RFC3339Nano),
+	}
+}
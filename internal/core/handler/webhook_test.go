@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/core/handler/mocks"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	pb "github.com/jhaprabhatt/account-transfer-project/internal/proto"
+)
+
+func TestWebhookHandler_CreateWebhook(t *testing.T) {
+	logger := zap.NewNop()
+
+	t.Run("Success: Request Translated", func(t *testing.T) {
+		mockSvc := new(mocks.MockWebhookService)
+		h := NewWebhookHandler(mockSvc, logger)
+
+		mockSvc.On("CreateWebhook", mock.Anything, &models.WebhookRequest{
+			URL:        "https://partner.example/hooks",
+			EventTypes: []models.EventType{models.EventTransferCompleted},
+			AccountID:  101,
+		}).Return(&models.WebhookSubscription{
+			ID: 3, URL: "https://partner.example/hooks", Secret: "generated-secret-0123",
+			EventTypes: []models.EventType{models.EventTransferCompleted}, AccountID: 101,
+			Status: constants.WebhookActive, CreatedAt: time.Date(2026, 3, 1, 14, 0, 0, 0, time.UTC),
+		}, nil)
+
+		resp, err := h.CreateWebhook(context.Background(), &pb.CreateWebhookRequest{
+			Url: "https://partner.example/hooks", EventTypes: []string{"TransferCompleted"}, AccountId: 101,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), resp.Webhook.WebhookId)
+		assert.Equal(t, "generated-secret-0123", resp.Webhook.Secret)
+		assert.Equal(t, []string{"TransferCompleted"}, resp.Webhook.EventTypes)
+		assert.Equal(t, "ACTIVE", resp.Webhook.Status)
+		assert.Equal(t, "2026-03-01T14:00:00Z", resp.Webhook.CreatedAt)
+	})
+
+	t.Run("Failure: Invalid URL", func(t *testing.T) {
+		mockSvc := new(mocks.MockWebhookService)
+		h := NewWebhookHandler(mockSvc, logger)
+
+		mockSvc.On("CreateWebhook", mock.Anything, mock.Anything).Return(nil, constants.ErrInvalidWebhookURL)
+
+		_, err := h.CreateWebhook(context.Background(), &pb.CreateWebhookRequest{Url: "partner.example"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("Failure: Internal Address", func(t *testing.T) {
+		mockSvc := new(mocks.MockWebhookService)
+		h := NewWebhookHandler(mockSvc, logger)
+
+		mockSvc.On("CreateWebhook", mock.Anything, mock.Anything).Return(nil, constants.ErrWebhookURLNotAllowed)
+
+		_, err := h.CreateWebhook(context.Background(), &pb.CreateWebhookRequest{
+			Url: "http://169.254.169.254/latest/meta-data", EventTypes: []string{"TransferCompleted"},
+		})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("Failure: Account Not Found", func(t *testing.T) {
+		mockSvc := new(mocks.MockWebhookService)
+		h := NewWebhookHandler(mockSvc, logger)
+
+		mockSvc.On("CreateWebhook", mock.Anything, mock.Anything).Return(nil, constants.ErrAccountNotFound)
+
+		_, err := h.CreateWebhook(context.Background(), &pb.CreateWebhookRequest{
+			Url: "https://partner.example/hooks", EventTypes: []string{"TransferCompleted"}, AccountId: 999,
+		})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
+func TestWebhookHandler_DeleteWebhook(t *testing.T) {
+	logger := zap.NewNop()
+
+	t.Run("Failure: Not Found", func(t *testing.T) {
+		mockSvc := new(mocks.MockWebhookService)
+		h := NewWebhookHandler(mockSvc, logger)
+
+		mockSvc.On("DeleteWebhook", mock.Anything, int64(3)).Return(nil, constants.ErrWebhookNotFound)
+
+		_, err := h.DeleteWebhook(context.Background(), &pb.DeleteWebhookRequest{WebhookId: 3})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+
+	t.Run("Failure: Already Deleted", func(t *testing.T) {
+		mockSvc := new(mocks.MockWebhookService)
+		h := NewWebhookHandler(mockSvc, logger)
+
+		mockSvc.On("DeleteWebhook", mock.Anything, int64(3)).Return(nil, constants.ErrWebhookNotActive)
+
+		_, err := h.DeleteWebhook(context.Background(), &pb.DeleteWebhookRequest{WebhookId: 3})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, constants.ReasonWebhookNotActive, errorInfoReason(st))
+	})
+}
+
+func TestWebhookHandler_ListDeadLetters(t *testing.T) {
+	mockSvc := new(mocks.MockWebhookService)
+	h := NewWebhookHandler(mockSvc, zap.NewNop())
+
+	failedAt := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	mockSvc.On("ListDeadLetters", mock.Anything, int64(3)).Return([]models.WebhookDeadLetter{
+		{ID: 5, SubscriptionID: 3, EventID: 42, EventType: models.EventTransferFailed,
+			Attempts: 8, LastError: "unexpected status 503", FailedAt: failedAt},
+	}, nil)
+
+	resp, err := h.ListDeadLetters(context.Background(), &pb.ListDeadLettersRequest{WebhookId: 3})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.DeadLetters, 1)
+	assert.Equal(t, int64(5), resp.DeadLetters[0].DeadLetterId)
+	assert.Equal(t, "TransferFailed", resp.DeadLetters[0].EventType)
+	assert.Equal(t, int32(8), resp.DeadLetters[0].Attempts)
+	assert.Equal(t, "2026-03-02T08:00:00Z", resp.DeadLetters[0].FailedAt)
+}
+
+func TestWebhookHandler_RedeliverDeadLetter(t *testing.T) {
+	logger := zap.NewNop()
+
+	t.Run("Success", func(t *testing.T) {
+		mockSvc := new(mocks.MockWebhookService)
+		h := NewWebhookHandler(mockSvc, logger)
+
+		mockSvc.On("Redeliver", mock.Anything, int64(5)).Return(int64(12), nil)
+
+		resp, err := h.RedeliverDeadLetter(context.Background(), &pb.RedeliverDeadLetterRequest{DeadLetterId: 5})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(12), resp.DeliveryId)
+	})
+
+	t.Run("Failure: Not Found", func(t *testing.T) {
+		mockSvc := new(mocks.MockWebhookService)
+		h := NewWebhookHandler(mockSvc, logger)
+
+		mockSvc.On("Redeliver", mock.Anything, int64(5)).Return(int64(0), constants.ErrDeadLetterNotFound)
+
+		_, err := h.RedeliverDeadLetter(context.Background(), &pb.RedeliverDeadLetterRequest{DeadLetterId: 5})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
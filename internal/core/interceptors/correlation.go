@@ -6,11 +6,9 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
-)
-
-type contextKey string
 
-const CorrelationKey contextKey = "correlation_id"
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+)
 
 func UnaryCorrelationInterceptor() grpc.UnaryServerInterceptor {
 	return func(
@@ -19,14 +17,40 @@ func UnaryCorrelationInterceptor() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if ok {
-			if ids := md.Get("correlation_id"); len(ids) > 0 {
-				if id, err := strconv.ParseInt(ids[0], 10, 64); err == nil {
-					ctx = context.WithValue(ctx, CorrelationKey, id)
-				}
+		return handler(withCorrelationID(ctx), req)
+	}
+}
+
+// StreamCorrelationInterceptor is the streaming counterpart of
+// UnaryCorrelationInterceptor: handlers see the ID on stream.Context().
+func StreamCorrelationInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &correlatedStream{ServerStream: ss, ctx: withCorrelationID(ss.Context())})
+	}
+}
+
+type correlatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *correlatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func withCorrelationID(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		if ids := md.Get("correlation_id"); len(ids) > 0 {
+			if id, err := strconv.ParseInt(ids[0], 10, 64); err == nil {
+				ctx = context.WithValue(ctx, constants.CorrelationKey, id)
 			}
 		}
-		return handler(ctx, req)
 	}
+	return ctx
 }
@@ -1,17 +1,146 @@
 package models
 
-import "github.com/shopspring/decimal"
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultAccountClass is assumed when an account is created without a class,
+// matching the accounts.account_class column default.
+const DefaultAccountClass = "standard"
+
+var accountClassPattern = regexp.MustCompile(`^[a-z0-9_-]{1,32}$`)
 
 type Account struct {
-	ID      int64           `json:"account_id"`
-	Balance decimal.Decimal `json:"balance"`
+	ID             int64                   `json:"account_id"`
+	Balance        decimal.Decimal         `json:"balance"`
+	HeldBalance    decimal.Decimal         `json:"held_balance"`
+	Currency       string                  `json:"currency"`
+	Status         constants.AccountStatus `json:"status"`
+	Class          string                  `json:"account_class"`
+	OverdraftLimit decimal.Decimal         `json:"overdraft_limit"` // how far below zero Balance may go
+	Version        int64                   `json:"version"`         // bumped by every update to the row
+}
+
+// NormalizeAccountClass lower-cases class and defaults an empty class to
+// DefaultAccountClass.
+func NormalizeAccountClass(class string) (string, error) {
+	if class == "" {
+		return DefaultAccountClass, nil
+	}
+
+	class = strings.ToLower(class)
+	if !accountClassPattern.MatchString(class) {
+		return "", constants.ErrInvalidAccountClass
+	}
+	return class, nil
+}
+
+// Available is what transfers and new holds may spend: the part of the balance
+// not reserved by active holds, plus the overdraft.
+func (a *Account) Available() decimal.Decimal {
+	return a.Balance.Sub(a.HeldBalance).Add(a.OverdraftLimit)
 }
 
 func (a *Account) CanWithdraw(amount decimal.Decimal) bool {
-	return a.Balance.GreaterThanOrEqual(amount)
+	return a.Available().GreaterThanOrEqual(amount)
+}
+
+// CheckDebit reports whether money may leave the account.
+func (a *Account) CheckDebit() error {
+	switch a.Status {
+	case constants.AccountFrozen:
+		return constants.ErrAccountFrozen
+	case constants.AccountClosed:
+		return constants.ErrAccountClosed
+	}
+	return nil
+}
+
+// CheckCredit reports whether money may enter the account. Frozen accounts
+// still receive funds; only closed accounts refuse them.
+func (a *Account) CheckCredit() error {
+	if a.Status == constants.AccountClosed {
+		return constants.ErrAccountClosed
+	}
+	return nil
+}
+
+// SetOverdraftLimit changes the overdraft of an open account. A lowered limit
+// must still cover what the account has drawn and holds.
+func (a *Account) SetOverdraftLimit(limit decimal.Decimal) error {
+	if limit.IsNegative() {
+		return constants.ErrInvalidOverdraftLimit
+	}
+	if err := CheckScale(limit, a.Currency); err != nil {
+		return err
+	}
+	if a.Status == constants.AccountClosed {
+		return constants.ErrAccountClosed
+	}
+	if a.Balance.Sub(a.HeldBalance).Add(limit).IsNegative() {
+		return constants.ErrOverdraftLimitTooLow
+	}
+
+	a.OverdraftLimit = limit
+	return nil
+}
+
+// TransitionTo validates a lifecycle change: ACTIVE <-> FROZEN, and either to
+// CLOSED once the balance is zero and no holds are outstanding. CLOSED is
+// terminal.
+func (a *Account) TransitionTo(to constants.AccountStatus) error {
+	if a.Status == constants.AccountClosed {
+		return constants.ErrAccountClosed
+	}
+
+	switch to {
+	case constants.AccountFrozen:
+		if a.Status != constants.AccountActive {
+			return constants.ErrInvalidStatusTransition
+		}
+	case constants.AccountActive:
+		if a.Status != constants.AccountFrozen {
+			return constants.ErrInvalidStatusTransition
+		}
+	case constants.AccountClosed:
+		if !a.HeldBalance.IsZero() {
+			return constants.ErrAccountHasHolds
+		}
+		if !a.Balance.IsZero() {
+			return constants.ErrAccountBalanceNotZero
+		}
+	default:
+		return constants.ErrInvalidStatusTransition
+	}
+
+	a.Status = to
+	return nil
+}
+
+// BalanceAt is the ledger balance of an account as of At, left by transfer
+// TransferID; TransferID is 0 when nothing was posted to the account by then.
+type BalanceAt struct {
+	AccountID  int64
+	Balance    decimal.Decimal
+	Currency   string
+	At         time.Time
+	TransferID int64
 }
 
 type CreateAccountRequest struct {
-	ID      int64           `json:"account_id"`
-	Balance decimal.Decimal `json:"balance"`
+	ID             int64           `json:"account_id"`
+	Balance        decimal.Decimal `json:"balance"`
+	Currency       string          `json:"currency"`
+	Class          string          `json:"account_class"`
+	OverdraftLimit decimal.Decimal `json:"overdraft_limit"`
+}
+
+type UpdateAccountRequest struct {
+	OverdraftLimit decimal.NullDecimal `json:"overdraft_limit"`
 }
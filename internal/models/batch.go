@@ -0,0 +1,86 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+)
+
+const MaxBatchLegs = 1000
+
+// BatchTransferRequest moves money in several legs inside one database
+// transaction. By default the batch is all-or-nothing; with BestEffort a leg
+// that fails is skipped and reported while the others still post.
+type BatchTransferRequest struct {
+	Legs       []BatchLeg `json:"legs"`
+	BestEffort bool       `json:"best_effort,omitempty"`
+}
+
+// BatchLeg is one transfer of a batch together with its outcome.
+type BatchLeg struct {
+	TransferRequest
+
+	Result *TransferResult `json:"-"`
+	Err    error           `json:"-"`
+}
+
+// Validate checks the batch as a whole. Legs are validated one by one so a
+// best-effort batch can report each failure against its leg.
+func (b *BatchTransferRequest) Validate() error {
+	if len(b.Legs) == 0 {
+		return constants.ErrEmptyBatch
+	}
+	if len(b.Legs) > MaxBatchLegs {
+		return constants.ErrBatchTooLarge
+	}
+	for i := range b.Legs {
+		if b.Legs[i].IdempotencyKey != "" {
+			return &LegError{Index: i, Err: constants.ErrBatchIdempotencyKey}
+		}
+	}
+	return nil
+}
+
+// AccountIDs returns every account the legs that have not failed post to,
+// fee accounts included, each once.
+func (b *BatchTransferRequest) AccountIDs() []int64 {
+	seen := make(map[int64]bool, len(b.Legs)+1)
+	var ids []int64
+	for _, leg := range b.Legs {
+		if leg.Err != nil {
+			continue
+		}
+		for _, id := range leg.AccountIDs() {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// Failed counts the legs that did not post.
+func (b *BatchTransferRequest) Failed() int {
+	n := 0
+	for _, leg := range b.Legs {
+		if leg.Err != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// LegError ties an error to the batch leg that caused it.
+type LegError struct {
+	Index int
+	Err   error
+}
+
+func (e *LegError) Error() string {
+	return fmt.Sprintf("leg %d: %v", e.Index, e.Err)
+}
+
+func (e *LegError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultCurrency is assumed when an account is created without a currency,
+// matching the accounts.currency column default.
+const DefaultCurrency = "USD"
+
+// currencyMinorUnits holds the ISO 4217 exponent of every supported currency.
+// It mirrors the currencies table seeded in init.sql.
+var currencyMinorUnits = map[string]int32{
+	"USD": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"CHF": 2,
+	"CAD": 2,
+	"AUD": 2,
+	"INR": 2,
+	"JPY": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"BHD": 3,
+}
+
+// NormalizeCurrency upper-cases code and defaults an empty code to DefaultCurrency.
+func NormalizeCurrency(code string) (string, error) {
+	if code == "" {
+		return DefaultCurrency, nil
+	}
+
+	code = strings.ToUpper(code)
+	if _, ok := currencyMinorUnits[code]; !ok {
+		return "", constants.ErrUnsupportedCurrency
+	}
+	return code, nil
+}
+
+// normalizeRequestCurrency upper-cases the currency a request was sent with,
+// so "usd" is accepted as USD. An empty code is left empty: the account's own
+// currency applies.
+func normalizeRequestCurrency(code *string) error {
+	if *code == "" {
+		return nil
+	}
+	currency, err := NormalizeCurrency(*code)
+	if err != nil {
+		return err
+	}
+	*code = currency
+	return nil
+}
+
+// CheckScale rejects amounts carrying more decimal places than the currency's
+// minor units allow, e.g. 1.5 JPY or 0.001 USD.
+func CheckScale(amount decimal.Decimal, currency string) error {
+	units, ok := currencyMinorUnits[currency]
+	if !ok {
+		return constants.ErrUnsupportedCurrency
+	}
+
+	if !amount.Equal(amount.Truncate(units)) {
+		return constants.ErrInvalidAmountScale
+	}
+	return nil
+}
+
+// parseCurrencyAccounts parses a comma-separated list of CURRENCY:account_id
+// pairs, such as "USD:900,EUR:901", into account IDs keyed by currency.
+func parseCurrencyAccounts(pairs string) (map[string]int64, error) {
+	ids := make(map[string]int64)
+	for _, pair := range strings.Split(pairs, ",") {
+		code, id, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok || code == "" {
+			return nil, fmt.Errorf("account %q", pair)
+		}
+		currency, err := NormalizeCurrency(code)
+		if err != nil {
+			return nil, fmt.Errorf("account %q", pair)
+		}
+		accountID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil || accountID <= 0 {
+			return nil, fmt.Errorf("account %q", pair)
+		}
+		ids[currency] = accountID
+	}
+	return ids, nil
+}
@@ -0,0 +1,150 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+
+	"github.com/shopspring/decimal"
+)
+
+type EventType string
+
+const (
+	EventAccountCreated    EventType = "AccountCreated"
+	EventTransferCompleted EventType = "TransferCompleted"
+	EventTransferFailed    EventType = "TransferFailed"
+)
+
+// Payload versions. A version is bumped whenever a payload changes in a way
+// consumers of the previous version could not read.
+const (
+	AccountCreatedVersion    = 1
+	TransferCompletedVersion = 1
+	TransferFailedVersion    = 1
+)
+
+// Event is a domain event as stored in the outbox and handed to publishers.
+// AccountID is the account the event is keyed on; for a transfer that is only
+// the source account. CounterpartyID is a transfer's destination, so a
+// consumer following an account's credits, or partitioning on both sides,
+// does not have to parse the payload.
+type Event struct {
+	ID             int64           `json:"event_id"`
+	Type           EventType       `json:"type"`
+	Version        int             `json:"version"`
+	AccountID      int64           `json:"account_id"`
+	CounterpartyID int64           `json:"counterparty_account_id,omitempty"`
+	Payload        json.RawMessage `json:"payload"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// ParseEventType accepts the event types a webhook can subscribe to.
+func ParseEventType(s string) (EventType, bool) {
+	switch t := EventType(s); t {
+	case EventAccountCreated, EventTransferCompleted, EventTransferFailed:
+		return t, true
+	default:
+		return "", false
+	}
+}
+
+type AccountCreated struct {
+	AccountID      int64           `json:"account_id"`
+	Balance        decimal.Decimal `json:"balance"`
+	Currency       string          `json:"currency"`
+	AccountClass   string          `json:"account_class"`
+	OverdraftLimit decimal.Decimal `json:"overdraft_limit"`
+}
+
+type TransferCompleted struct {
+	TransferID             int64           `json:"transfer_id"`
+	CorrelationID          int64           `json:"correlation_id"`
+	Kind                   string          `json:"kind"`
+	SourceAccountID        int64           `json:"source_account_id"`
+	DestinationAccountID   int64           `json:"destination_account_id"`
+	Amount                 decimal.Decimal `json:"amount"`
+	Currency               string          `json:"currency"`
+	DestinationAmount      decimal.Decimal `json:"destination_amount"`
+	DestinationCurrency    string          `json:"destination_currency"`
+	SourcePostBalance      decimal.Decimal `json:"source_post_balance"`
+	DestinationPostBalance decimal.Decimal `json:"destination_post_balance"`
+	GroupID                int64           `json:"group_id,omitempty"`
+	FeeOf                  int64           `json:"fee_of,omitempty"`
+	ReversalOf             int64           `json:"reversal_of,omitempty"`
+}
+
+// TransferFailed is a transfer rejected once its accounts were locked, e.g.
+// for insufficient funds or an exceeded limit. Nothing was posted.
+type TransferFailed struct {
+	CorrelationID        int64           `json:"correlation_id"`
+	Kind                 string          `json:"kind"`
+	SourceAccountID      int64           `json:"source_account_id"`
+	DestinationAccountID int64           `json:"destination_account_id"`
+	Amount               decimal.Decimal `json:"amount"`
+	Currency             string          `json:"currency,omitempty"`
+	IdempotencyKey       string          `json:"idempotency_key,omitempty"`
+	Reason               string          `json:"reason"`
+}
+
+func NewAccountCreatedEvent(acc *Account) *Event {
+	return newEvent(EventAccountCreated, AccountCreatedVersion, acc.ID, AccountCreated{
+		AccountID:      acc.ID,
+		Balance:        acc.Balance,
+		Currency:       acc.Currency,
+		AccountClass:   acc.Class,
+		OverdraftLimit: acc.OverdraftLimit,
+	})
+}
+
+// NewTransferCompletedEvent describes a transfer that has just been posted.
+func NewTransferCompletedEvent(t *Transfer) *Event {
+	kind := t.Kind
+	if kind == 0 {
+		kind = constants.KindTransfer
+	}
+	event := newEvent(EventTransferCompleted, TransferCompletedVersion, t.SourceID, TransferCompleted{
+		TransferID:             t.ID,
+		CorrelationID:          t.CorrelationID,
+		Kind:                   kind.String(),
+		SourceAccountID:        t.SourceID,
+		DestinationAccountID:   t.DestinationID,
+		Amount:                 t.Amount,
+		Currency:               t.Currency,
+		DestinationAmount:      t.DestinationAmount,
+		DestinationCurrency:    t.DestinationCurrency,
+		SourcePostBalance:      t.SourcePostBalance,
+		DestinationPostBalance: t.DestinationPostBalance,
+		GroupID:                t.GroupID,
+		FeeOf:                  t.FeeOf,
+		ReversalOf:             t.ReversalOf,
+	})
+	event.CounterpartyID = t.DestinationID
+	return event
+}
+
+func NewTransferFailedEvent(req *TransferRequest, correlationID int64, reason error) *Event {
+	kind := req.Kind
+	if kind == 0 {
+		kind = constants.KindTransfer
+	}
+	event := newEvent(EventTransferFailed, TransferFailedVersion, req.SourceID, TransferFailed{
+		CorrelationID:        correlationID,
+		Kind:                 kind.String(),
+		SourceAccountID:      req.SourceID,
+		DestinationAccountID: req.DestinationID,
+		Amount:               req.Amount,
+		Currency:             req.Currency,
+		IdempotencyKey:       req.IdempotencyKey,
+		Reason:               reason.Error(),
+	})
+	event.CounterpartyID = req.DestinationID
+	return event
+}
+
+// newEvent marshals payload, which cannot fail for the plain structs above.
+func newEvent(eventType EventType, version int, accountID int64, payload any) *Event {
+	raw, _ := json.Marshal(payload)
+	return &Event{Type: eventType, Version: version, AccountID: accountID, Payload: raw}
+}
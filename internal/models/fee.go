@@ -0,0 +1,191 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+
+	"github.com/shopspring/decimal"
+)
+
+// FeePolicy computes the fee charged on a transfer of amount, in the source
+// currency. The result is not yet rounded to the currency's minor units.
+type FeePolicy interface {
+	Fee(amount decimal.Decimal) decimal.Decimal
+}
+
+// FlatFee charges the same fee on every transfer.
+type FlatFee struct {
+	Amount decimal.Decimal
+}
+
+func (f FlatFee) Fee(decimal.Decimal) decimal.Decimal {
+	return f.Amount
+}
+
+// PercentageFee charges Rate times the amount, clamped to Min and Max when set.
+type PercentageFee struct {
+	Rate decimal.Decimal
+	Min  decimal.NullDecimal
+	Max  decimal.NullDecimal
+}
+
+func (f PercentageFee) Fee(amount decimal.Decimal) decimal.Decimal {
+	fee := amount.Mul(f.Rate)
+	if f.Min.Valid && fee.LessThan(f.Min.Decimal) {
+		fee = f.Min.Decimal
+	}
+	if f.Max.Valid && fee.GreaterThan(f.Max.Decimal) {
+		fee = f.Max.Decimal
+	}
+	return fee
+}
+
+// FeeTier applies Fee to amounts up to and including UpTo; the last tier of a
+// TieredFee has no upper bound.
+type FeeTier struct {
+	UpTo decimal.NullDecimal
+	Fee  FeePolicy
+}
+
+// TieredFee charges the whole amount by the policy of the first tier it fits.
+type TieredFee struct {
+	Tiers []FeeTier
+}
+
+func (f TieredFee) Fee(amount decimal.Decimal) decimal.Decimal {
+	for _, tier := range f.Tiers {
+		if !tier.UpTo.Valid || amount.LessThanOrEqual(tier.UpTo.Decimal) {
+			return tier.Fee.Fee(amount)
+		}
+	}
+	return decimal.Zero
+}
+
+// TransferFee is the fee the service fixed for a transfer and the account
+// that collects it.
+type TransferFee struct {
+	Amount    decimal.Decimal
+	AccountID int64
+}
+
+// FeeSchedule holds the fee policy of each account class and the fee-revenue
+// account of each currency. Classes without a policy pay no fee.
+type FeeSchedule struct {
+	Policies map[string]FeePolicy
+	Accounts map[string]int64
+}
+
+// For returns the fee policy of an account class, if it has one.
+func (s FeeSchedule) For(class string) (FeePolicy, bool) {
+	if class == "" {
+		class = DefaultAccountClass
+	}
+	p, ok := s.Policies[class]
+	return p, ok
+}
+
+// ParseFeeSchedule parses fee policies from a JSON object keyed by account
+// class, for example
+//
+//	{"standard": {"type": "percentage", "rate": "0.01", "min": "0.50", "max": "25"},
+//	 "business": {"type": "tiered", "tiers": [
+//	     {"up_to": "1000", "fee": {"type": "flat", "amount": "1"}},
+//	     {"fee": {"type": "percentage", "rate": "0.001"}}]}}
+//
+// and fee accounts from a comma-separated list of CURRENCY:account_id pairs.
+// Empty strings configure no fees.
+func ParseFeeSchedule(policies, accounts string) (FeeSchedule, error) {
+	var s FeeSchedule
+
+	if policies != "" {
+		var specs map[string]feePolicySpec
+		if err := json.Unmarshal([]byte(policies), &specs); err != nil {
+			return FeeSchedule{}, fmt.Errorf("%w: %v", constants.ErrInvalidFeePolicy, err)
+		}
+		s.Policies = make(map[string]FeePolicy, len(specs))
+		for class, spec := range specs {
+			normalized, err := NormalizeAccountClass(class)
+			if err != nil || class == "" {
+				return FeeSchedule{}, fmt.Errorf("%w: class %q", constants.ErrInvalidFeePolicy, class)
+			}
+			p, err := spec.policy()
+			if err != nil {
+				return FeeSchedule{}, fmt.Errorf("%w: class %q: %v", constants.ErrInvalidFeePolicy, class, err)
+			}
+			s.Policies[normalized] = p
+		}
+	}
+
+	if accounts != "" {
+		ids, err := parseCurrencyAccounts(accounts)
+		if err != nil {
+			return FeeSchedule{}, fmt.Errorf("%w: %v", constants.ErrInvalidFeePolicy, err)
+		}
+		s.Accounts = ids
+	}
+
+	return s, nil
+}
+
+type feePolicySpec struct {
+	Type   string              `json:"type"`
+	Amount decimal.NullDecimal `json:"amount"`
+	Rate   decimal.NullDecimal `json:"rate"`
+	Min    decimal.NullDecimal `json:"min"`
+	Max    decimal.NullDecimal `json:"max"`
+	Tiers  []feeTierSpec       `json:"tiers"`
+}
+
+type feeTierSpec struct {
+	UpTo decimal.NullDecimal `json:"up_to"`
+	Fee  *feePolicySpec      `json:"fee"`
+}
+
+func (s *feePolicySpec) policy() (FeePolicy, error) {
+	switch s.Type {
+	case "flat":
+		if !s.Amount.Valid || s.Amount.Decimal.IsNegative() {
+			return nil, fmt.Errorf("flat fee needs a non-negative amount")
+		}
+		return FlatFee{Amount: s.Amount.Decimal}, nil
+
+	case "percentage":
+		if !s.Rate.Valid || s.Rate.Decimal.IsNegative() {
+			return nil, fmt.Errorf("percentage fee needs a non-negative rate")
+		}
+		if (s.Min.Valid && s.Min.Decimal.IsNegative()) || (s.Max.Valid && s.Max.Decimal.IsNegative()) {
+			return nil, fmt.Errorf("percentage fee min and max must not be negative")
+		}
+		if s.Min.Valid && s.Max.Valid && s.Min.Decimal.GreaterThan(s.Max.Decimal) {
+			return nil, fmt.Errorf("percentage fee min exceeds max")
+		}
+		return PercentageFee{Rate: s.Rate.Decimal, Min: s.Min, Max: s.Max}, nil
+
+	case "tiered":
+		if len(s.Tiers) == 0 {
+			return nil, fmt.Errorf("tiered fee needs at least one tier")
+		}
+		tiers := make([]FeeTier, len(s.Tiers))
+		for i, t := range s.Tiers {
+			last := i == len(s.Tiers)-1
+			if last == t.UpTo.Valid {
+				return nil, fmt.Errorf("every tier but the last needs up_to")
+			}
+			if i > 0 && t.UpTo.Valid && !t.UpTo.Decimal.GreaterThan(tiers[i-1].UpTo.Decimal) {
+				return nil, fmt.Errorf("tier up_to values must increase")
+			}
+			if t.Fee == nil {
+				return nil, fmt.Errorf("tier %d has no fee", i)
+			}
+			p, err := t.Fee.policy()
+			if err != nil {
+				return nil, err
+			}
+			tiers[i] = FeeTier{UpTo: t.UpTo, Fee: p}
+		}
+		return TieredFee{Tiers: tiers}, nil
+	}
+	return nil, fmt.Errorf("unknown fee type %q", s.Type)
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+
+	"github.com/shopspring/decimal"
+)
+
+// FxRate converts one unit of Base into Rate units of Quote. Rates are
+// append-only: a new rate supersedes an old one from its EffectiveAt onward,
+// so a transfer that references a rate ID can always be recomputed.
+type FxRate struct {
+	ID          int64           `json:"rate_id"`
+	Base        string          `json:"base_currency"`
+	Quote       string          `json:"quote_currency"`
+	Rate        decimal.Decimal `json:"rate"`
+	EffectiveAt time.Time       `json:"effective_at"`
+}
+
+func (r *FxRate) Validate() error {
+	if _, ok := currencyMinorUnits[r.Base]; !ok {
+		return constants.ErrUnsupportedCurrency
+	}
+	if _, ok := currencyMinorUnits[r.Quote]; !ok {
+		return constants.ErrUnsupportedCurrency
+	}
+	if r.Base == r.Quote || !r.Rate.IsPositive() {
+		return constants.ErrInvalidFxRate
+	}
+	return nil
+}
+
+// Convert applies the rate to amount and rounds the result to the quote
+// currency's minor units using mode.
+func (r *FxRate) Convert(amount decimal.Decimal, mode RoundingMode) decimal.Decimal {
+	return mode.Round(amount.Mul(r.Rate), r.Quote)
+}
+
+// FxQuote is the conversion fixed for a single transfer. It is computed once
+// before the transfer executes and stored on its audit row.
+type FxQuote struct {
+	RateID              int64
+	Rate                decimal.Decimal
+	SourceCurrency      string
+	DestinationCurrency string
+	DestinationAmount   decimal.Decimal
+}
+
+type RoundingMode string
+
+const (
+	RoundHalfEven RoundingMode = "half_even"
+	RoundHalfUp   RoundingMode = "half_up"
+	RoundDown     RoundingMode = "down"
+)
+
+func ParseRoundingMode(s string) (RoundingMode, error) {
+	switch mode := RoundingMode(s); mode {
+	case RoundHalfEven, RoundHalfUp, RoundDown:
+		return mode, nil
+	default:
+		return "", constants.ErrInvalidRoundingMode
+	}
+}
+
+// Round rounds amount to the minor units of currency. Converted amounts are
+// always positive, so RoundDown truncates toward zero.
+func (m RoundingMode) Round(amount decimal.Decimal, currency string) decimal.Decimal {
+	units := currencyMinorUnits[currency]
+
+	switch m {
+	case RoundHalfUp:
+		return amount.Round(units)
+	case RoundDown:
+		return amount.Truncate(units)
+	default:
+		return amount.RoundBank(units)
+	}
+}
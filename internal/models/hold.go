@@ -0,0 +1,78 @@
+package models
+
+import (
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultHoldTTL applies when an authorization does not set its own expiry.
+const DefaultHoldTTL = 7 * 24 * time.Hour
+
+// Hold reserves funds on the source account for an authorized transfer. Its ID
+// is the ID of the PENDING transfer it belongs to.
+type Hold struct {
+	ID             int64                `json:"hold_id"`
+	CorrelationID  int64                `json:"correlation_id"`
+	SourceID       int64                `json:"source_account_id"`
+	DestinationID  int64                `json:"destination_account_id"`
+	Amount         decimal.Decimal      `json:"amount"`
+	CapturedAmount decimal.Decimal      `json:"captured_amount"`
+	Currency       string               `json:"currency"`
+	Status         constants.HoldStatus `json:"status"`
+	ExpiresAt      time.Time            `json:"expires_at"`
+	CreatedAt      time.Time            `json:"created_at"`
+}
+
+// CheckCapturable reports whether the hold can still be settled at now.
+func (h *Hold) CheckCapturable(now time.Time) error {
+	if h.Status != constants.HoldActive {
+		return constants.ErrHoldNotActive
+	}
+	if !now.Before(h.ExpiresAt) {
+		return constants.ErrHoldExpired
+	}
+	return nil
+}
+
+// CaptureAmount resolves the amount to settle: zero means the full hold, and
+// anything above the held amount is rejected. The remainder is released.
+func (h *Hold) CaptureAmount(requested decimal.Decimal) (decimal.Decimal, error) {
+	if requested.IsZero() {
+		return h.Amount, nil
+	}
+	if requested.IsNegative() {
+		return decimal.Zero, constants.ErrAmountMustBePositive
+	}
+	if requested.GreaterThan(h.Amount) {
+		return decimal.Zero, constants.ErrCaptureExceedsHold
+	}
+	return requested, CheckScale(requested, h.Currency)
+}
+
+// AuthorizeRequest places a hold for a future transfer. Holds settle in the
+// source currency only, so conversion is not accepted.
+type AuthorizeRequest struct {
+	TransferRequest
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (r *AuthorizeRequest) Validate(now time.Time) error {
+	if err := r.TransferRequest.Validate(); err != nil {
+		return err
+	}
+	if r.Convert {
+		return constants.ErrHoldCurrencyMismatch
+	}
+	if !r.ExpiresAt.IsZero() && !r.ExpiresAt.After(now) {
+		return constants.ErrInvalidHoldExpiry
+	}
+	return nil
+}
+
+type CaptureRequest struct {
+	HoldID int64           `json:"-"`
+	Amount decimal.Decimal `json:"amount"`
+}
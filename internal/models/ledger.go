@@ -0,0 +1,81 @@
+package models
+
+import (
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+
+	"github.com/shopspring/decimal"
+)
+
+// FxPositionAccountID marks ledger entries booked against the house FX position
+// rather than a customer account. They are stored with a NULL account_id.
+const FxPositionAccountID int64 = 0
+
+// LedgerEntry is one posting against an account. Debits are negative and
+// credits positive, so an account's balance is its opening balance plus the
+// sum of its entries.
+type LedgerEntry struct {
+	ID         int64           `json:"entry_id"`
+	TransferID int64           `json:"transfer_id"`
+	AccountID  int64           `json:"account_id"`
+	Amount     decimal.Decimal `json:"amount"`
+	Currency   string          `json:"currency"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// Journal groups the entries posted for a single transfer.
+type Journal struct {
+	TransferID int64
+	Entries    []LedgerEntry
+}
+
+// NewTransferJournal builds the debit/credit pair for moving amount from source to destination.
+func NewTransferJournal(transferID, sourceID, destinationID int64, amount decimal.Decimal, currency string) Journal {
+	return Journal{
+		TransferID: transferID,
+		Entries: []LedgerEntry{
+			{TransferID: transferID, AccountID: sourceID, Amount: amount.Neg(), Currency: currency},
+			{TransferID: transferID, AccountID: destinationID, Amount: amount, Currency: currency},
+		},
+	}
+}
+
+// NewFxTransferJournal books a conversion through the FX position: the source
+// amount moves into the position in the source currency and the destination
+// amount moves out of it in the destination currency, so each currency nets to zero.
+func NewFxTransferJournal(transferID, sourceID, destinationID int64, quote *FxQuote, sourceAmount decimal.Decimal) Journal {
+	return Journal{
+		TransferID: transferID,
+		Entries: []LedgerEntry{
+			{TransferID: transferID, AccountID: sourceID, Amount: sourceAmount.Neg(), Currency: quote.SourceCurrency},
+			{TransferID: transferID, AccountID: FxPositionAccountID, Amount: sourceAmount, Currency: quote.SourceCurrency},
+			{TransferID: transferID, AccountID: FxPositionAccountID, Amount: quote.DestinationAmount.Neg(), Currency: quote.DestinationCurrency},
+			{TransferID: transferID, AccountID: destinationID, Amount: quote.DestinationAmount, Currency: quote.DestinationCurrency},
+		},
+	}
+}
+
+// Validate enforces the double-entry invariant: at least one debit and one
+// credit, no zero postings, and entries summing to zero within each currency.
+func (j Journal) Validate() error {
+	if len(j.Entries) < 2 {
+		return constants.ErrUnbalancedJournal
+	}
+
+	sums := make(map[string]decimal.Decimal)
+	for _, e := range j.Entries {
+		if e.Amount.IsZero() || e.TransferID != j.TransferID {
+			return constants.ErrUnbalancedJournal
+		}
+		sums[e.Currency] = sums[e.Currency].Add(e.Amount)
+	}
+
+	for _, sum := range sums {
+		if !sum.IsZero() {
+			return constants.ErrUnbalancedJournal
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,240 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+
+	"github.com/shopspring/decimal"
+)
+
+// LimitKind names one of the limits in TransferLimits.
+type LimitKind string
+
+const (
+	LimitMaxSingle     LimitKind = "max_single"
+	LimitDailyAmount   LimitKind = "daily_amount"
+	LimitDailyCount    LimitKind = "daily_count"
+	LimitMonthlyAmount LimitKind = "monthly_amount"
+	LimitMonthlyCount  LimitKind = "monthly_count"
+)
+
+// TransferLimits caps the transfers paid out of an account, in the account's
+// currency. Days and months are calendar periods in UTC. An invalid amount or
+// a zero count means no limit.
+type TransferLimits struct {
+	MaxSingle     decimal.NullDecimal `json:"max_single"`
+	DailyAmount   decimal.NullDecimal `json:"daily_amount"`
+	DailyCount    int64               `json:"daily_count"`
+	MonthlyAmount decimal.NullDecimal `json:"monthly_amount"`
+	MonthlyCount  int64               `json:"monthly_count"`
+}
+
+// AccountLimits are the limits set for one account in account_limits. Each
+// one set replaces the default of its kind; the rest fall back to the defaults.
+type AccountLimits struct {
+	AccountID int64
+	Limits    TransferLimits
+}
+
+// maxLimitCount is the largest count account_limits can store.
+const maxLimitCount = math.MaxInt32
+
+// Validate checks limits set for an account held in currency: amounts must be
+// positive and fit the currency's minor units, and counts must not be negative.
+// Unset amounts and zero counts are left to the defaults.
+func (l TransferLimits) Validate(currency string) error {
+	for _, amount := range []decimal.NullDecimal{l.MaxSingle, l.DailyAmount, l.MonthlyAmount} {
+		if !amount.Valid {
+			continue
+		}
+		if !amount.Decimal.IsPositive() {
+			return constants.ErrInvalidLimit
+		}
+		if err := CheckScale(amount.Decimal, currency); err != nil {
+			return err
+		}
+	}
+	for _, count := range []int64{l.DailyCount, l.MonthlyCount} {
+		if count < 0 || count > maxLimitCount {
+			return constants.ErrInvalidLimit
+		}
+	}
+	return nil
+}
+
+// LimitDefaults are the limits of accounts without an override of their own.
+// Amounts are set per currency, since one number cannot cap both a USD and a
+// JPY account; counts apply to every account.
+type LimitDefaults struct {
+	MaxSingle     map[string]decimal.Decimal
+	DailyAmount   map[string]decimal.Decimal
+	DailyCount    int64
+	MonthlyAmount map[string]decimal.Decimal
+	MonthlyCount  int64
+}
+
+// ParseLimitDefaults parses limits from configuration. Amounts are
+// comma-separated CURRENCY:amount pairs, such as "USD:5000,JPY:750000", and
+// counts plain numbers; an empty string leaves that limit unset.
+func ParseLimitDefaults(maxSingle, dailyAmount, dailyCount, monthlyAmount, monthlyCount string) (LimitDefaults, error) {
+	var (
+		d   LimitDefaults
+		err error
+	)
+	if d.MaxSingle, err = parseCurrencyLimits(maxSingle); err != nil {
+		return LimitDefaults{}, err
+	}
+	if d.DailyAmount, err = parseCurrencyLimits(dailyAmount); err != nil {
+		return LimitDefaults{}, err
+	}
+	if d.DailyCount, err = parseLimitCount(dailyCount); err != nil {
+		return LimitDefaults{}, err
+	}
+	if d.MonthlyAmount, err = parseCurrencyLimits(monthlyAmount); err != nil {
+		return LimitDefaults{}, err
+	}
+	if d.MonthlyCount, err = parseLimitCount(monthlyCount); err != nil {
+		return LimitDefaults{}, err
+	}
+	return d, nil
+}
+
+// For returns the default limits of an account held in currency. A currency
+// the defaults do not name has no amount limits.
+func (d LimitDefaults) For(currency string) TransferLimits {
+	return TransferLimits{
+		MaxSingle:     currencyLimit(d.MaxSingle, currency),
+		DailyAmount:   currencyLimit(d.DailyAmount, currency),
+		DailyCount:    d.DailyCount,
+		MonthlyAmount: currencyLimit(d.MonthlyAmount, currency),
+		MonthlyCount:  d.MonthlyCount,
+	}
+}
+
+func currencyLimit(amounts map[string]decimal.Decimal, currency string) decimal.NullDecimal {
+	amount, ok := amounts[currency]
+	return decimal.NullDecimal{Decimal: amount, Valid: ok}
+}
+
+func parseCurrencyLimits(pairs string) (map[string]decimal.Decimal, error) {
+	if pairs == "" {
+		return nil, nil
+	}
+	amounts := make(map[string]decimal.Decimal)
+	for _, pair := range strings.Split(pairs, ",") {
+		code, value, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", constants.ErrInvalidLimit, pair)
+		}
+		currency, err := NormalizeCurrency(code)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", constants.ErrInvalidLimit, pair)
+		}
+		amount, err := decimal.NewFromString(value)
+		if err != nil || !amount.IsPositive() || CheckScale(amount, currency) != nil {
+			return nil, fmt.Errorf("%w: %q", constants.ErrInvalidLimit, pair)
+		}
+		amounts[currency] = amount
+	}
+	return amounts, nil
+}
+
+func parseLimitCount(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n <= 0 {
+		return 0, constants.ErrInvalidLimit
+	}
+	return n, nil
+}
+
+// Override returns l with every limit set in o replacing its own.
+func (l TransferLimits) Override(o TransferLimits) TransferLimits {
+	if o.MaxSingle.Valid {
+		l.MaxSingle = o.MaxSingle
+	}
+	if o.DailyAmount.Valid {
+		l.DailyAmount = o.DailyAmount
+	}
+	if o.DailyCount > 0 {
+		l.DailyCount = o.DailyCount
+	}
+	if o.MonthlyAmount.Valid {
+		l.MonthlyAmount = o.MonthlyAmount
+	}
+	if o.MonthlyCount > 0 {
+		l.MonthlyCount = o.MonthlyCount
+	}
+	return l
+}
+
+// Periodic reports whether any limit depends on earlier transfers.
+func (l TransferLimits) Periodic() bool {
+	return l.DailyAmount.Valid || l.DailyCount > 0 || l.MonthlyAmount.Valid || l.MonthlyCount > 0
+}
+
+// TransferUsage is what an account has already paid out in the current day
+// and month.
+type TransferUsage struct {
+	DailyAmount   decimal.Decimal
+	DailyCount    int64
+	MonthlyAmount decimal.Decimal
+	MonthlyCount  int64
+}
+
+// Check returns a *LimitError for the first limit a transfer of amount would
+// break on top of used.
+func (l TransferLimits) Check(amount decimal.Decimal, used TransferUsage) error {
+	if l.MaxSingle.Valid && amount.GreaterThan(l.MaxSingle.Decimal) {
+		return &LimitError{Limit: LimitMaxSingle, Remaining: l.MaxSingle.Decimal}
+	}
+	if err := checkCount(LimitDailyCount, l.DailyCount, used.DailyCount); err != nil {
+		return err
+	}
+	if err := checkAmount(LimitDailyAmount, l.DailyAmount, used.DailyAmount, amount); err != nil {
+		return err
+	}
+	if err := checkCount(LimitMonthlyCount, l.MonthlyCount, used.MonthlyCount); err != nil {
+		return err
+	}
+	return checkAmount(LimitMonthlyAmount, l.MonthlyAmount, used.MonthlyAmount, amount)
+}
+
+func checkCount(kind LimitKind, limit, used int64) error {
+	if limit > 0 && used >= limit {
+		return &LimitError{Limit: kind, Remaining: decimal.Zero}
+	}
+	return nil
+}
+
+func checkAmount(kind LimitKind, limit decimal.NullDecimal, used, amount decimal.Decimal) error {
+	if !limit.Valid {
+		return nil
+	}
+	remaining := decimal.Max(limit.Decimal.Sub(used), decimal.Zero)
+	if amount.GreaterThan(remaining) {
+		return &LimitError{Limit: kind, Remaining: remaining}
+	}
+	return nil
+}
+
+// LimitError reports the limit a transfer broke and how much of it is left:
+// an amount for the amount limits and a number of transfers for the counts.
+type LimitError struct {
+	Limit     LimitKind
+	Remaining decimal.Decimal
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%v: %s, remaining %s", constants.ErrLimitExceeded, e.Limit, e.Remaining)
+}
+
+func (e *LimitError) Unwrap() error {
+	return constants.ErrLimitExceeded
+}
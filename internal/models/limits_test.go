@@ -0,0 +1,84 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+)
+
+func TestParseLimitDefaults(t *testing.T) {
+	defaults, err := ParseLimitDefaults("usd:5000, JPY:750000", "USD:10000.50", "20", "", "")
+	require.NoError(t, err)
+
+	usd := defaults.For("USD")
+	assert.Equal(t, decimal.NewNullDecimal(decimal.NewFromInt(5000)), usd.MaxSingle)
+	assert.Equal(t, decimal.NewNullDecimal(decimal.RequireFromString("10000.50")), usd.DailyAmount)
+	assert.Equal(t, int64(20), usd.DailyCount)
+	assert.False(t, usd.MonthlyAmount.Valid)
+
+	jpy := defaults.For("JPY")
+	assert.Equal(t, decimal.NewNullDecimal(decimal.NewFromInt(750000)), jpy.MaxSingle)
+	assert.False(t, jpy.DailyAmount.Valid)
+	assert.Equal(t, int64(20), jpy.DailyCount)
+
+	eur := defaults.For("EUR")
+	assert.False(t, eur.MaxSingle.Valid)
+	assert.Equal(t, int64(20), eur.DailyCount)
+}
+
+func TestParseLimitDefaults_Invalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		maxSingle string
+		count     string
+	}{
+		{name: "Bare Amount", maxSingle: "5000"},
+		{name: "Unknown Currency", maxSingle: "XYZ:5000"},
+		{name: "Zero Amount", maxSingle: "USD:0"},
+		{name: "Negative Amount", maxSingle: "USD:-5"},
+		{name: "Too Many Decimal Places", maxSingle: "JPY:100.5"},
+		{name: "Trailing Comma", maxSingle: "USD:5000,"},
+		{name: "Zero Count", count: "0"},
+		{name: "Non-Numeric Count", count: "ten"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseLimitDefaults(tt.maxSingle, "", tt.count, "", "")
+			assert.ErrorIs(t, err, constants.ErrInvalidLimit)
+		})
+	}
+}
+
+func TestTransferLimits_Validate(t *testing.T) {
+	amount := func(s string) decimal.NullDecimal { return decimal.NewNullDecimal(decimal.RequireFromString(s)) }
+
+	tests := []struct {
+		name   string
+		limits TransferLimits
+		want   error
+	}{
+		{name: "None Set", limits: TransferLimits{}},
+		{name: "Amounts And Counts", limits: TransferLimits{MaxSingle: amount("250.50"), DailyCount: 20, MonthlyAmount: amount("9000")}},
+		{name: "Zero Amount", limits: TransferLimits{DailyAmount: amount("0")}, want: constants.ErrInvalidLimit},
+		{name: "Negative Amount", limits: TransferLimits{MaxSingle: amount("-1")}, want: constants.ErrInvalidLimit},
+		{name: "Too Many Decimal Places", limits: TransferLimits{MaxSingle: amount("0.001")}, want: constants.ErrInvalidAmountScale},
+		{name: "Negative Count", limits: TransferLimits{MonthlyCount: -1}, want: constants.ErrInvalidLimit},
+		{name: "Count Too Large", limits: TransferLimits{DailyCount: 1 << 31}, want: constants.ErrInvalidLimit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.limits.Validate("USD")
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
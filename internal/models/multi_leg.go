@@ -0,0 +1,120 @@
+package models
+
+import (
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+
+	"github.com/shopspring/decimal"
+)
+
+const MaxTransferLegs = 100
+
+// Leg is one account debited or credited by a multi-leg transfer.
+type Leg struct {
+	AccountID int64           `json:"account_id"`
+	Amount    decimal.Decimal `json:"amount"`
+}
+
+// MultiLegTransferRequest debits one or more accounts and credits one or more
+// others as a single business transaction, e.g. a payout split between a
+// seller and a platform fee. All accounts share one currency and the debits
+// must total the credits.
+type MultiLegTransferRequest struct {
+	Debits   []Leg  `json:"debits"`
+	Credits  []Leg  `json:"credits"`
+	Currency string `json:"currency,omitempty"`
+}
+
+func (r *MultiLegTransferRequest) Validate() error {
+	if len(r.Debits) == 0 || len(r.Credits) == 0 {
+		return constants.ErrInvalidLegs
+	}
+	if len(r.Debits)+len(r.Credits) > MaxTransferLegs {
+		return constants.ErrTooManyLegs
+	}
+
+	if err := normalizeRequestCurrency(&r.Currency); err != nil {
+		return err
+	}
+
+	seen := make(map[int64]bool, len(r.Debits)+len(r.Credits))
+	var totals [2]decimal.Decimal
+	for i, side := range [][]Leg{r.Debits, r.Credits} {
+		for _, leg := range side {
+			if leg.AccountID <= 0 {
+				return constants.ErrInvalidAccountID
+			}
+			if seen[leg.AccountID] {
+				return constants.ErrDuplicateLegAccount
+			}
+			seen[leg.AccountID] = true
+
+			if !leg.Amount.IsPositive() {
+				return constants.ErrAmountMustBePositive
+			}
+			if r.Currency != "" {
+				if err := CheckScale(leg.Amount, r.Currency); err != nil {
+					return err
+				}
+			}
+			totals[i] = totals[i].Add(leg.Amount)
+		}
+	}
+	if !totals[0].Equal(totals[1]) {
+		return constants.ErrUnbalancedLegs
+	}
+	return nil
+}
+
+// AccountIDs returns every account the request debits or credits.
+func (r *MultiLegTransferRequest) AccountIDs() []int64 {
+	ids := make([]int64, 0, len(r.Debits)+len(r.Credits))
+	for _, leg := range r.Debits {
+		ids = append(ids, leg.AccountID)
+	}
+	for _, leg := range r.Credits {
+		ids = append(ids, leg.AccountID)
+	}
+	return ids
+}
+
+// Split pairs debits with credits in order into plain source-to-destination
+// transfers, at most len(Debits)+len(Credits)-1 of them, that together move
+// exactly the requested amounts. The request must be valid.
+func (r *MultiLegTransferRequest) Split() []TransferRequest {
+	var (
+		pairs      []TransferRequest
+		d, c       int
+		debitLeft  = r.Debits[0].Amount
+		creditLeft = r.Credits[0].Amount
+	)
+	for d < len(r.Debits) && c < len(r.Credits) {
+		amount := decimal.Min(debitLeft, creditLeft)
+		pairs = append(pairs, TransferRequest{
+			SourceID:      r.Debits[d].AccountID,
+			DestinationID: r.Credits[c].AccountID,
+			Amount:        amount,
+			Currency:      r.Currency,
+		})
+
+		debitLeft = debitLeft.Sub(amount)
+		creditLeft = creditLeft.Sub(amount)
+		if debitLeft.IsZero() {
+			if d++; d < len(r.Debits) {
+				debitLeft = r.Debits[d].Amount
+			}
+		}
+		if creditLeft.IsZero() {
+			if c++; c < len(r.Credits) {
+				creditLeft = r.Credits[c].Amount
+			}
+		}
+	}
+	return pairs
+}
+
+// MultiLegResult is a posted multi-leg transfer: the group ID shared by its
+// transfer rows and the result of each row in posting order.
+type MultiLegResult struct {
+	GroupID   int64            `json:"group_id"`
+	Transfers []TransferResult `json:"transfers"`
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// AccountBalance is the stored opening and current balance of an account.
+type AccountBalance struct {
+	AccountID      int64
+	OpeningBalance decimal.Decimal
+	Balance        decimal.Decimal
+}
+
+const (
+	ChainBreakPrevBalance = "prev_balance_mismatch"
+	ChainBreakPostBalance = "post_balance_mismatch"
+)
+
+// ChainBreak is a transfer whose recorded balances do not follow from the
+// account's previous transfer (prev mismatch) or from its own amount (post mismatch).
+type ChainBreak struct {
+	AccountID  int64           `json:"account_id"`
+	TransferID int64           `json:"transfer_id"`
+	Reason     string          `json:"reason"`
+	Expected   decimal.Decimal `json:"expected"`
+	Actual     decimal.Decimal `json:"actual"`
+}
+
+// BalanceMismatch is an account whose stored balance differs from the balance
+// recomputed from its opening balance and transfer history.
+type BalanceMismatch struct {
+	AccountID int64           `json:"account_id"`
+	Expected  decimal.Decimal `json:"expected_balance"`
+	Actual    decimal.Decimal `json:"actual_balance"`
+}
+
+type ReconciliationReport struct {
+	AccountsChecked  int               `json:"accounts_checked"`
+	TransfersChecked int               `json:"transfers_checked"`
+	Mismatches       []BalanceMismatch `json:"mismatches"`
+	BrokenChains     []ChainBreak      `json:"broken_chains"`
+	StartedAt        time.Time         `json:"started_at"`
+	FinishedAt       time.Time         `json:"finished_at"`
+}
+
+func (r *ReconciliationReport) Consistent() bool {
+	return len(r.Mismatches) == 0 && len(r.BrokenChains) == 0
+}
@@ -0,0 +1,163 @@
+package models
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+)
+
+const MinScheduleInterval = time.Minute
+
+// Recurrence yields the occurrences of a recurring schedule.
+type Recurrence interface {
+	// Next returns the first occurrence strictly after t, or the zero time if
+	// there is none.
+	Next(t time.Time) time.Time
+}
+
+var cronShorthands = map[string]string{
+	"@hourly":  "0 * * * *",
+	"@daily":   "0 0 * * *",
+	"@weekly":  "0 0 * * 0",
+	"@monthly": "0 0 1 * *",
+}
+
+// ParseRecurrence parses a five-field cron expression (minute hour
+// day-of-month month day-of-week, evaluated in UTC), one of the shorthands
+// above, or "@every <duration>". Cron fields accept *, numbers, ranges a-b,
+// steps /n and comma-separated lists.
+func ParseRecurrence(rule string) (Recurrence, error) {
+	rule = strings.TrimSpace(rule)
+	if d, ok := strings.CutPrefix(rule, "@every "); ok {
+		interval, err := time.ParseDuration(strings.TrimSpace(d))
+		if err != nil || interval < MinScheduleInterval {
+			return nil, constants.ErrInvalidSchedule
+		}
+		return every(interval), nil
+	}
+	if expanded, ok := cronShorthands[rule]; ok {
+		rule = expanded
+	}
+	return parseCron(rule)
+}
+
+type every time.Duration
+
+func (d every) Next(t time.Time) time.Time {
+	return t.Add(time.Duration(d))
+}
+
+// cronSchedule holds one bit per allowed value of each field.
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// Cron matches a day when either day field matches, unless one of them
+	// starts with *.
+	domStar, dowStar bool
+}
+
+func parseCron(rule string) (*cronSchedule, error) {
+	fields := strings.Fields(rule)
+	if len(fields) != 5 {
+		return nil, constants.ErrInvalidSchedule
+	}
+
+	var (
+		c   cronSchedule
+		err error
+	)
+	if c.minute, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, err
+	}
+	if c.hour, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, err
+	}
+	if c.dom, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, err
+	}
+	if c.month, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, err
+	}
+	if c.dow, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, err
+	}
+	// 7 is an alias for Sunday.
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+	c.domStar = strings.HasPrefix(fields[2], "*")
+	c.dowStar = strings.HasPrefix(fields[4], "*")
+	return &c, nil
+}
+
+func parseCronField(field string, lo, hi int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		spec, stepStr, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepStr)
+			if err != nil || n <= 0 {
+				return 0, constants.ErrInvalidSchedule
+			}
+			step = n
+		}
+
+		first, last := lo, hi
+		if spec != "*" {
+			from, to, isRange := strings.Cut(spec, "-")
+			n, err := strconv.Atoi(from)
+			if err != nil {
+				return 0, constants.ErrInvalidSchedule
+			}
+			first, last = n, n
+			if isRange {
+				if last, err = strconv.Atoi(to); err != nil {
+					return 0, constants.ErrInvalidSchedule
+				}
+			} else if hasStep {
+				last = hi
+			}
+		}
+		if first < lo || last > hi || first > last {
+			return 0, constants.ErrInvalidSchedule
+		}
+
+		for v := first; v <= last; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// Next looks up to five years ahead, which covers any satisfiable expression.
+func (c *cronSchedule) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		switch {
+		case c.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !c.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (c *cronSchedule) dayMatches(t time.Time) bool {
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
@@ -0,0 +1,111 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+)
+
+func utc(year int, month time.Month, day, hour, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+}
+
+func TestParseRecurrence_Next(t *testing.T) {
+	// 2026-01-15 is a Thursday.
+	thursday := utc(2026, time.January, 15, 10, 30)
+
+	tests := []struct {
+		name string
+		rule string
+		from time.Time
+		want time.Time
+	}{
+		{name: "First Of The Month", rule: "0 0 1 * *", from: thursday, want: utc(2026, time.February, 1, 0, 0)},
+		{name: "First Of The Month From The Last Minute", rule: "0 0 1 * *", from: utc(2026, time.January, 31, 23, 59), want: utc(2026, time.February, 1, 0, 0)},
+		{name: "Monthly Shorthand", rule: "@monthly", from: thursday, want: utc(2026, time.February, 1, 0, 0)},
+		{name: "Strictly After The Given Time", rule: "30 10 * * *", from: thursday, want: utc(2026, time.January, 16, 10, 30)},
+		{name: "Day Of Month Only", rule: "0 9 12 * *", from: thursday, want: utc(2026, time.February, 12, 9, 0)},
+		{name: "Day Of Week Only", rule: "0 9 * * 5", from: thursday, want: utc(2026, time.January, 16, 9, 0)},
+		{name: "Either Day Field Matches: Weekday First", rule: "0 9 12 * 5", from: thursday, want: utc(2026, time.January, 16, 9, 0)},
+		{name: "Either Day Field Matches: Day Of Month First", rule: "0 9 12 * 5", from: utc(2026, time.February, 10, 10, 0), want: utc(2026, time.February, 12, 9, 0)},
+		{name: "Starred Day Of Month Needs Both", rule: "0 9 */2 * 5", from: thursday, want: utc(2026, time.January, 23, 9, 0)},
+		{name: "Step Over Whole Range", rule: "*/15 * * * *", from: thursday, want: utc(2026, time.January, 15, 10, 45)},
+		{name: "Step Within Range", rule: "5-20/5 * * * *", from: utc(2026, time.January, 15, 10, 21), want: utc(2026, time.January, 15, 11, 5)},
+		{name: "Step From Value", rule: "10/20 * * * *", from: utc(2026, time.January, 15, 10, 31), want: utc(2026, time.January, 15, 10, 50)},
+		{name: "Ranges Roll Over The Weekend", rule: "0 9-17 * * 1-5", from: utc(2026, time.January, 16, 17, 50), want: utc(2026, time.January, 19, 9, 0)},
+		{name: "List", rule: "0 8,20 * * *", from: thursday, want: utc(2026, time.January, 15, 20, 0)},
+		{name: "Seven Is Sunday", rule: "0 0 * * 7", from: thursday, want: utc(2026, time.January, 18, 0, 0)},
+		{name: "Zero Is Sunday", rule: "@weekly", from: thursday, want: utc(2026, time.January, 18, 0, 0)},
+		{name: "Leap Day", rule: "0 0 29 2 *", from: thursday, want: utc(2028, time.February, 29, 0, 0)},
+		{name: "Never Matches", rule: "0 0 30 2 *", from: thursday, want: time.Time{}},
+		{name: "Every Interval", rule: "@every 90m", from: thursday, want: utc(2026, time.January, 15, 12, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, err := ParseRecurrence(tt.rule)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, rec.Next(tt.from))
+		})
+	}
+}
+
+func TestParseRecurrence_Invalid(t *testing.T) {
+	for _, rule := range []string{
+		"",
+		"0 0 * *",
+		"0 0 * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"@yearly",
+		"@every 30s",
+		"@every soon",
+	} {
+		t.Run(rule, func(t *testing.T) {
+			_, err := ParseRecurrence(rule)
+			assert.ErrorIs(t, err, constants.ErrInvalidSchedule)
+		})
+	}
+}
+
+func TestScheduledTransfer_NextRunAfter(t *testing.T) {
+	runAt := utc(2026, time.January, 10, 0, 0)
+
+	tests := []struct {
+		name     string
+		schedule string
+		now      time.Time
+		want     time.Time
+	}{
+		{name: "One-Off Has No Next Run", schedule: "", now: runAt, want: time.Time{}},
+		{name: "On Time Runs The Following Occurrence", schedule: "@daily", now: runAt.Add(time.Minute), want: utc(2026, time.January, 11, 0, 0)},
+		{name: "Missed Occurrences Skipped", schedule: "@daily", now: utc(2026, time.January, 15, 10, 30), want: utc(2026, time.January, 16, 0, 0)},
+		{name: "Occurrence Due Exactly Now Skipped", schedule: "@daily", now: utc(2026, time.January, 12, 0, 0), want: utc(2026, time.January, 13, 0, 0)},
+		{name: "Missed Intervals Skipped", schedule: "@every 1h", now: utc(2026, time.January, 10, 5, 30), want: utc(2026, time.January, 10, 6, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sched := &ScheduledTransfer{Schedule: tt.schedule}
+			next, err := sched.NextRunAfter(runAt, tt.now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, next)
+		})
+	}
+
+	t.Run("Invalid Schedule", func(t *testing.T) {
+		sched := &ScheduledTransfer{Schedule: "not a rule"}
+		_, err := sched.NextRunAfter(runAt, runAt)
+		assert.ErrorIs(t, err, constants.ErrInvalidSchedule)
+	})
+}
@@ -0,0 +1,108 @@
+package models
+
+import (
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+
+	"github.com/shopspring/decimal"
+)
+
+const MaxReversalReasonLength = 255
+
+// ReversalRequest refunds some or all of a posted transfer. Amount is in the
+// original source currency, i.e. what goes back to the original payer; zero
+// reverses whatever has not been reversed yet.
+type ReversalRequest struct {
+	TransferID     int64           `json:"-"`
+	Amount         decimal.Decimal `json:"amount"`
+	Reason         string          `json:"reason"`
+	IdempotencyKey string          `json:"-"`
+	Rounding       RoundingMode    `json:"-"`
+}
+
+func (r *ReversalRequest) Validate() error {
+	if r.TransferID <= 0 {
+		return constants.ErrInvalidTransferID
+	}
+	if r.Amount.IsNegative() {
+		return constants.ErrAmountMustBePositive
+	}
+	if r.Reason == "" || len(r.Reason) > MaxReversalReasonLength {
+		return constants.ErrInvalidReversalReason
+	}
+	if len(r.IdempotencyKey) > MaxIdempotencyKeyLength {
+		return constants.ErrInvalidIdempotencyKey
+	}
+	return nil
+}
+
+// SamePayload reports whether reversal, stored under r.IdempotencyKey, was
+// posted for the same request. A request for the remainder matches whatever
+// amount that was.
+func (r *ReversalRequest) SamePayload(reversal *Transfer) bool {
+	return reversal.ReversalOf == r.TransferID &&
+		reversal.Reason == r.Reason &&
+		(r.Amount.IsZero() || r.Amount.Equal(reversal.DestinationAmount))
+}
+
+// Reversal is the compensating transfer together with the original it reverses.
+type Reversal struct {
+	Reversal Transfer `json:"reversal"`
+	Original Transfer `json:"original"`
+}
+
+// CheckReversible reports whether t can still be reversed. Reversals
+// themselves cannot be; send a new transfer instead. Neither can the fee
+// posted for a transfer: reversing the transfer refunds only its amount, since
+// the fee was earned by moving the money, and a fee waived as a goodwill
+// gesture is sent back from the fee account as a transfer of its own.
+func (t *Transfer) CheckReversible() error {
+	if t.ReversalOf != 0 || t.FeeOf != 0 {
+		return constants.ErrTransferNotReversible
+	}
+	if t.Status != constants.StatusCompleted && t.Status != constants.StatusPartiallyReversed {
+		return constants.ErrTransferNotReversible
+	}
+	return nil
+}
+
+// ReversalAmounts resolves a reversal of requested (zero meaning the rest) into
+// the refund credited to the original source and the amount debited from the
+// original destination. A converted transfer is reversed at its original rate;
+// the final reversal debits exactly what is left of DestinationAmount after
+// destReversed, so rounding never strands a remainder or overdraws it.
+func (t *Transfer) ReversalAmounts(requested, destReversed decimal.Decimal, mode RoundingMode) (refund, debit decimal.Decimal, err error) {
+	remaining := t.Amount.Sub(t.ReversedAmount)
+
+	refund = requested
+	if refund.IsZero() {
+		refund = remaining
+	}
+	if refund.GreaterThan(remaining) {
+		return decimal.Zero, decimal.Zero, constants.ErrReversalTooLarge
+	}
+	if err := CheckScale(refund, t.Currency); err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+
+	if !t.FxRate.Valid {
+		return refund, refund, nil
+	}
+
+	left := t.DestinationAmount.Sub(destReversed)
+	debit = mode.Round(refund.Mul(t.FxRate.Decimal), t.DestinationCurrency)
+	if refund.Equal(remaining) || debit.GreaterThan(left) {
+		debit = left
+	}
+	if !debit.IsPositive() {
+		return decimal.Zero, decimal.Zero, constants.ErrConvertedAmountTooSmall
+	}
+	return refund, debit, nil
+}
+
+// ReversedStatus is the status of t once refund more has been reversed.
+func (t *Transfer) ReversedStatus(refund decimal.Decimal) constants.TransferStatus {
+	if t.ReversedAmount.Add(refund).Equal(t.Amount) {
+		return constants.StatusReversed
+	}
+	return constants.StatusPartiallyReversed
+}
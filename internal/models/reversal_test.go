@@ -0,0 +1,46 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+)
+
+func TestTransfer_CheckReversible(t *testing.T) {
+	tests := []struct {
+		name     string
+		transfer Transfer
+		wantErr  error
+	}{
+		{name: "Completed", transfer: Transfer{Status: constants.StatusCompleted}},
+		{name: "Partially Reversed", transfer: Transfer{Status: constants.StatusPartiallyReversed}},
+		{name: "Completed With Fee", transfer: Transfer{Status: constants.StatusCompleted, Fee: decimal.NewFromInt(1)}},
+		{name: "Fully Reversed", transfer: Transfer{Status: constants.StatusReversed}, wantErr: constants.ErrTransferNotReversible},
+		{name: "Failed", transfer: Transfer{Status: constants.StatusFailed}, wantErr: constants.ErrTransferNotReversible},
+		{name: "A Reversal", transfer: Transfer{Status: constants.StatusCompleted, ReversalOf: 7}, wantErr: constants.ErrTransferNotReversible},
+		{name: "A Fee", transfer: Transfer{Status: constants.StatusCompleted, FeeOf: 7}, wantErr: constants.ErrTransferNotReversible},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, tt.transfer.CheckReversible())
+		})
+	}
+}
+
+func TestTransfer_ReversalAmounts_FeeNotRefunded(t *testing.T) {
+	original := Transfer{
+		Status: constants.StatusCompleted, Amount: decimal.NewFromInt(100), Currency: "USD",
+		DestinationAmount: decimal.NewFromInt(100), DestinationCurrency: "USD", Fee: decimal.NewFromInt(2),
+	}
+
+	refund, debit, err := original.ReversalAmounts(decimal.Zero, decimal.Zero, RoundHalfEven)
+	require.NoError(t, err)
+	assert.True(t, decimal.NewFromInt(100).Equal(refund))
+	assert.True(t, decimal.NewFromInt(100).Equal(debit))
+	assert.Equal(t, constants.StatusReversed, original.ReversedStatus(refund))
+}
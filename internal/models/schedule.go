@@ -0,0 +1,115 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+
+	"github.com/shopspring/decimal"
+)
+
+// ScheduleRequest sets up a future transfer. Without a Schedule it runs once
+// at StartAt; with one it recurs, starting at StartAt for an @every interval
+// and at the first cron occurrence after StartAt (or now) otherwise.
+type ScheduleRequest struct {
+	TransferRequest
+	Schedule string    `json:"schedule,omitempty"`
+	StartAt  time.Time `json:"start_at,omitempty"`
+}
+
+// FirstRun validates the request and returns when it first runs.
+func (r *ScheduleRequest) FirstRun(now time.Time) (time.Time, error) {
+	if err := r.TransferRequest.Validate(); err != nil {
+		return time.Time{}, err
+	}
+	if !r.StartAt.IsZero() && !r.StartAt.After(now) {
+		return time.Time{}, constants.ErrInvalidStartAt
+	}
+
+	if r.Schedule == "" {
+		if r.StartAt.IsZero() {
+			return time.Time{}, constants.ErrInvalidStartAt
+		}
+		return r.StartAt, nil
+	}
+
+	rec, err := ParseRecurrence(r.Schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if _, isInterval := rec.(every); isInterval && !r.StartAt.IsZero() {
+		return r.StartAt, nil
+	}
+
+	from := now
+	if r.StartAt.After(now) {
+		from = r.StartAt
+	}
+	first := rec.Next(from)
+	if first.IsZero() {
+		return time.Time{}, constants.ErrInvalidSchedule
+	}
+	return first, nil
+}
+
+type ScheduledTransfer struct {
+	ID            int64                    `json:"schedule_id"`
+	SourceID      int64                    `json:"source_account_id"`
+	DestinationID int64                    `json:"destination_account_id"`
+	Amount        decimal.Decimal          `json:"amount"`
+	Currency      string                   `json:"currency,omitempty"`
+	Convert       bool                     `json:"convert,omitempty"`
+	Schedule      string                   `json:"schedule,omitempty"`
+	Status        constants.ScheduleStatus `json:"status"`
+	NextRunAt     time.Time                `json:"next_run_at,omitempty"`
+	LastRun       *ScheduledRun            `json:"last_run,omitempty"`
+	CreatedAt     time.Time                `json:"created_at"`
+	// Attempts counts the tries of the occurrence due at NextRunAt that failed
+	// without an answer from the ledger.
+	Attempts int `json:"-"`
+}
+
+// TransferFor builds the transfer for the occurrence due at runAt. Its
+// idempotency key is fixed per occurrence, so a run retried after a crash
+// replays the transfer instead of paying twice.
+func (s *ScheduledTransfer) TransferFor(runAt time.Time) *TransferRequest {
+	return &TransferRequest{
+		SourceID:       s.SourceID,
+		DestinationID:  s.DestinationID,
+		Amount:         s.Amount,
+		Currency:       s.Currency,
+		Convert:        s.Convert,
+		IdempotencyKey: fmt.Sprintf("schedule-%d-%d", s.ID, runAt.Unix()),
+	}
+}
+
+// NextRunAfter returns the occurrence following the one due at runAt,
+// skipping any that are already past at now. The zero time means the
+// schedule has no further runs.
+func (s *ScheduledTransfer) NextRunAfter(runAt, now time.Time) (time.Time, error) {
+	if s.Schedule == "" {
+		return time.Time{}, nil
+	}
+	rec, err := ParseRecurrence(s.Schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	next := rec.Next(runAt)
+	for !next.IsZero() && !next.After(now) {
+		next = rec.Next(next)
+	}
+	return next, nil
+}
+
+// ScheduledRun records one occurrence of a scheduled transfer. TransferID is
+// set when it succeeded and Error when it failed.
+type ScheduledRun struct {
+	ScheduleID   int64               `json:"-"`
+	ScheduledFor time.Time           `json:"scheduled_for"`
+	Status       constants.RunStatus `json:"status"`
+	TransferID   int64               `json:"transfer_id,omitempty"`
+	Error        string              `json:"error,omitempty"`
+	ExecutedAt   time.Time           `json:"executed_at"`
+}
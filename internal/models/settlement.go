@@ -0,0 +1,88 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+
+	"github.com/shopspring/decimal"
+)
+
+// SettlementAccounts maps each currency to the system account that stands for
+// money outside the system. A deposit is a transfer from it and a withdrawal a
+// transfer to it, so the balances of all accounts always sum to their opening
+// balances and the settlement balance explains every unit that came or went.
+type SettlementAccounts map[string]int64
+
+// ParseSettlementAccounts parses a comma-separated list of CURRENCY:account_id
+// pairs. An empty string configures none, which disables deposits and withdrawals.
+func ParseSettlementAccounts(accounts string) (SettlementAccounts, error) {
+	if accounts == "" {
+		return nil, nil
+	}
+	ids, err := parseCurrencyAccounts(accounts)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", constants.ErrInvalidSettlement, err)
+	}
+	return ids, nil
+}
+
+// CashRequest deposits money into or withdraws it from an account.
+type CashRequest struct {
+	AccountID      int64           `json:"-"`
+	Amount         decimal.Decimal `json:"amount"`
+	Currency       string          `json:"currency,omitempty"`
+	IdempotencyKey string          `json:"-"`
+}
+
+func (r *CashRequest) Validate() error {
+	if r.AccountID <= 0 {
+		return constants.ErrInvalidAccountID
+	}
+
+	if r.Amount.LessThanOrEqual(decimal.Zero) {
+		return constants.ErrAmountMustBePositive
+	}
+
+	if len(r.IdempotencyKey) > MaxIdempotencyKeyLength {
+		return constants.ErrInvalidIdempotencyKey
+	}
+
+	if err := normalizeRequestCurrency(&r.Currency); err != nil {
+		return err
+	}
+	if r.Currency != "" {
+		return CheckScale(r.Amount, r.Currency)
+	}
+
+	return nil
+}
+
+// Transfer returns the transfer that settles r of the given kind against the
+// settlement account: from it for a deposit, to it for a withdrawal.
+func (r *CashRequest) Transfer(kind constants.TransferKind, settlementID int64) *TransferRequest {
+	req := &TransferRequest{
+		SourceID:       r.AccountID,
+		DestinationID:  settlementID,
+		Amount:         r.Amount,
+		Currency:       r.Currency,
+		IdempotencyKey: r.IdempotencyKey,
+		Kind:           kind,
+	}
+	if kind == constants.KindDeposit {
+		req.SourceID, req.DestinationID = settlementID, r.AccountID
+	}
+	return req
+}
+
+// CashResult is the outcome of a deposit or withdrawal.
+type CashResult struct {
+	AuditID       int64
+	CorrelationID int64
+	AccountID     int64
+	Kind          constants.TransferKind
+	Amount        string
+	Currency      string
+	// NewBalance is the balance of the account after the deposit or withdrawal.
+	NewBalance string
+}
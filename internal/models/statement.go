@@ -0,0 +1,120 @@
+package models
+
+import (
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxStatementDays bounds the range of a single statement.
+const MaxStatementDays = 366
+
+type StatementFormat string
+
+const (
+	StatementJSON  StatementFormat = "json"
+	StatementCSV   StatementFormat = "csv"
+	StatementMT940 StatementFormat = "mt940"
+)
+
+// ParseStatementFormat defaults an empty format to JSON.
+func ParseStatementFormat(s string) (StatementFormat, error) {
+	switch f := StatementFormat(s); f {
+	case "":
+		return StatementJSON, nil
+	case StatementJSON, StatementCSV, StatementMT940:
+		return f, nil
+	default:
+		return "", constants.ErrInvalidStatementFormat
+	}
+}
+
+func (f StatementFormat) ContentType() string {
+	switch f {
+	case StatementCSV:
+		return "text/csv"
+	case StatementMT940:
+		return "text/plain"
+	default:
+		return "application/json"
+	}
+}
+
+// StatementQuery selects the transfers posted to an account after From up to
+// and including To, so the opening and closing balances are the balances at
+// From and To as reported by GetBalanceAt.
+type StatementQuery struct {
+	AccountID int64
+	From      time.Time
+	To        time.Time
+}
+
+func (q *StatementQuery) Validate() error {
+	if q.AccountID <= 0 {
+		return constants.ErrInvalidAccountID
+	}
+
+	if q.From.IsZero() || q.To.IsZero() || q.From.After(q.To) {
+		return constants.ErrInvalidTimeRange
+	}
+
+	if q.To.Sub(q.From) > MaxStatementDays*24*time.Hour {
+		return constants.ErrStatementRangeTooLong
+	}
+
+	return nil
+}
+
+const (
+	StatementLineFee      = "FEE"
+	StatementLineReversal = "REVERSAL"
+)
+
+// StatementLineType labels a posted transfer on a statement: fees and
+// reversals by what they are, anything else by its kind.
+func StatementLineType(kind constants.TransferKind, feeOf, reversalOf int64) string {
+	switch {
+	case feeOf != 0:
+		return StatementLineFee
+	case reversalOf != 0:
+		return StatementLineReversal
+	default:
+		return kind.String()
+	}
+}
+
+// StatementLine is one transfer as seen from the statement's account: exactly
+// one of Debit and Credit is set, in the account's currency, and Balance is
+// the account's balance right after it.
+type StatementLine struct {
+	TransferID     int64           `json:"transfer_id"`
+	PostedAt       time.Time       `json:"posted_at"`
+	Type           string          `json:"type"`
+	CounterpartyID int64           `json:"counterparty_id"`
+	Debit          decimal.Decimal `json:"debit"`
+	Credit         decimal.Decimal `json:"credit"`
+	Balance        decimal.Decimal `json:"balance"`
+}
+
+type Statement struct {
+	AccountID      int64           `json:"account_id"`
+	Currency       string          `json:"currency"`
+	From           time.Time       `json:"from"`
+	To             time.Time       `json:"to"`
+	OpeningBalance decimal.Decimal `json:"opening_balance"`
+	ClosingBalance decimal.Decimal `json:"closing_balance"`
+	TotalDebits    decimal.Decimal `json:"total_debits"`
+	TotalCredits   decimal.Decimal `json:"total_credits"`
+	Lines          []StatementLine `json:"lines"`
+}
+
+// Add appends a line in posting order, accumulating the totals and moving the
+// closing balance to the line's balance.
+func (s *Statement) Add(line StatementLine) {
+	s.Lines = append(s.Lines, line)
+	s.TotalDebits = s.TotalDebits.Add(line.Debit)
+	s.TotalCredits = s.TotalCredits.Add(line.Credit)
+	s.ClosingBalance = line.Balance
+}
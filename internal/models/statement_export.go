@@ -0,0 +1,121 @@
+package models
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Write renders the statement in format f.
+func (s *Statement) Write(w io.Writer, f StatementFormat) error {
+	switch f {
+	case StatementCSV:
+		return s.writeCSV(w)
+	case StatementMT940:
+		return s.writeMT940(w)
+	default:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(s)
+	}
+}
+
+// writeCSV writes one row per line between an opening and a closing balance
+// row, with debits and credits in separate columns.
+func (s *Statement) writeCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	rows := [][]string{
+		{"posted_at", "transfer_id", "type", "counterparty_id", "debit", "credit", "balance", "currency"},
+		{s.From.UTC().Format(time.RFC3339), "", "OPENING_BALANCE", "", "", "", s.OpeningBalance.String(), s.Currency},
+	}
+	for _, l := range s.Lines {
+		rows = append(rows, []string{
+			l.PostedAt.UTC().Format(time.RFC3339Nano),
+			strconv.FormatInt(l.TransferID, 10),
+			l.Type,
+			strconv.FormatInt(l.CounterpartyID, 10),
+			csvAmount(l.Debit),
+			csvAmount(l.Credit),
+			l.Balance.String(),
+			s.Currency,
+		})
+	}
+	rows = append(rows, []string{
+		s.To.UTC().Format(time.RFC3339), "", "CLOSING_BALANCE", "", s.TotalDebits.String(), s.TotalCredits.String(),
+		s.ClosingBalance.String(), s.Currency,
+	})
+
+	if err := cw.WriteAll(rows); err != nil {
+		return fmt.Errorf("write csv statement: %w", err)
+	}
+	return nil
+}
+
+func csvAmount(d decimal.Decimal) string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.String()
+}
+
+// writeMT940 writes the text block of a SWIFT MT940 customer statement: dates
+// are UTC, amounts carry the currency's minor units with a decimal comma, and
+// each :61: entry is referenced by its transfer_id.
+func (s *Statement) writeMT940(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	field := func(tag, value string) {
+		_, _ = bw.WriteString(":" + tag + ":" + value + "\r\n")
+	}
+
+	field("20", "STMT"+s.To.UTC().Format("060102"))
+	field("25", strconv.FormatInt(s.AccountID, 10))
+	field("28C", "1")
+	field("60F", s.mt940Balance(s.From, s.OpeningBalance))
+	for _, l := range s.Lines {
+		mark, amount, code := "C", l.Credit, "NTRF"
+		if l.Debit.IsPositive() {
+			mark, amount = "D", l.Debit
+		}
+		if l.Type == StatementLineFee {
+			code = "NCHG"
+		}
+		posted := l.PostedAt.UTC()
+		field("61", posted.Format("060102")+posted.Format("0102")+mark+s.mt940Amount(amount)+code+
+			strconv.FormatInt(l.TransferID, 10))
+		field("86", fmt.Sprintf("%s COUNTERPARTY %d", l.Type, l.CounterpartyID))
+	}
+	field("62F", s.mt940Balance(s.To, s.ClosingBalance))
+	_, _ = bw.WriteString("-\r\n")
+
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("write mt940 statement: %w", err)
+	}
+	return nil
+}
+
+func (s *Statement) mt940Balance(at time.Time, balance decimal.Decimal) string {
+	mark := "C"
+	if balance.IsNegative() {
+		mark = "D"
+	}
+	return mark + at.UTC().Format("060102") + s.Currency + s.mt940Amount(balance)
+}
+
+func (s *Statement) mt940Amount(d decimal.Decimal) string {
+	units, ok := currencyMinorUnits[s.Currency]
+	if !ok {
+		units = 2
+	}
+	amount := d.Abs().StringFixed(units)
+	if units == 0 {
+		return amount + ","
+	}
+	return strings.Replace(amount, ".", ",", 1)
+}
@@ -6,10 +6,51 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+const MaxIdempotencyKeyLength = 255
+
 type TransferRequest struct {
-	SourceID      int64           `json:"source_account_id"`
-	DestinationID int64           `json:"destination_account_id"`
-	Amount        decimal.Decimal `json:"amount"`
+	SourceID       int64           `json:"source_account_id"`
+	DestinationID  int64           `json:"destination_account_id"`
+	Amount         decimal.Decimal `json:"amount"`
+	Currency       string          `json:"currency,omitempty"`
+	Convert        bool            `json:"convert,omitempty"`
+	IdempotencyKey string          `json:"-"`
+
+	// Quote is the FX conversion fixed by the service for a cross-currency transfer.
+	Quote *FxQuote `json:"-"`
+	// GroupID links the transfers posted for one multi-leg transfer.
+	GroupID int64 `json:"-"`
+	// Fee is the fee fixed by the service, charged on top of Amount.
+	Fee *TransferFee `json:"-"`
+	// FeeOf is set on the transfer that collects the fee of another transfer.
+	FeeOf int64 `json:"-"`
+	// Kind marks deposits and withdrawals; zero is a plain transfer.
+	Kind constants.TransferKind `json:"-"`
+}
+
+// Debit is the total leaving the source account: the amount plus any fee.
+func (r *TransferRequest) Debit() decimal.Decimal {
+	if r.Fee == nil {
+		return r.Amount
+	}
+	return r.Amount.Add(r.Fee.Amount)
+}
+
+// AccountIDs returns every account the transfer posts to, including the fee
+// account if a fee is charged.
+func (r *TransferRequest) AccountIDs() []int64 {
+	if r.Fee == nil {
+		return []int64{r.SourceID, r.DestinationID}
+	}
+	return []int64{r.SourceID, r.DestinationID, r.Fee.AccountID}
+}
+
+// FeeAmount is the fee charged on the transfer, zero if none.
+func (r *TransferRequest) FeeAmount() decimal.Decimal {
+	if r.Fee == nil {
+		return decimal.Zero
+	}
+	return r.Fee.Amount
 }
 
 func (r *TransferRequest) Validate() error {
@@ -25,5 +66,43 @@ func (r *TransferRequest) Validate() error {
 		return constants.ErrSameAccount
 	}
 
+	if len(r.IdempotencyKey) > MaxIdempotencyKeyLength {
+		return constants.ErrInvalidIdempotencyKey
+	}
+
+	if err := normalizeRequestCurrency(&r.Currency); err != nil {
+		return err
+	}
+	if r.Currency != "" {
+		return CheckScale(r.Amount, r.Currency)
+	}
+
 	return nil
 }
+
+// CheckCurrency verifies the amount is expressed in the source currency and fits
+// its minor units. Accounts in different currencies additionally require the
+// request to ask for conversion.
+func (r *TransferRequest) CheckCurrency(src, dest *Account) error {
+	if err := normalizeRequestCurrency(&r.Currency); err != nil {
+		return err
+	}
+	if src.Currency != dest.Currency && !r.Convert {
+		return constants.ErrCurrencyMismatch
+	}
+	if r.Currency != "" && r.Currency != src.Currency {
+		return constants.ErrCurrencyMismatch
+	}
+	return CheckScale(r.Amount, src.Currency)
+}
+
+// SamePayload reports whether other describes the same money movement,
+// ignoring the idempotency key itself. A retry that omits the currency matches
+// the stored transfer, which always records it.
+func (r *TransferRequest) SamePayload(other *TransferRequest) bool {
+	return r.SourceID == other.SourceID &&
+		r.DestinationID == other.DestinationID &&
+		r.Amount.Equal(other.Amount) &&
+		(other.Currency == "" || r.Currency == other.Currency) &&
+		r.Convert == other.Convert
+}
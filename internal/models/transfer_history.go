@@ -0,0 +1,110 @@
+package models
+
+import (
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	DefaultTransferPageSize = 50
+	MaxTransferPageSize     = 200
+)
+
+// Transfer is a row of the transfers audit table.
+type Transfer struct {
+	ID                     int64                    `json:"transfer_id"`
+	CorrelationID          int64                    `json:"correlation_id"`
+	Status                 constants.TransferStatus `json:"status"`
+	SourceID               int64                    `json:"source_account_id"`
+	DestinationID          int64                    `json:"destination_account_id"`
+	Amount                 decimal.Decimal          `json:"amount"`
+	Currency               string                   `json:"currency"`
+	DestinationAmount      decimal.Decimal          `json:"destination_amount"`
+	DestinationCurrency    string                   `json:"destination_currency"`
+	FxRateID               int64                    `json:"fx_rate_id,omitempty"`
+	FxRate                 decimal.NullDecimal      `json:"fx_rate"`
+	SourcePrevBalance      decimal.Decimal          `json:"source_prev_balance"`
+	SourcePostBalance      decimal.Decimal          `json:"source_post_balance"`
+	DestinationPrevBalance decimal.Decimal          `json:"destination_prev_balance"`
+	DestinationPostBalance decimal.Decimal          `json:"destination_post_balance"`
+	ReversalOf             int64                    `json:"reversal_of,omitempty"`
+	ReversedAmount         decimal.Decimal          `json:"reversed_amount"`
+	Reason                 string                   `json:"reason,omitempty"`
+	GroupID                int64                    `json:"group_id,omitempty"`
+	Fee                    decimal.Decimal          `json:"fee"`
+	FeeOf                  int64                    `json:"fee_of,omitempty"`
+	Kind                   constants.TransferKind   `json:"kind"`
+	CreatedAt              time.Time                `json:"created_at"`
+}
+
+// NullDecimalString formats d, or returns "" when it is null, such as the
+// FX rate of a transfer made without conversion.
+func NullDecimalString(d decimal.NullDecimal) string {
+	if !d.Valid {
+		return ""
+	}
+	return d.Decimal.String()
+}
+
+type TransferDirection int
+
+const (
+	DirectionAll TransferDirection = iota
+	DirectionOutgoing
+	DirectionIncoming
+)
+
+// TransferQuery selects a page of an account's transfer history, newest first.
+// Cursor is the ID of the last transfer of the previous page (0 for the first page);
+// zero From/To leave that side of the time range open.
+type TransferQuery struct {
+	AccountID int64
+	Direction TransferDirection
+	From      time.Time
+	To        time.Time
+	Cursor    int64
+	Limit     int
+}
+
+func (q *TransferQuery) Validate() error {
+	if q.AccountID <= 0 {
+		return constants.ErrInvalidAccountID
+	}
+
+	if q.Direction < DirectionAll || q.Direction > DirectionIncoming {
+		return constants.ErrInvalidDirection
+	}
+
+	if !q.From.IsZero() && !q.To.IsZero() && q.From.After(q.To) {
+		return constants.ErrInvalidTimeRange
+	}
+
+	if q.Cursor < 0 {
+		return constants.ErrInvalidCursor
+	}
+
+	switch {
+	case q.Limit <= 0:
+		q.Limit = DefaultTransferPageSize
+	case q.Limit > MaxTransferPageSize:
+		q.Limit = MaxTransferPageSize
+	}
+
+	return nil
+}
+
+// TransferCursor is a position in the postings to an account. PostingSeq is
+// the posting_seq of the last transfer seen, 0 before the first.
+type TransferCursor struct {
+	AccountID  int64
+	PostingSeq int64
+}
+
+// TransferPage is one page of transfer history. NextCursor is 0 when there are no more rows.
+type TransferPage struct {
+	Transfers  []Transfer
+	NextCursor int64
+}
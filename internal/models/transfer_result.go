@@ -5,9 +5,18 @@ import (
 )
 
 type TransferResult struct {
-	AuditID           int64
-	CorrelationID     int64
-	Status            string
-	SourcePostBalance string
-	CreatedAt         time.Time
+	AuditID                int64
+	CorrelationID          int64
+	Status                 string
+	SourceID               int64
+	DestinationID          int64
+	SourcePostBalance      string
+	DestinationPostBalance string
+	SourceAmount           string
+	SourceCurrency         string
+	DestinationAmount      string
+	DestinationCurrency    string
+	FxRate                 string
+	Fee                    string
+	CreatedAt              time.Time
 }
@@ -0,0 +1,112 @@
+package models
+
+import (
+	"net/netip"
+	"net/url"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+)
+
+// WebhookRequest subscribes a URL to events. AccountID 0 subscribes to every
+// account; otherwise only events concerning that account, as either side of a
+// transfer, are sent. An empty Secret is generated by the Core service.
+type WebhookRequest struct {
+	URL        string      `json:"url"`
+	Secret     string      `json:"secret,omitempty"`
+	EventTypes []EventType `json:"event_types"`
+	AccountID  int64       `json:"account_id,omitempty"`
+}
+
+func (r *WebhookRequest) Validate() error {
+	u, err := url.Parse(r.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return constants.ErrInvalidWebhookURL
+	}
+	if r.Secret != "" && (len(r.Secret) < 16 || len(r.Secret) > 255) {
+		return constants.ErrInvalidWebhookSecret
+	}
+	if r.AccountID < 0 {
+		return constants.ErrInvalidAccountID
+	}
+	if len(r.EventTypes) == 0 {
+		return constants.ErrInvalidEventTypes
+	}
+	for _, t := range r.EventTypes {
+		if _, ok := ParseEventType(string(t)); !ok {
+			return constants.ErrInvalidEventTypes
+		}
+	}
+	return nil
+}
+
+// IsPublicWebhookAddr reports whether a webhook may be delivered to addr. Loopback,
+// private, link-local (including cloud metadata endpoints such as
+// 169.254.169.254), unspecified and multicast addresses are refused so a
+// subscription cannot point Core at its own network.
+func IsPublicWebhookAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsMulticast() &&
+		!addr.IsUnspecified()
+}
+
+// WebhookSubscription is a registered webhook. Secret signs its deliveries and
+// is only returned when the subscription is created.
+type WebhookSubscription struct {
+	ID         int64                   `json:"webhook_id"`
+	URL        string                  `json:"url"`
+	Secret     string                  `json:"secret,omitempty"`
+	EventTypes []EventType             `json:"event_types"`
+	AccountID  int64                   `json:"account_id,omitempty"`
+	Status     constants.WebhookStatus `json:"status"`
+	CreatedAt  time.Time               `json:"created_at"`
+}
+
+// WebhookDelivery is one event due to be posted to one subscription.
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID int64
+	URL            string
+	Secret         string
+	Attempts       int
+	Event          Event
+}
+
+// WebhookDeadLetter is a delivery that used up its attempts.
+type WebhookDeadLetter struct {
+	ID             int64     `json:"dead_letter_id"`
+	SubscriptionID int64     `json:"webhook_id"`
+	EventID        int64     `json:"event_id"`
+	EventType      EventType `json:"event_type"`
+	Attempts       int       `json:"attempts"`
+	LastError      string    `json:"last_error"`
+	FailedAt       time.Time `json:"failed_at"`
+}
+
+// WebhookRetryPolicy spaces out failed deliveries: the wait after attempt n
+// is BaseDelay doubled n-1 times, capped at MaxDelay. A delivery that fails
+// MaxAttempts times is dead-lettered.
+type WebhookRetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+func DefaultWebhookRetryPolicy() WebhookRetryPolicy {
+	return WebhookRetryPolicy{MaxAttempts: 8, BaseDelay: 10 * time.Second, MaxDelay: time.Hour}
+}
+
+// Backoff returns how long to wait after the given failed attempt.
+func (p WebhookRetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempt && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxDelay)
+}
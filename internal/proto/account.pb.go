@@ -22,11 +22,14 @@ const (
 )
 
 type CreateAccountRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Balance       string                 `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AccountId      int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Balance        string                 `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Currency       string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	AccountClass   string                 `protobuf:"bytes,4,opt,name=account_class,json=accountClass,proto3" json:"account_class,omitempty"`
+	OverdraftLimit string                 `protobuf:"bytes,5,opt,name=overdraft_limit,json=overdraftLimit,proto3" json:"overdraft_limit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateAccountRequest) Reset() {
@@ -73,6 +76,27 @@ func (x *CreateAccountRequest) GetBalance() string {
 	return ""
 }
 
+func (x *CreateAccountRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetAccountClass() string {
+	if x != nil {
+		return x.AccountClass
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetOverdraftLimit() string {
+	if x != nil {
+		return x.OverdraftLimit
+	}
+	return ""
+}
+
 type CreateAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -117,19 +141,1019 @@ func (x *CreateAccountResponse) GetSuccess() bool {
 	return false
 }
 
-var File_internal_proto_account_proto protoreflect.FileDescriptor
+type GetAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_internal_proto_account_proto_rawDesc = "" +
-	"\n" +
-	"\x1cinternal/proto/account.proto\x12\btransfer\"O\n" +
-	"\x14CreateAccountRequest\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x18\n" +
-	"\abalance\x18\x02 \x01(\tR\abalance\"1\n" +
-	"\x15CreateAccountResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2b\n" +
-	"\x0eAccountService\x12P\n" +
-	"\rCreateAccount\x12\x1e.transfer.CreateAccountRequest\x1a\x1f.transfer.CreateAccountResponseB@Z>github.com/jhaprabhatt/account-transfer-project/internal/protob\x06proto3"
+func (x *GetAccountRequest) Reset() {
+	*x = GetAccountRequest{}
+	mi := &file_internal_proto_account_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRequest) ProtoMessage() {}
+
+func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_account_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_account_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAccountRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type GetAccountResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AccountId        int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Balance          string                 `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Status           string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Currency         string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	HeldBalance      string                 `protobuf:"bytes,5,opt,name=held_balance,json=heldBalance,proto3" json:"held_balance,omitempty"`
+	AvailableBalance string                 `protobuf:"bytes,6,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
+	AccountClass     string                 `protobuf:"bytes,7,opt,name=account_class,json=accountClass,proto3" json:"account_class,omitempty"`
+	OverdraftLimit   string                 `protobuf:"bytes,8,opt,name=overdraft_limit,json=overdraftLimit,proto3" json:"overdraft_limit,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetAccountResponse) Reset() {
+	*x = GetAccountResponse{}
+	mi := &file_internal_proto_account_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountResponse) ProtoMessage() {}
+
+func (x *GetAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_account_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_account_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetAccountResponse) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *GetAccountResponse) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *GetAccountResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetAccountResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetAccountResponse) GetHeldBalance() string {
+	if x != nil {
+		return x.HeldBalance
+	}
+	return ""
+}
+
+func (x *GetAccountResponse) GetAvailableBalance() string {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return ""
+}
+
+func (x *GetAccountResponse) GetAccountClass() string {
+	if x != nil {
+		return x.AccountClass
+	}
+	return ""
+}
+
+func (x *GetAccountResponse) GetOverdraftLimit() string {
+	if x != nil {
+		return x.OverdraftLimit
+	}
+	return ""
+}
+
+// UpdateAccount changes the settings of an existing account.
+type UpdateAccountRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AccountId      int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	OverdraftLimit string                 `protobuf:"bytes,2,opt,name=overdraft_limit,json=overdraftLimit,proto3" json:"overdraft_limit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateAccountRequest) Reset() {
+	*x = UpdateAccountRequest{}
+	mi := &file_internal_proto_account_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountRequest) ProtoMessage() {}
+
+func (x *UpdateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_account_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_account_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateAccountRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *UpdateAccountRequest) GetOverdraftLimit() string {
+	if x != nil {
+		return x.OverdraftLimit
+	}
+	return ""
+}
+
+type AccountStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountStatusRequest) Reset() {
+	*x = AccountStatusRequest{}
+	mi := &file_internal_proto_account_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountStatusRequest) ProtoMessage() {}
+
+func (x *AccountStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_account_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountStatusRequest.ProtoReflect.Descriptor instead.
+func (*AccountStatusRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_account_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AccountStatusRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type AccountStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountStatusResponse) Reset() {
+	*x = AccountStatusResponse{}
+	mi := &file_internal_proto_account_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountStatusResponse) ProtoMessage() {}
+
+func (x *AccountStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_account_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountStatusResponse.ProtoReflect.Descriptor instead.
+func (*AccountStatusResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_account_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AccountStatusResponse) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AccountStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// at is an RFC 3339 timestamp.
+type GetBalanceAtRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	At            string                 `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceAtRequest) Reset() {
+	*x = GetBalanceAtRequest{}
+	mi := &file_internal_proto_account_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceAtRequest) ProtoMessage() {}
+
+func (x *GetBalanceAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_account_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceAtRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceAtRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_account_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetBalanceAtRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *GetBalanceAtRequest) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+// transfer_id is the last transfer posted to the account by at, 0 if none.
+type GetBalanceAtResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Balance       string                 `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	At            string                 `protobuf:"bytes,4,opt,name=at,proto3" json:"at,omitempty"`
+	TransferId    int64                  `protobuf:"varint,5,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceAtResponse) Reset() {
+	*x = GetBalanceAtResponse{}
+	mi := &file_internal_proto_account_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceAtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceAtResponse) ProtoMessage() {}
+
+func (x *GetBalanceAtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_account_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceAtResponse.ProtoReflect.Descriptor instead.
+func (*GetBalanceAtResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_account_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetBalanceAtResponse) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *GetBalanceAtResponse) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *GetBalanceAtResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetBalanceAtResponse) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+func (x *GetBalanceAtResponse) GetTransferId() int64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+// from and to are RFC 3339 timestamps; the statement covers transfers posted
+// after from up to and including to.
+type GetStatementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatementRequest) Reset() {
+	*x = GetStatementRequest{}
+	mi := &file_internal_proto_account_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatementRequest) ProtoMessage() {}
+
+func (x *GetStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_account_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatementRequest.ProtoReflect.Descriptor instead.
+func (*GetStatementRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_account_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetStatementRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *GetStatementRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetStatementRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+// debit and credit are empty on the side the line did not move.
+type StatementLine struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TransferId     int64                  `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	PostedAt       string                 `protobuf:"bytes,2,opt,name=posted_at,json=postedAt,proto3" json:"posted_at,omitempty"`
+	Type           string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	CounterpartyId int64                  `protobuf:"varint,4,opt,name=counterparty_id,json=counterpartyId,proto3" json:"counterparty_id,omitempty"`
+	Debit          string                 `protobuf:"bytes,5,opt,name=debit,proto3" json:"debit,omitempty"`
+	Credit         string                 `protobuf:"bytes,6,opt,name=credit,proto3" json:"credit,omitempty"`
+	Balance        string                 `protobuf:"bytes,7,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StatementLine) Reset() {
+	*x = StatementLine{}
+	mi := &file_internal_proto_account_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatementLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatementLine) ProtoMessage() {}
+
+func (x *StatementLine) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_account_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatementLine.ProtoReflect.Descriptor instead.
+func (*StatementLine) Descriptor() ([]byte, []int) {
+	return file_internal_proto_account_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StatementLine) GetTransferId() int64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+func (x *StatementLine) GetPostedAt() string {
+	if x != nil {
+		return x.PostedAt
+	}
+	return ""
+}
+
+func (x *StatementLine) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StatementLine) GetCounterpartyId() int64 {
+	if x != nil {
+		return x.CounterpartyId
+	}
+	return 0
+}
+
+func (x *StatementLine) GetDebit() string {
+	if x != nil {
+		return x.Debit
+	}
+	return ""
+}
+
+func (x *StatementLine) GetCredit() string {
+	if x != nil {
+		return x.Credit
+	}
+	return ""
+}
+
+func (x *StatementLine) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+type GetStatementResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AccountId      int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Currency       string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	From           string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To             string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	OpeningBalance string                 `protobuf:"bytes,5,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"`
+	ClosingBalance string                 `protobuf:"bytes,6,opt,name=closing_balance,json=closingBalance,proto3" json:"closing_balance,omitempty"`
+	TotalDebits    string                 `protobuf:"bytes,7,opt,name=total_debits,json=totalDebits,proto3" json:"total_debits,omitempty"`
+	TotalCredits   string                 `protobuf:"bytes,8,opt,name=total_credits,json=totalCredits,proto3" json:"total_credits,omitempty"`
+	Lines          []*StatementLine       `protobuf:"bytes,9,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetStatementResponse) Reset() {
+	*x = GetStatementResponse{}
+	mi := &file_internal_proto_account_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatementResponse) ProtoMessage() {}
+
+func (x *GetStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_account_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatementResponse.ProtoReflect.Descriptor instead.
+func (*GetStatementResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_account_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetStatementResponse) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *GetStatementResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetStatementResponse) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetStatementResponse) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetStatementResponse) GetOpeningBalance() string {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return ""
+}
+
+func (x *GetStatementResponse) GetClosingBalance() string {
+	if x != nil {
+		return x.ClosingBalance
+	}
+	return ""
+}
+
+func (x *GetStatementResponse) GetTotalDebits() string {
+	if x != nil {
+		return x.TotalDebits
+	}
+	return ""
+}
+
+func (x *GetStatementResponse) GetTotalCredits() string {
+	if x != nil {
+		return x.TotalCredits
+	}
+	return ""
+}
+
+func (x *GetStatementResponse) GetLines() []*StatementLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+// SetAccountLimits replaces the account's own transfer limits. Amounts are in
+// the account's currency; an empty amount or a zero count falls back to the
+// default for that limit.
+type SetAccountLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	MaxSingle     string                 `protobuf:"bytes,2,opt,name=max_single,json=maxSingle,proto3" json:"max_single,omitempty"`
+	DailyAmount   string                 `protobuf:"bytes,3,opt,name=daily_amount,json=dailyAmount,proto3" json:"daily_amount,omitempty"`
+	DailyCount    int64                  `protobuf:"varint,4,opt,name=daily_count,json=dailyCount,proto3" json:"daily_count,omitempty"`
+	MonthlyAmount string                 `protobuf:"bytes,5,opt,name=monthly_amount,json=monthlyAmount,proto3" json:"monthly_amount,omitempty"`
+	MonthlyCount  int64                  `protobuf:"varint,6,opt,name=monthly_count,json=monthlyCount,proto3" json:"monthly_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAccountLimitsRequest) Reset() {
+	*x = SetAccountLimitsRequest{}
+	mi := &file_internal_proto_account_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccountLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccountLimitsRequest) ProtoMessage() {}
+
+func (x *SetAccountLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_account_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccountLimitsRequest.ProtoReflect.Descriptor instead.
+func (*SetAccountLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_account_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetAccountLimitsRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *SetAccountLimitsRequest) GetMaxSingle() string {
+	if x != nil {
+		return x.MaxSingle
+	}
+	return ""
+}
+
+func (x *SetAccountLimitsRequest) GetDailyAmount() string {
+	if x != nil {
+		return x.DailyAmount
+	}
+	return ""
+}
+
+func (x *SetAccountLimitsRequest) GetDailyCount() int64 {
+	if x != nil {
+		return x.DailyCount
+	}
+	return 0
+}
+
+func (x *SetAccountLimitsRequest) GetMonthlyAmount() string {
+	if x != nil {
+		return x.MonthlyAmount
+	}
+	return ""
+}
+
+func (x *SetAccountLimitsRequest) GetMonthlyCount() int64 {
+	if x != nil {
+		return x.MonthlyCount
+	}
+	return 0
+}
+
+type AccountLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountLimitsRequest) Reset() {
+	*x = AccountLimitsRequest{}
+	mi := &file_internal_proto_account_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountLimitsRequest) ProtoMessage() {}
+
+func (x *AccountLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_account_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountLimitsRequest.ProtoReflect.Descriptor instead.
+func (*AccountLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_account_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AccountLimitsRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+// The limits set for the account itself; the empty ones fall back to the defaults.
+type AccountLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	MaxSingle     string                 `protobuf:"bytes,2,opt,name=max_single,json=maxSingle,proto3" json:"max_single,omitempty"`
+	DailyAmount   string                 `protobuf:"bytes,3,opt,name=daily_amount,json=dailyAmount,proto3" json:"daily_amount,omitempty"`
+	DailyCount    int64                  `protobuf:"varint,4,opt,name=daily_count,json=dailyCount,proto3" json:"daily_count,omitempty"`
+	MonthlyAmount string                 `protobuf:"bytes,5,opt,name=monthly_amount,json=monthlyAmount,proto3" json:"monthly_amount,omitempty"`
+	MonthlyCount  int64                  `protobuf:"varint,6,opt,name=monthly_count,json=monthlyCount,proto3" json:"monthly_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountLimitsResponse) Reset() {
+	*x = AccountLimitsResponse{}
+	mi := &file_internal_proto_account_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountLimitsResponse) ProtoMessage() {}
+
+func (x *AccountLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_account_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountLimitsResponse.ProtoReflect.Descriptor instead.
+func (*AccountLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_account_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AccountLimitsResponse) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AccountLimitsResponse) GetMaxSingle() string {
+	if x != nil {
+		return x.MaxSingle
+	}
+	return ""
+}
+
+func (x *AccountLimitsResponse) GetDailyAmount() string {
+	if x != nil {
+		return x.DailyAmount
+	}
+	return ""
+}
+
+func (x *AccountLimitsResponse) GetDailyCount() int64 {
+	if x != nil {
+		return x.DailyCount
+	}
+	return 0
+}
+
+func (x *AccountLimitsResponse) GetMonthlyAmount() string {
+	if x != nil {
+		return x.MonthlyAmount
+	}
+	return ""
+}
+
+func (x *AccountLimitsResponse) GetMonthlyCount() int64 {
+	if x != nil {
+		return x.MonthlyCount
+	}
+	return 0
+}
+
+var File_internal_proto_account_proto protoreflect.FileDescriptor
+
+const file_internal_proto_account_proto_rawDesc = "" +
+	"\n" +
+	"\x1cinternal/proto/account.proto\x12\btransfer\"\xb9\x01\n" +
+	"\x14CreateAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\tR\abalance\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12#\n" +
+	"\raccount_class\x18\x04 \x01(\tR\faccountClass\x12'\n" +
+	"\x0foverdraft_limit\x18\x05 \x01(\tR\x0eoverdraftLimit\"1\n" +
+	"\x15CreateAccountResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"2\n" +
+	"\x11GetAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\"\x9f\x02\n" +
+	"\x12GetAccountResponse\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\tR\abalance\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12!\n" +
+	"\fheld_balance\x18\x05 \x01(\tR\vheldBalance\x12+\n" +
+	"\x11available_balance\x18\x06 \x01(\tR\x10availableBalance\x12#\n" +
+	"\raccount_class\x18\a \x01(\tR\faccountClass\x12'\n" +
+	"\x0foverdraft_limit\x18\b \x01(\tR\x0eoverdraftLimit\"^\n" +
+	"\x14UpdateAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12'\n" +
+	"\x0foverdraft_limit\x18\x02 \x01(\tR\x0eoverdraftLimit\"5\n" +
+	"\x14AccountStatusRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\"N\n" +
+	"\x15AccountStatusResponse\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"D\n" +
+	"\x13GetBalanceAtRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x0e\n" +
+	"\x02at\x18\x02 \x01(\tR\x02at\"\x9c\x01\n" +
+	"\x14GetBalanceAtResponse\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\tR\abalance\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x0e\n" +
+	"\x02at\x18\x04 \x01(\tR\x02at\x12\x1f\n" +
+	"\vtransfer_id\x18\x05 \x01(\x03R\n" +
+	"transferId\"X\n" +
+	"\x13GetStatementRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"\xd2\x01\n" +
+	"\rStatementLine\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\x03R\n" +
+	"transferId\x12\x1b\n" +
+	"\tposted_at\x18\x02 \x01(\tR\bpostedAt\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12'\n" +
+	"\x0fcounterparty_id\x18\x04 \x01(\x03R\x0ecounterpartyId\x12\x14\n" +
+	"\x05debit\x18\x05 \x01(\tR\x05debit\x12\x16\n" +
+	"\x06credit\x18\x06 \x01(\tR\x06credit\x12\x18\n" +
+	"\abalance\x18\a \x01(\tR\abalance\"\xbe\x02\n" +
+	"\x14GetStatementResponse\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\x12'\n" +
+	"\x0fopening_balance\x18\x05 \x01(\tR\x0eopeningBalance\x12'\n" +
+	"\x0fclosing_balance\x18\x06 \x01(\tR\x0eclosingBalance\x12!\n" +
+	"\ftotal_debits\x18\a \x01(\tR\vtotalDebits\x12#\n" +
+	"\rtotal_credits\x18\b \x01(\tR\ftotalCredits\x12-\n" +
+	"\x05lines\x18\t \x03(\v2\x17.transfer.StatementLineR\x05lines\"\xe7\x01\n" +
+	"\x17SetAccountLimitsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x1d\n" +
+	"\n" +
+	"max_single\x18\x02 \x01(\tR\tmaxSingle\x12!\n" +
+	"\fdaily_amount\x18\x03 \x01(\tR\vdailyAmount\x12\x1f\n" +
+	"\vdaily_count\x18\x04 \x01(\x03R\n" +
+	"dailyCount\x12%\n" +
+	"\x0emonthly_amount\x18\x05 \x01(\tR\rmonthlyAmount\x12#\n" +
+	"\rmonthly_count\x18\x06 \x01(\x03R\fmonthlyCount\"5\n" +
+	"\x14AccountLimitsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\"\xe5\x01\n" +
+	"\x15AccountLimitsResponse\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x1d\n" +
+	"\n" +
+	"max_single\x18\x02 \x01(\tR\tmaxSingle\x12!\n" +
+	"\fdaily_amount\x18\x03 \x01(\tR\vdailyAmount\x12\x1f\n" +
+	"\vdaily_count\x18\x04 \x01(\x03R\n" +
+	"dailyCount\x12%\n" +
+	"\x0emonthly_amount\x18\x05 \x01(\tR\rmonthlyAmount\x12#\n" +
+	"\rmonthly_count\x18\x06 \x01(\x03R\fmonthlyCount2\x93\a\n" +
+	"\x0eAccountService\x12P\n" +
+	"\rCreateAccount\x12\x1e.transfer.CreateAccountRequest\x1a\x1f.transfer.CreateAccountResponse\x12G\n" +
+	"\n" +
+	"GetAccount\x12\x1b.transfer.GetAccountRequest\x1a\x1c.transfer.GetAccountResponse\x12P\n" +
+	"\rFreezeAccount\x12\x1e.transfer.AccountStatusRequest\x1a\x1f.transfer.AccountStatusResponse\x12R\n" +
+	"\x0fUnfreezeAccount\x12\x1e.transfer.AccountStatusRequest\x1a\x1f.transfer.AccountStatusResponse\x12O\n" +
+	"\fCloseAccount\x12\x1e.transfer.AccountStatusRequest\x1a\x1f.transfer.AccountStatusResponse\x12M\n" +
+	"\rUpdateAccount\x12\x1e.transfer.UpdateAccountRequest\x1a\x1c.transfer.GetAccountResponse\x12M\n" +
+	"\fGetBalanceAt\x12\x1d.transfer.GetBalanceAtRequest\x1a\x1e.transfer.GetBalanceAtResponse\x12M\n" +
+	"\fGetStatement\x12\x1d.transfer.GetStatementRequest\x1a\x1e.transfer.GetStatementResponse\x12V\n" +
+	"\x10SetAccountLimits\x12!.transfer.SetAccountLimitsRequest\x1a\x1f.transfer.AccountLimitsResponse\x12S\n" +
+	"\x10GetAccountLimits\x12\x1e.transfer.AccountLimitsRequest\x1a\x1f.transfer.AccountLimitsResponse\x12U\n" +
+	"\x12ClearAccountLimits\x12\x1e.transfer.AccountLimitsRequest\x1a\x1f.transfer.AccountLimitsResponseB@Z>github.com/jhaprabhatt/account-transfer-project/internal/protob\x06proto3"
 
 var (
 	file_internal_proto_account_proto_rawDescOnce sync.Once
@@ -143,19 +1167,53 @@ func file_internal_proto_account_proto_rawDescGZIP() []byte {
 	return file_internal_proto_account_proto_rawDescData
 }
 
-var file_internal_proto_account_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_internal_proto_account_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_internal_proto_account_proto_goTypes = []any{
-	(*CreateAccountRequest)(nil),  // 0: transfer.CreateAccountRequest
-	(*CreateAccountResponse)(nil), // 1: transfer.CreateAccountResponse
+	(*CreateAccountRequest)(nil),    // 0: transfer.CreateAccountRequest
+	(*CreateAccountResponse)(nil),   // 1: transfer.CreateAccountResponse
+	(*GetAccountRequest)(nil),       // 2: transfer.GetAccountRequest
+	(*GetAccountResponse)(nil),      // 3: transfer.GetAccountResponse
+	(*UpdateAccountRequest)(nil),    // 4: transfer.UpdateAccountRequest
+	(*AccountStatusRequest)(nil),    // 5: transfer.AccountStatusRequest
+	(*AccountStatusResponse)(nil),   // 6: transfer.AccountStatusResponse
+	(*GetBalanceAtRequest)(nil),     // 7: transfer.GetBalanceAtRequest
+	(*GetBalanceAtResponse)(nil),    // 8: transfer.GetBalanceAtResponse
+	(*GetStatementRequest)(nil),     // 9: transfer.GetStatementRequest
+	(*StatementLine)(nil),           // 10: transfer.StatementLine
+	(*GetStatementResponse)(nil),    // 11: transfer.GetStatementResponse
+	(*SetAccountLimitsRequest)(nil), // 12: transfer.SetAccountLimitsRequest
+	(*AccountLimitsRequest)(nil),    // 13: transfer.AccountLimitsRequest
+	(*AccountLimitsResponse)(nil),   // 14: transfer.AccountLimitsResponse
 }
 var file_internal_proto_account_proto_depIdxs = []int32{
-	0, // 0: transfer.AccountService.CreateAccount:input_type -> transfer.CreateAccountRequest
-	1, // 1: transfer.AccountService.CreateAccount:output_type -> transfer.CreateAccountResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	10, // 0: transfer.GetStatementResponse.lines:type_name -> transfer.StatementLine
+	0,  // 1: transfer.AccountService.CreateAccount:input_type -> transfer.CreateAccountRequest
+	2,  // 2: transfer.AccountService.GetAccount:input_type -> transfer.GetAccountRequest
+	5,  // 3: transfer.AccountService.FreezeAccount:input_type -> transfer.AccountStatusRequest
+	5,  // 4: transfer.AccountService.UnfreezeAccount:input_type -> transfer.AccountStatusRequest
+	5,  // 5: transfer.AccountService.CloseAccount:input_type -> transfer.AccountStatusRequest
+	4,  // 6: transfer.AccountService.UpdateAccount:input_type -> transfer.UpdateAccountRequest
+	7,  // 7: transfer.AccountService.GetBalanceAt:input_type -> transfer.GetBalanceAtRequest
+	9,  // 8: transfer.AccountService.GetStatement:input_type -> transfer.GetStatementRequest
+	12, // 9: transfer.AccountService.SetAccountLimits:input_type -> transfer.SetAccountLimitsRequest
+	13, // 10: transfer.AccountService.GetAccountLimits:input_type -> transfer.AccountLimitsRequest
+	13, // 11: transfer.AccountService.ClearAccountLimits:input_type -> transfer.AccountLimitsRequest
+	1,  // 12: transfer.AccountService.CreateAccount:output_type -> transfer.CreateAccountResponse
+	3,  // 13: transfer.AccountService.GetAccount:output_type -> transfer.GetAccountResponse
+	6,  // 14: transfer.AccountService.FreezeAccount:output_type -> transfer.AccountStatusResponse
+	6,  // 15: transfer.AccountService.UnfreezeAccount:output_type -> transfer.AccountStatusResponse
+	6,  // 16: transfer.AccountService.CloseAccount:output_type -> transfer.AccountStatusResponse
+	3,  // 17: transfer.AccountService.UpdateAccount:output_type -> transfer.GetAccountResponse
+	8,  // 18: transfer.AccountService.GetBalanceAt:output_type -> transfer.GetBalanceAtResponse
+	11, // 19: transfer.AccountService.GetStatement:output_type -> transfer.GetStatementResponse
+	14, // 20: transfer.AccountService.SetAccountLimits:output_type -> transfer.AccountLimitsResponse
+	14, // 21: transfer.AccountService.GetAccountLimits:output_type -> transfer.AccountLimitsResponse
+	14, // 22: transfer.AccountService.ClearAccountLimits:output_type -> transfer.AccountLimitsResponse
+	12, // [12:23] is the sub-list for method output_type
+	1,  // [1:12] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_internal_proto_account_proto_init() }
@@ -169,7 +1227,7 @@ func file_internal_proto_account_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_proto_account_proto_rawDesc), len(file_internal_proto_account_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
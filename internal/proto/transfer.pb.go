@@ -21,13 +21,65 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TransferDirection int32
+
+const (
+	TransferDirection_TRANSFER_DIRECTION_ALL      TransferDirection = 0
+	TransferDirection_TRANSFER_DIRECTION_OUTGOING TransferDirection = 1
+	TransferDirection_TRANSFER_DIRECTION_INCOMING TransferDirection = 2
+)
+
+// Enum value maps for TransferDirection.
+var (
+	TransferDirection_name = map[int32]string{
+		0: "TRANSFER_DIRECTION_ALL",
+		1: "TRANSFER_DIRECTION_OUTGOING",
+		2: "TRANSFER_DIRECTION_INCOMING",
+	}
+	TransferDirection_value = map[string]int32{
+		"TRANSFER_DIRECTION_ALL":      0,
+		"TRANSFER_DIRECTION_OUTGOING": 1,
+		"TRANSFER_DIRECTION_INCOMING": 2,
+	}
+)
+
+func (x TransferDirection) Enum() *TransferDirection {
+	p := new(TransferDirection)
+	*p = x
+	return p
+}
+
+func (x TransferDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransferDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_transfer_proto_enumTypes[0].Descriptor()
+}
+
+func (TransferDirection) Type() protoreflect.EnumType {
+	return &file_internal_proto_transfer_proto_enumTypes[0]
+}
+
+func (x TransferDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransferDirection.Descriptor instead.
+func (TransferDirection) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{0}
+}
+
 type TransferRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SourceId      int64                  `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
-	DestinationId int64                  `protobuf:"varint,2,opt,name=destination_id,json=destinationId,proto3" json:"destination_id,omitempty"`
-	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SourceId       int64                  `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	DestinationId  int64                  `protobuf:"varint,2,opt,name=destination_id,json=destinationId,proto3" json:"destination_id,omitempty"`
+	Amount         string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	Currency       string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Convert        bool                   `protobuf:"varint,6,opt,name=convert,proto3" json:"convert,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *TransferRequest) Reset() {
@@ -81,14 +133,41 @@ func (x *TransferRequest) GetAmount() string {
 	return ""
 }
 
+func (x *TransferRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetConvert() bool {
+	if x != nil {
+		return x.Convert
+	}
+	return false
+}
+
 type TransferResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Success          bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	TransactionId    int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	AuditId          int64                  `protobuf:"varint,3,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`
-	NewSourceBalance string                 `protobuf:"bytes,4,opt,name=new_source_balance,json=newSourceBalance,proto3" json:"new_source_balance,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Success             bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TransactionId       int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	AuditId             int64                  `protobuf:"varint,3,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`
+	NewSourceBalance    string                 `protobuf:"bytes,4,opt,name=new_source_balance,json=newSourceBalance,proto3" json:"new_source_balance,omitempty"`
+	SourceAmount        string                 `protobuf:"bytes,5,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
+	SourceCurrency      string                 `protobuf:"bytes,6,opt,name=source_currency,json=sourceCurrency,proto3" json:"source_currency,omitempty"`
+	DestinationAmount   string                 `protobuf:"bytes,7,opt,name=destination_amount,json=destinationAmount,proto3" json:"destination_amount,omitempty"`
+	DestinationCurrency string                 `protobuf:"bytes,8,opt,name=destination_currency,json=destinationCurrency,proto3" json:"destination_currency,omitempty"`
+	FxRate              string                 `protobuf:"bytes,9,opt,name=fx_rate,json=fxRate,proto3" json:"fx_rate,omitempty"`
+	Fee                 string                 `protobuf:"bytes,10,opt,name=fee,proto3" json:"fee,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *TransferResponse) Reset() {
@@ -149,22 +228,1674 @@ func (x *TransferResponse) GetNewSourceBalance() string {
 	return ""
 }
 
-var File_internal_proto_transfer_proto protoreflect.FileDescriptor
+func (x *TransferResponse) GetSourceAmount() string {
+	if x != nil {
+		return x.SourceAmount
+	}
+	return ""
+}
 
-const file_internal_proto_transfer_proto_rawDesc = "" +
-	"\n" +
-	"\x1dinternal/proto/transfer.proto\x12\btransfer\"m\n" +
-	"\x0fTransferRequest\x12\x1b\n" +
-	"\tsource_id\x18\x01 \x01(\x03R\bsourceId\x12%\n" +
-	"\x0edestination_id\x18\x02 \x01(\x03R\rdestinationId\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\tR\x06amount\"\x9c\x01\n" +
-	"\x10TransferResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
-	"\x0etransaction_id\x18\x02 \x01(\x03R\rtransactionId\x12\x19\n" +
-	"\baudit_id\x18\x03 \x01(\x03R\aauditId\x12,\n" +
-	"\x12new_source_balance\x18\x04 \x01(\tR\x10newSourceBalance2X\n" +
+func (x *TransferResponse) GetSourceCurrency() string {
+	if x != nil {
+		return x.SourceCurrency
+	}
+	return ""
+}
+
+func (x *TransferResponse) GetDestinationAmount() string {
+	if x != nil {
+		return x.DestinationAmount
+	}
+	return ""
+}
+
+func (x *TransferResponse) GetDestinationCurrency() string {
+	if x != nil {
+		return x.DestinationCurrency
+	}
+	return ""
+}
+
+func (x *TransferResponse) GetFxRate() string {
+	if x != nil {
+		return x.FxRate
+	}
+	return ""
+}
+
+func (x *TransferResponse) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+// Timestamps are RFC 3339 strings and amounts are decimal strings.
+type Transfer struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	TransferId             int64                  `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	CorrelationId          int64                  `protobuf:"varint,2,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	Status                 string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	SourceId               int64                  `protobuf:"varint,4,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	DestinationId          int64                  `protobuf:"varint,5,opt,name=destination_id,json=destinationId,proto3" json:"destination_id,omitempty"`
+	Amount                 string                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	SourcePrevBalance      string                 `protobuf:"bytes,7,opt,name=source_prev_balance,json=sourcePrevBalance,proto3" json:"source_prev_balance,omitempty"`
+	SourcePostBalance      string                 `protobuf:"bytes,8,opt,name=source_post_balance,json=sourcePostBalance,proto3" json:"source_post_balance,omitempty"`
+	DestinationPrevBalance string                 `protobuf:"bytes,9,opt,name=destination_prev_balance,json=destinationPrevBalance,proto3" json:"destination_prev_balance,omitempty"`
+	DestinationPostBalance string                 `protobuf:"bytes,10,opt,name=destination_post_balance,json=destinationPostBalance,proto3" json:"destination_post_balance,omitempty"`
+	CreatedAt              string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Currency               string                 `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`
+	DestinationAmount      string                 `protobuf:"bytes,13,opt,name=destination_amount,json=destinationAmount,proto3" json:"destination_amount,omitempty"`
+	DestinationCurrency    string                 `protobuf:"bytes,14,opt,name=destination_currency,json=destinationCurrency,proto3" json:"destination_currency,omitempty"`
+	FxRate                 string                 `protobuf:"bytes,15,opt,name=fx_rate,json=fxRate,proto3" json:"fx_rate,omitempty"`
+	ReversalOf             int64                  `protobuf:"varint,16,opt,name=reversal_of,json=reversalOf,proto3" json:"reversal_of,omitempty"`
+	ReversedAmount         string                 `protobuf:"bytes,17,opt,name=reversed_amount,json=reversedAmount,proto3" json:"reversed_amount,omitempty"`
+	Reason                 string                 `protobuf:"bytes,18,opt,name=reason,proto3" json:"reason,omitempty"`
+	GroupId                int64                  `protobuf:"varint,19,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Fee                    string                 `protobuf:"bytes,20,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeOf                  int64                  `protobuf:"varint,21,opt,name=fee_of,json=feeOf,proto3" json:"fee_of,omitempty"`
+	Kind                   string                 `protobuf:"bytes,22,opt,name=kind,proto3" json:"kind,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Transfer) Reset() {
+	*x = Transfer{}
+	mi := &file_internal_proto_transfer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transfer) ProtoMessage() {}
+
+func (x *Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_transfer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Transfer) GetTransferId() int64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+func (x *Transfer) GetCorrelationId() int64 {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return 0
+}
+
+func (x *Transfer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Transfer) GetSourceId() int64 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *Transfer) GetDestinationId() int64 {
+	if x != nil {
+		return x.DestinationId
+	}
+	return 0
+}
+
+func (x *Transfer) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Transfer) GetSourcePrevBalance() string {
+	if x != nil {
+		return x.SourcePrevBalance
+	}
+	return ""
+}
+
+func (x *Transfer) GetSourcePostBalance() string {
+	if x != nil {
+		return x.SourcePostBalance
+	}
+	return ""
+}
+
+func (x *Transfer) GetDestinationPrevBalance() string {
+	if x != nil {
+		return x.DestinationPrevBalance
+	}
+	return ""
+}
+
+func (x *Transfer) GetDestinationPostBalance() string {
+	if x != nil {
+		return x.DestinationPostBalance
+	}
+	return ""
+}
+
+func (x *Transfer) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Transfer) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Transfer) GetDestinationAmount() string {
+	if x != nil {
+		return x.DestinationAmount
+	}
+	return ""
+}
+
+func (x *Transfer) GetDestinationCurrency() string {
+	if x != nil {
+		return x.DestinationCurrency
+	}
+	return ""
+}
+
+func (x *Transfer) GetFxRate() string {
+	if x != nil {
+		return x.FxRate
+	}
+	return ""
+}
+
+func (x *Transfer) GetReversalOf() int64 {
+	if x != nil {
+		return x.ReversalOf
+	}
+	return 0
+}
+
+func (x *Transfer) GetReversedAmount() string {
+	if x != nil {
+		return x.ReversedAmount
+	}
+	return ""
+}
+
+func (x *Transfer) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Transfer) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *Transfer) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *Transfer) GetFeeOf() int64 {
+	if x != nil {
+		return x.FeeOf
+	}
+	return 0
+}
+
+func (x *Transfer) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type GetTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    int64                  `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransferRequest) Reset() {
+	*x = GetTransferRequest{}
+	mi := &file_internal_proto_transfer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferRequest) ProtoMessage() {}
+
+func (x *GetTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_transfer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferRequest.ProtoReflect.Descriptor instead.
+func (*GetTransferRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTransferRequest) GetTransferId() int64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+type GetTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *Transfer              `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransferResponse) Reset() {
+	*x = GetTransferResponse{}
+	mi := &file_internal_proto_transfer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferResponse) ProtoMessage() {}
+
+func (x *GetTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_transfer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferResponse.ProtoReflect.Descriptor instead.
+func (*GetTransferResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetTransferResponse) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+// since_transfer_id 0 streams only transfers posted from now on; otherwise
+// transfers posted to the account after that one are replayed first.
+type WatchTransfersRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccountId       int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	SinceTransferId int64                  `protobuf:"varint,2,opt,name=since_transfer_id,json=sinceTransferId,proto3" json:"since_transfer_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchTransfersRequest) Reset() {
+	*x = WatchTransfersRequest{}
+	mi := &file_internal_proto_transfer_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTransfersRequest) ProtoMessage() {}
+
+func (x *WatchTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_transfer_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTransfersRequest.ProtoReflect.Descriptor instead.
+func (*WatchTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WatchTransfersRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *WatchTransfersRequest) GetSinceTransferId() int64 {
+	if x != nil {
+		return x.SinceTransferId
+	}
+	return 0
+}
+
+type ListTransfersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Direction     TransferDirection      `protobuf:"varint,2,opt,name=direction,proto3,enum=transfer.TransferDirection" json:"direction,omitempty"`
+	From          string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Cursor        int64                  `protobuf:"varint,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransfersRequest) Reset() {
+	*x = ListTransfersRequest{}
+	mi := &file_internal_proto_transfer_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersRequest) ProtoMessage() {}
+
+func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_transfer_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListTransfersRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ListTransfersRequest) GetDirection() TransferDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return TransferDirection_TRANSFER_DIRECTION_ALL
+}
+
+func (x *ListTransfersRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListTransfersRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ListTransfersRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ListTransfersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfers     []*Transfer            `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	NextCursor    int64                  `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransfersResponse) Reset() {
+	*x = ListTransfersResponse{}
+	mi := &file_internal_proto_transfer_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersResponse) ProtoMessage() {}
+
+func (x *ListTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_transfer_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListTransfersResponse) GetTransfers() []*Transfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+func (x *ListTransfersResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+// expires_at is RFC 3339; empty means the default hold lifetime.
+type AuthorizeTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      int64                  `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	DestinationId int64                  `protobuf:"varint,2,opt,name=destination_id,json=destinationId,proto3" json:"destination_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizeTransferRequest) Reset() {
+	*x = AuthorizeTransferRequest{}
+	mi := &file_internal_proto_transfer_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizeTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeTransferRequest) ProtoMessage() {}
+
+func (x *AuthorizeTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_transfer_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeTransferRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizeTransferRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AuthorizeTransferRequest) GetSourceId() int64 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *AuthorizeTransferRequest) GetDestinationId() int64 {
+	if x != nil {
+		return x.DestinationId
+	}
+	return 0
+}
+
+func (x *AuthorizeTransferRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AuthorizeTransferRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AuthorizeTransferRequest) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+// Empty amount captures the full hold; a smaller amount releases the rest.
+type CaptureTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        int64                  `protobuf:"varint,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptureTransferRequest) Reset() {
+	*x = CaptureTransferRequest{}
+	mi := &file_internal_proto_transfer_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureTransferRequest) ProtoMessage() {}
+
+func (x *CaptureTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_transfer_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureTransferRequest.ProtoReflect.Descriptor instead.
+func (*CaptureTransferRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CaptureTransferRequest) GetHoldId() int64 {
+	if x != nil {
+		return x.HoldId
+	}
+	return 0
+}
+
+func (x *CaptureTransferRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type VoidTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        int64                  `protobuf:"varint,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidTransferRequest) Reset() {
+	*x = VoidTransferRequest{}
+	mi := &file_internal_proto_transfer_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidTransferRequest) ProtoMessage() {}
+
+func (x *VoidTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_transfer_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidTransferRequest.ProtoReflect.Descriptor instead.
+func (*VoidTransferRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *VoidTransferRequest) GetHoldId() int64 {
+	if x != nil {
+		return x.HoldId
+	}
+	return 0
+}
+
+type Hold struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	HoldId         int64                  `protobuf:"varint,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	CorrelationId  int64                  `protobuf:"varint,2,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	SourceId       int64                  `protobuf:"varint,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	DestinationId  int64                  `protobuf:"varint,4,opt,name=destination_id,json=destinationId,proto3" json:"destination_id,omitempty"`
+	Amount         string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	CapturedAmount string                 `protobuf:"bytes,6,opt,name=captured_amount,json=capturedAmount,proto3" json:"captured_amount,omitempty"`
+	Currency       string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status         string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	ExpiresAt      string                 `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Hold) Reset() {
+	*x = Hold{}
+	mi := &file_internal_proto_transfer_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hold) ProtoMessage() {}
+
+func (x *Hold) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_transfer_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hold.ProtoReflect.Descriptor instead.
+func (*Hold) Descriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Hold) GetHoldId() int64 {
+	if x != nil {
+		return x.HoldId
+	}
+	return 0
+}
+
+func (x *Hold) GetCorrelationId() int64 {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return 0
+}
+
+func (x *Hold) GetSourceId() int64 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *Hold) GetDestinationId() int64 {
+	if x != nil {
+		return x.DestinationId
+	}
+	return 0
+}
+
+func (x *Hold) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Hold) GetCapturedAmount() string {
+	if x != nil {
+		return x.CapturedAmount
+	}
+	return ""
+}
+
+func (x *Hold) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Hold) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Hold) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *Hold) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type HoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *Hold                  `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoldResponse) Reset() {
+	*x = HoldResponse{}
+	mi := &file_internal_proto_transfer_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldResponse) ProtoMessage() {}
+
+func (x *HoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_transfer_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldResponse.ProtoReflect.Descriptor instead.
+func (*HoldResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *HoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+// amount is in the original source currency; empty reverses the remainder.
+type ReverseTransferRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TransferId     int64                  `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	Amount         string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason         string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReverseTransferRequest) Reset() {
+	*x = ReverseTransferRequest{}
+	mi := &file_internal_proto_transfer_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseTransferRequest) ProtoMessage() {}
+
+func (x *ReverseTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_transfer_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseTransferRequest.ProtoReflect.Descriptor instead.
+func (*ReverseTransferRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReverseTransferRequest) GetTransferId() int64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+func (x *ReverseTransferRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ReverseTransferRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReverseTransferRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ReverseTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reversal      *Transfer              `protobuf:"bytes,1,opt,name=reversal,proto3" json:"reversal,omitempty"`
+	Original      *Transfer              `protobuf:"bytes,2,opt,name=original,proto3" json:"original,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverseTransferResponse) Reset() {
+	*x = ReverseTransferResponse{}
+	mi := &file_internal_proto_transfer_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseTransferResponse) ProtoMessage() {}
+
+func (x *ReverseTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_transfer_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseTransferResponse.ProtoReflect.Descriptor instead.
+func (*ReverseTransferResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReverseTransferResponse) GetReversal() *Transfer {
+	if x != nil {
+		return x.Reversal
+	}
+	return nil
+}
+
+func (x *ReverseTransferResponse) GetOriginal() *Transfer {
+	if x != nil {
+		return x.Original
+	}
+	return nil
+}
+
+// Legs must not carry an idempotency_key. Without best_effort any failing leg
+// fails the whole batch; a malformed amount always does.
+type BatchTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Legs          []*TransferRequest     `protobuf:"bytes,1,rep,name=legs,proto3" json:"legs,omitempty"`
+	BestEffort    bool                   `protobuf:"varint,2,opt,name=best_effort,json=bestEffort,proto3" json:"best_effort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchTransferRequest) Reset() {
+	*x = BatchTransferRequest{}
+	mi := &file_internal_proto_transfer_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTransferRequest) ProtoMessage() {}
+
+func (x *BatchTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_transfer_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTransferRequest.ProtoReflect.Descriptor instead.
+func (*BatchTransferRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchTransferRequest) GetLegs() []*TransferRequest {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *BatchTransferRequest) GetBestEffort() bool {
+	if x != nil {
+		return x.BestEffort
+	}
+	return false
+}
+
+// Either transfer or error is set. error_code is the gRPC code name and
+// error_reason the ErrorInfo reason, if any, the leg failed with.
+type BatchLegResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Transfer      *TransferResponse      `protobuf:"bytes,2,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode     string                 `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorReason   string                 `protobuf:"bytes,5,opt,name=error_reason,json=errorReason,proto3" json:"error_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchLegResult) Reset() {
+	*x = BatchLegResult{}
+	mi := &file_internal_proto_transfer_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchLegResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchLegResult) ProtoMessage() {}
+
+func (x *BatchLegResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_transfer_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchLegResult.ProtoReflect.Descriptor instead.
+func (*BatchLegResult) Descriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BatchLegResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchLegResult) GetTransfer() *TransferResponse {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *BatchLegResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BatchLegResult) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *BatchLegResult) GetErrorReason() string {
+	if x != nil {
+		return x.ErrorReason
+	}
+	return ""
+}
+
+type BatchTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchLegResult      `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchTransferResponse) Reset() {
+	*x = BatchTransferResponse{}
+	mi := &file_internal_proto_transfer_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTransferResponse) ProtoMessage() {}
+
+func (x *BatchTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_transfer_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTransferResponse.ProtoReflect.Descriptor instead.
+func (*BatchTransferResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BatchTransferResponse) GetResults() []*BatchLegResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchTransferResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BatchTransferResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type TransferLeg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferLeg) Reset() {
+	*x = TransferLeg{}
+	mi := &file_internal_proto_transfer_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferLeg) ProtoMessage() {}
+
+func (x *TransferLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_transfer_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferLeg.ProtoReflect.Descriptor instead.
+func (*TransferLeg) Descriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TransferLeg) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *TransferLeg) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// Debits and credits must total the same amount in one currency.
+type MultiLegTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Debits        []*TransferLeg         `protobuf:"bytes,1,rep,name=debits,proto3" json:"debits,omitempty"`
+	Credits       []*TransferLeg         `protobuf:"bytes,2,rep,name=credits,proto3" json:"credits,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiLegTransferRequest) Reset() {
+	*x = MultiLegTransferRequest{}
+	mi := &file_internal_proto_transfer_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiLegTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiLegTransferRequest) ProtoMessage() {}
+
+func (x *MultiLegTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_transfer_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiLegTransferRequest.ProtoReflect.Descriptor instead.
+func (*MultiLegTransferRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MultiLegTransferRequest) GetDebits() []*TransferLeg {
+	if x != nil {
+		return x.Debits
+	}
+	return nil
+}
+
+func (x *MultiLegTransferRequest) GetCredits() []*TransferLeg {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+func (x *MultiLegTransferRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// transfers are the pairwise rows posted under group_id, in posting order.
+type MultiLegTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Transfers     []*TransferResponse    `protobuf:"bytes,2,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiLegTransferResponse) Reset() {
+	*x = MultiLegTransferResponse{}
+	mi := &file_internal_proto_transfer_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiLegTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiLegTransferResponse) ProtoMessage() {}
+
+func (x *MultiLegTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_transfer_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiLegTransferResponse.ProtoReflect.Descriptor instead.
+func (*MultiLegTransferResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MultiLegTransferResponse) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *MultiLegTransferResponse) GetTransfers() []*TransferResponse {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+// A deposit or withdrawal, settled against the settlement account of the
+// account's currency.
+type CashRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AccountId      int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount         string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency       string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CashRequest) Reset() {
+	*x = CashRequest{}
+	mi := &file_internal_proto_transfer_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashRequest) ProtoMessage() {}
+
+func (x *CashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_transfer_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashRequest.ProtoReflect.Descriptor instead.
+func (*CashRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CashRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *CashRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CashRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CashRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// new_balance is the balance of account_id after the deposit or withdrawal.
+type CashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuditId       int64                  `protobuf:"varint,1,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`
+	AccountId     int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Amount        string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	NewBalance    string                 `protobuf:"bytes,6,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CashResponse) Reset() {
+	*x = CashResponse{}
+	mi := &file_internal_proto_transfer_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashResponse) ProtoMessage() {}
+
+func (x *CashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_transfer_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashResponse.ProtoReflect.Descriptor instead.
+func (*CashResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_transfer_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CashResponse) GetAuditId() int64 {
+	if x != nil {
+		return x.AuditId
+	}
+	return 0
+}
+
+func (x *CashResponse) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *CashResponse) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CashResponse) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CashResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CashResponse) GetNewBalance() string {
+	if x != nil {
+		return x.NewBalance
+	}
+	return ""
+}
+
+var File_internal_proto_transfer_proto protoreflect.FileDescriptor
+
+const file_internal_proto_transfer_proto_rawDesc = "" +
+	"\n" +
+	"\x1dinternal/proto/transfer.proto\x12\btransfer\"\xcc\x01\n" +
+	"\x0fTransferRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\x03R\bsourceId\x12%\n" +
+	"\x0edestination_id\x18\x02 \x01(\x03R\rdestinationId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x18\n" +
+	"\aconvert\x18\x06 \x01(\bR\aconvert\"\xf7\x02\n" +
+	"\x10TransferResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03R\rtransactionId\x12\x19\n" +
+	"\baudit_id\x18\x03 \x01(\x03R\aauditId\x12,\n" +
+	"\x12new_source_balance\x18\x04 \x01(\tR\x10newSourceBalance\x12#\n" +
+	"\rsource_amount\x18\x05 \x01(\tR\fsourceAmount\x12'\n" +
+	"\x0fsource_currency\x18\x06 \x01(\tR\x0esourceCurrency\x12-\n" +
+	"\x12destination_amount\x18\a \x01(\tR\x11destinationAmount\x121\n" +
+	"\x14destination_currency\x18\b \x01(\tR\x13destinationCurrency\x12\x17\n" +
+	"\afx_rate\x18\t \x01(\tR\x06fxRate\x12\x10\n" +
+	"\x03fee\x18\n" +
+	" \x01(\tR\x03fee\"\x8a\x06\n" +
+	"\bTransfer\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\x03R\n" +
+	"transferId\x12%\n" +
+	"\x0ecorrelation_id\x18\x02 \x01(\x03R\rcorrelationId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1b\n" +
+	"\tsource_id\x18\x04 \x01(\x03R\bsourceId\x12%\n" +
+	"\x0edestination_id\x18\x05 \x01(\x03R\rdestinationId\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\tR\x06amount\x12.\n" +
+	"\x13source_prev_balance\x18\a \x01(\tR\x11sourcePrevBalance\x12.\n" +
+	"\x13source_post_balance\x18\b \x01(\tR\x11sourcePostBalance\x128\n" +
+	"\x18destination_prev_balance\x18\t \x01(\tR\x16destinationPrevBalance\x128\n" +
+	"\x18destination_post_balance\x18\n" +
+	" \x01(\tR\x16destinationPostBalance\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1a\n" +
+	"\bcurrency\x18\f \x01(\tR\bcurrency\x12-\n" +
+	"\x12destination_amount\x18\r \x01(\tR\x11destinationAmount\x121\n" +
+	"\x14destination_currency\x18\x0e \x01(\tR\x13destinationCurrency\x12\x17\n" +
+	"\afx_rate\x18\x0f \x01(\tR\x06fxRate\x12\x1f\n" +
+	"\vreversal_of\x18\x10 \x01(\x03R\n" +
+	"reversalOf\x12'\n" +
+	"\x0freversed_amount\x18\x11 \x01(\tR\x0ereversedAmount\x12\x16\n" +
+	"\x06reason\x18\x12 \x01(\tR\x06reason\x12\x19\n" +
+	"\bgroup_id\x18\x13 \x01(\x03R\agroupId\x12\x10\n" +
+	"\x03fee\x18\x14 \x01(\tR\x03fee\x12\x15\n" +
+	"\x06fee_of\x18\x15 \x01(\x03R\x05feeOf\x12\x12\n" +
+	"\x04kind\x18\x16 \x01(\tR\x04kind\"5\n" +
+	"\x12GetTransferRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\x03R\n" +
+	"transferId\"E\n" +
+	"\x13GetTransferResponse\x12.\n" +
+	"\btransfer\x18\x01 \x01(\v2\x12.transfer.TransferR\btransfer\"b\n" +
+	"\x15WatchTransfersRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12*\n" +
+	"\x11since_transfer_id\x18\x02 \x01(\x03R\x0fsinceTransferId\"\xc2\x01\n" +
+	"\x14ListTransfersRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x129\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x1b.transfer.TransferDirectionR\tdirection\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\x03R\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"j\n" +
+	"\x15ListTransfersResponse\x120\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x12.transfer.TransferR\ttransfers\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\x03R\n" +
+	"nextCursor\"\xb1\x01\n" +
+	"\x18AuthorizeTransferRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\x03R\bsourceId\x12%\n" +
+	"\x0edestination_id\x18\x02 \x01(\x03R\rdestinationId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\"I\n" +
+	"\x16CaptureTransferRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\x03R\x06holdId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\".\n" +
+	"\x13VoidTransferRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\x03R\x06holdId\"\xbd\x02\n" +
+	"\x04Hold\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\x03R\x06holdId\x12%\n" +
+	"\x0ecorrelation_id\x18\x02 \x01(\x03R\rcorrelationId\x12\x1b\n" +
+	"\tsource_id\x18\x03 \x01(\x03R\bsourceId\x12%\n" +
+	"\x0edestination_id\x18\x04 \x01(\x03R\rdestinationId\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12'\n" +
+	"\x0fcaptured_amount\x18\x06 \x01(\tR\x0ecapturedAmount\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\tR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\"2\n" +
+	"\fHoldResponse\x12\"\n" +
+	"\x04hold\x18\x01 \x01(\v2\x0e.transfer.HoldR\x04hold\"\x92\x01\n" +
+	"\x16ReverseTransferRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\x03R\n" +
+	"transferId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\"y\n" +
+	"\x17ReverseTransferResponse\x12.\n" +
+	"\breversal\x18\x01 \x01(\v2\x12.transfer.TransferR\breversal\x12.\n" +
+	"\boriginal\x18\x02 \x01(\v2\x12.transfer.TransferR\boriginal\"f\n" +
+	"\x14BatchTransferRequest\x12-\n" +
+	"\x04legs\x18\x01 \x03(\v2\x19.transfer.TransferRequestR\x04legs\x12\x1f\n" +
+	"\vbest_effort\x18\x02 \x01(\bR\n" +
+	"bestEffort\"\xb6\x01\n" +
+	"\x0eBatchLegResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x126\n" +
+	"\btransfer\x18\x02 \x01(\v2\x1a.transfer.TransferResponseR\btransfer\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x04 \x01(\tR\terrorCode\x12!\n" +
+	"\ferror_reason\x18\x05 \x01(\tR\verrorReason\"\x81\x01\n" +
+	"\x15BatchTransferResponse\x122\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.transfer.BatchLegResultR\aresults\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"D\n" +
+	"\vTransferLeg\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\"\x95\x01\n" +
+	"\x17MultiLegTransferRequest\x12-\n" +
+	"\x06debits\x18\x01 \x03(\v2\x15.transfer.TransferLegR\x06debits\x12/\n" +
+	"\acredits\x18\x02 \x03(\v2\x15.transfer.TransferLegR\acredits\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"o\n" +
+	"\x18MultiLegTransferResponse\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x128\n" +
+	"\ttransfers\x18\x02 \x03(\v2\x1a.transfer.TransferResponseR\ttransfers\"\x89\x01\n" +
+	"\vCashRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\"\xb1\x01\n" +
+	"\fCashResponse\x12\x19\n" +
+	"\baudit_id\x18\x01 \x01(\x03R\aauditId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vnew_balance\x18\x06 \x01(\tR\n" +
+	"newBalance*q\n" +
+	"\x11TransferDirection\x12\x1a\n" +
+	"\x16TRANSFER_DIRECTION_ALL\x10\x00\x12\x1f\n" +
+	"\x1bTRANSFER_DIRECTION_OUTGOING\x10\x01\x12\x1f\n" +
+	"\x1bTRANSFER_DIRECTION_INCOMING\x10\x022\xaa\a\n" +
 	"\x0fTransferService\x12E\n" +
-	"\fMakeTransfer\x12\x19.transfer.TransferRequest\x1a\x1a.transfer.TransferResponseB@Z>github.com/jhaprabhatt/account-transfer-project/internal/protob\x06proto3"
+	"\fMakeTransfer\x12\x19.transfer.TransferRequest\x1a\x1a.transfer.TransferResponse\x12J\n" +
+	"\vGetTransfer\x12\x1c.transfer.GetTransferRequest\x1a\x1d.transfer.GetTransferResponse\x12P\n" +
+	"\rListTransfers\x12\x1e.transfer.ListTransfersRequest\x1a\x1f.transfer.ListTransfersResponse\x12O\n" +
+	"\x11AuthorizeTransfer\x12\".transfer.AuthorizeTransferRequest\x1a\x16.transfer.HoldResponse\x12O\n" +
+	"\x0fCaptureTransfer\x12 .transfer.CaptureTransferRequest\x1a\x1a.transfer.TransferResponse\x12E\n" +
+	"\fVoidTransfer\x12\x1d.transfer.VoidTransferRequest\x1a\x16.transfer.HoldResponse\x12V\n" +
+	"\x0fReverseTransfer\x12 .transfer.ReverseTransferRequest\x1a!.transfer.ReverseTransferResponse\x12T\n" +
+	"\x11MakeBatchTransfer\x12\x1e.transfer.BatchTransferRequest\x1a\x1f.transfer.BatchTransferResponse\x12]\n" +
+	"\x14MakeMultiLegTransfer\x12!.transfer.MultiLegTransferRequest\x1a\".transfer.MultiLegTransferResponse\x128\n" +
+	"\aDeposit\x12\x15.transfer.CashRequest\x1a\x16.transfer.CashResponse\x129\n" +
+	"\bWithdraw\x12\x15.transfer.CashRequest\x1a\x16.transfer.CashResponse\x12G\n" +
+	"\x0eWatchTransfers\x12\x1f.transfer.WatchTransfersRequest\x1a\x12.transfer.Transfer0\x01B@Z>github.com/jhaprabhatt/account-transfer-project/internal/protob\x06proto3"
 
 var (
 	file_internal_proto_transfer_proto_rawDescOnce sync.Once
@@ -178,19 +1909,76 @@ func file_internal_proto_transfer_proto_rawDescGZIP() []byte {
 	return file_internal_proto_transfer_proto_rawDescData
 }
 
-var file_internal_proto_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_internal_proto_transfer_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_internal_proto_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_internal_proto_transfer_proto_goTypes = []any{
-	(*TransferRequest)(nil),  // 0: transfer.TransferRequest
-	(*TransferResponse)(nil), // 1: transfer.TransferResponse
+	(TransferDirection)(0),           // 0: transfer.TransferDirection
+	(*TransferRequest)(nil),          // 1: transfer.TransferRequest
+	(*TransferResponse)(nil),         // 2: transfer.TransferResponse
+	(*Transfer)(nil),                 // 3: transfer.Transfer
+	(*GetTransferRequest)(nil),       // 4: transfer.GetTransferRequest
+	(*GetTransferResponse)(nil),      // 5: transfer.GetTransferResponse
+	(*WatchTransfersRequest)(nil),    // 6: transfer.WatchTransfersRequest
+	(*ListTransfersRequest)(nil),     // 7: transfer.ListTransfersRequest
+	(*ListTransfersResponse)(nil),    // 8: transfer.ListTransfersResponse
+	(*AuthorizeTransferRequest)(nil), // 9: transfer.AuthorizeTransferRequest
+	(*CaptureTransferRequest)(nil),   // 10: transfer.CaptureTransferRequest
+	(*VoidTransferRequest)(nil),      // 11: transfer.VoidTransferRequest
+	(*Hold)(nil),                     // 12: transfer.Hold
+	(*HoldResponse)(nil),             // 13: transfer.HoldResponse
+	(*ReverseTransferRequest)(nil),   // 14: transfer.ReverseTransferRequest
+	(*ReverseTransferResponse)(nil),  // 15: transfer.ReverseTransferResponse
+	(*BatchTransferRequest)(nil),     // 16: transfer.BatchTransferRequest
+	(*BatchLegResult)(nil),           // 17: transfer.BatchLegResult
+	(*BatchTransferResponse)(nil),    // 18: transfer.BatchTransferResponse
+	(*TransferLeg)(nil),              // 19: transfer.TransferLeg
+	(*MultiLegTransferRequest)(nil),  // 20: transfer.MultiLegTransferRequest
+	(*MultiLegTransferResponse)(nil), // 21: transfer.MultiLegTransferResponse
+	(*CashRequest)(nil),              // 22: transfer.CashRequest
+	(*CashResponse)(nil),             // 23: transfer.CashResponse
 }
 var file_internal_proto_transfer_proto_depIdxs = []int32{
-	0, // 0: transfer.TransferService.MakeTransfer:input_type -> transfer.TransferRequest
-	1, // 1: transfer.TransferService.MakeTransfer:output_type -> transfer.TransferResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	3,  // 0: transfer.GetTransferResponse.transfer:type_name -> transfer.Transfer
+	0,  // 1: transfer.ListTransfersRequest.direction:type_name -> transfer.TransferDirection
+	3,  // 2: transfer.ListTransfersResponse.transfers:type_name -> transfer.Transfer
+	12, // 3: transfer.HoldResponse.hold:type_name -> transfer.Hold
+	3,  // 4: transfer.ReverseTransferResponse.reversal:type_name -> transfer.Transfer
+	3,  // 5: transfer.ReverseTransferResponse.original:type_name -> transfer.Transfer
+	1,  // 6: transfer.BatchTransferRequest.legs:type_name -> transfer.TransferRequest
+	2,  // 7: transfer.BatchLegResult.transfer:type_name -> transfer.TransferResponse
+	17, // 8: transfer.BatchTransferResponse.results:type_name -> transfer.BatchLegResult
+	19, // 9: transfer.MultiLegTransferRequest.debits:type_name -> transfer.TransferLeg
+	19, // 10: transfer.MultiLegTransferRequest.credits:type_name -> transfer.TransferLeg
+	2,  // 11: transfer.MultiLegTransferResponse.transfers:type_name -> transfer.TransferResponse
+	1,  // 12: transfer.TransferService.MakeTransfer:input_type -> transfer.TransferRequest
+	4,  // 13: transfer.TransferService.GetTransfer:input_type -> transfer.GetTransferRequest
+	7,  // 14: transfer.TransferService.ListTransfers:input_type -> transfer.ListTransfersRequest
+	9,  // 15: transfer.TransferService.AuthorizeTransfer:input_type -> transfer.AuthorizeTransferRequest
+	10, // 16: transfer.TransferService.CaptureTransfer:input_type -> transfer.CaptureTransferRequest
+	11, // 17: transfer.TransferService.VoidTransfer:input_type -> transfer.VoidTransferRequest
+	14, // 18: transfer.TransferService.ReverseTransfer:input_type -> transfer.ReverseTransferRequest
+	16, // 19: transfer.TransferService.MakeBatchTransfer:input_type -> transfer.BatchTransferRequest
+	20, // 20: transfer.TransferService.MakeMultiLegTransfer:input_type -> transfer.MultiLegTransferRequest
+	22, // 21: transfer.TransferService.Deposit:input_type -> transfer.CashRequest
+	22, // 22: transfer.TransferService.Withdraw:input_type -> transfer.CashRequest
+	6,  // 23: transfer.TransferService.WatchTransfers:input_type -> transfer.WatchTransfersRequest
+	2,  // 24: transfer.TransferService.MakeTransfer:output_type -> transfer.TransferResponse
+	5,  // 25: transfer.TransferService.GetTransfer:output_type -> transfer.GetTransferResponse
+	8,  // 26: transfer.TransferService.ListTransfers:output_type -> transfer.ListTransfersResponse
+	13, // 27: transfer.TransferService.AuthorizeTransfer:output_type -> transfer.HoldResponse
+	2,  // 28: transfer.TransferService.CaptureTransfer:output_type -> transfer.TransferResponse
+	13, // 29: transfer.TransferService.VoidTransfer:output_type -> transfer.HoldResponse
+	15, // 30: transfer.TransferService.ReverseTransfer:output_type -> transfer.ReverseTransferResponse
+	18, // 31: transfer.TransferService.MakeBatchTransfer:output_type -> transfer.BatchTransferResponse
+	21, // 32: transfer.TransferService.MakeMultiLegTransfer:output_type -> transfer.MultiLegTransferResponse
+	23, // 33: transfer.TransferService.Deposit:output_type -> transfer.CashResponse
+	23, // 34: transfer.TransferService.Withdraw:output_type -> transfer.CashResponse
+	3,  // 35: transfer.TransferService.WatchTransfers:output_type -> transfer.Transfer
+	24, // [24:36] is the sub-list for method output_type
+	12, // [12:24] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_internal_proto_transfer_proto_init() }
@@ -203,13 +1991,14 @@ func file_internal_proto_transfer_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_proto_transfer_proto_rawDesc), len(file_internal_proto_transfer_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_internal_proto_transfer_proto_goTypes,
 		DependencyIndexes: file_internal_proto_transfer_proto_depIdxs,
+		EnumInfos:         file_internal_proto_transfer_proto_enumTypes,
 		MessageInfos:      file_internal_proto_transfer_proto_msgTypes,
 	}.Build()
 	File_internal_proto_transfer_proto = out.File
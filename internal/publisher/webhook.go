@@ -0,0 +1,136 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+// Headers sent with every webhook. The event ID is the same on every attempt,
+// so receivers dedupe on it.
+const (
+	WebhookEventIDHeader   = "X-Webhook-Event-Id"
+	WebhookEventTypeHeader = "X-Webhook-Event-Type"
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	WebhookSignatureHeader = "X-Webhook-Signature"
+)
+
+// SignWebhook returns the signature header value for body sent at timestamp
+// (Unix seconds): "sha256=" and the hex HMAC-SHA256, keyed with the
+// subscription secret, of the timestamp, a '.' and the body. Receivers
+// recompute it and should reject stale timestamps to stop replays.
+func SignWebhook(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookClient posts deliveries as signed JSON. Any 2xx response counts as
+// delivered; anything else, including a timeout, is an error to retry.
+//
+// Unless allowPrivate is set, the client refuses to connect to addresses that
+// models.IsPublicWebhookAddr rejects. The check runs on the resolved IP at dial
+// time, so it also covers redirects and hosts re-pointed after subscribing,
+// and the client ignores proxy settings so the check sees the real receiver.
+type WebhookClient struct {
+	client       *http.Client
+	allowPrivate bool
+}
+
+func NewWebhookClient(timeout time.Duration, allowPrivate bool) *WebhookClient {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !allowPrivate {
+		dialer := &net.Dialer{Timeout: timeout, Control: checkDialAddr}
+		transport.DialContext = dialer.DialContext
+		transport.Proxy = nil
+	}
+	return &WebhookClient{
+		client:       &http.Client{Timeout: timeout, Transport: transport},
+		allowPrivate: allowPrivate,
+	}
+}
+
+// checkDialAddr refuses a connection to a non-public address, after DNS
+// resolution and just before the socket connects.
+func checkDialAddr(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil || !models.IsPublicWebhookAddr(addr) {
+		return fmt.Errorf("%w: %s", constants.ErrWebhookURLNotAllowed, host)
+	}
+	return nil
+}
+
+// CheckURL resolves rawURL's host and reports whether every address it
+// resolves to may receive webhooks. Subscriptions are checked when created;
+// Send checks again on every connection.
+func (c *WebhookClient) CheckURL(ctx context.Context, rawURL string) error {
+	if c.allowPrivate {
+		return nil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return constants.ErrInvalidWebhookURL
+	}
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", u.Hostname())
+	if err != nil {
+		return fmt.Errorf("%w: cannot resolve %s", constants.ErrInvalidWebhookURL, u.Hostname())
+	}
+	for _, addr := range addrs {
+		if !models.IsPublicWebhookAddr(addr) {
+			return constants.ErrWebhookURLNotAllowed
+		}
+	}
+	return nil
+}
+
+func (c *WebhookClient) Send(ctx context.Context, d *models.WebhookDelivery) error {
+	body, err := json.Marshal(&d.Event)
+	if err != nil {
+		return fmt.Errorf("marshal event %d: %w", d.Event.ID, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventIDHeader, strconv.FormatInt(d.Event.ID, 10))
+	req.Header.Set(WebhookEventTypeHeader, string(d.Event.Type))
+	req.Header.Set(WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(WebhookSignatureHeader, SignWebhook(d.Secret, timestamp, body))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// Drain a little of the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+// WriterPublisher publishes each event as one JSON line. It is meant for local
+// use: on stdout, or in a file a consumer tails.
+type WriterPublisher struct {
+	mu   sync.Mutex
+	w    io.Writer
+	file *os.File
+}
+
+func NewStdoutPublisher() *WriterPublisher {
+	return &WriterPublisher{w: os.Stdout}
+}
+
+// NewFilePublisher appends events to path, creating it if needed. Every event
+// is synced to disk before Publish returns.
+func NewFilePublisher(path string) (*WriterPublisher, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open event file: %w", err)
+	}
+	return &WriterPublisher{w: f, file: f}, nil
+}
+
+func (p *WriterPublisher) Publish(_ context.Context, event *models.Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal event %d: %w", event.ID, err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.w.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("write event %d: %w", event.ID, err)
+	}
+	if p.file != nil {
+		if err := p.file.Sync(); err != nil {
+			return fmt.Errorf("sync event %d: %w", event.ID, err)
+		}
+	}
+	return nil
+}
+
+// Close closes the event file, if any.
+func (p *WriterPublisher) Close() error {
+	if p.file == nil {
+		return nil
+	}
+	return p.file.Close()
+}
@@ -6,7 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"time"
 
+	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
 
 	_ "github.com/jackc/pgx/v5/stdlib"
@@ -26,13 +28,22 @@ func NewAccountRepository(db *sql.DB, log *zap.Logger) *AccountRepository {
 	}
 }
 
-func (r *AccountRepository) GetAccount(ctx context.Context, id int64) (*models.Account, error) {
-	query := `SELECT account_id, balance FROM accounts WHERE account_id = $1`
-
-	row := r.db.QueryRowContext(ctx, query, id)
+// accountColumns are the columns scanAccount reads, in order.
+const accountColumns = `account_id, balance, held_balance, currency, status, account_class, overdraft_limit, version`
 
+func scanAccount(row rowScanner) (*models.Account, error) {
 	var acc models.Account
-	err := row.Scan(&acc.ID, &acc.Balance)
+	err := row.Scan(&acc.ID, &acc.Balance, &acc.HeldBalance, &acc.Currency, &acc.Status, &acc.Class, &acc.OverdraftLimit, &acc.Version)
+	if err != nil {
+		return nil, err
+	}
+	return &acc, nil
+}
+
+func (r *AccountRepository) GetAccount(ctx context.Context, id int64) (*models.Account, error) {
+	query := `SELECT ` + accountColumns + ` FROM accounts WHERE account_id = $1`
+
+	acc, err := scanAccount(r.db.QueryRowContext(ctx, query, id))
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -42,24 +53,43 @@ func (r *AccountRepository) GetAccount(ctx context.Context, id int64) (*models.A
 		return nil, fmt.Errorf("get account failed: %w", err)
 	}
 
-	return &acc, nil
+	return acc, nil
 }
 
+// CreateAccount inserts the account and its AccountCreated event in one
+// transaction.
 func (r *AccountRepository) CreateAccount(ctx context.Context, acc *models.Account) error {
-	query := `INSERT INTO accounts (account_id, balance) VALUES ($1, $2)`
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.log.Error("failed to begin tx", zap.Error(err))
+		return err
+	}
+	defer func(tx *sql.Tx) {
+		_ = tx.Rollback()
+	}(tx)
+
+	query := `INSERT INTO accounts (account_id, balance, opening_balance, currency, status, account_class, overdraft_limit)
+              VALUES ($1, $2, $2, $3, $4, $5, $6)
+              RETURNING version`
 
-	_, err := r.db.ExecContext(ctx, query, acc.ID, acc.Balance)
+	err = tx.QueryRowContext(ctx, query, acc.ID, acc.Balance, acc.Currency, acc.Status, acc.Class, acc.OverdraftLimit).Scan(&acc.Version)
 	if err != nil {
 		r.log.Error("Failed to create account",
 			zap.Int64("account_id", acc.ID),
 			zap.Error(err))
 		return err
 	}
-	return nil
+
+	if err := insertEvent(ctx, tx, models.NewAccountCreatedEvent(acc)); err != nil {
+		r.log.Error("Failed to write account event", zap.Int64("account_id", acc.ID), zap.Error(err))
+		return err
+	}
+
+	return tx.Commit()
 }
 
 func (r *AccountRepository) GetAll(ctx context.Context) ([]models.Account, error) {
-	query := `SELECT account_id, balance FROM accounts`
+	query := `SELECT ` + accountColumns + ` FROM accounts`
 
 	rows, err := r.db.QueryContext(ctx, query)
 	if err != nil {
@@ -70,12 +100,12 @@ func (r *AccountRepository) GetAll(ctx context.Context) ([]models.Account, error
 
 	var accounts []models.Account
 	for rows.Next() {
-		var acc models.Account
-		if err := rows.Scan(&acc.ID, &acc.Balance); err != nil {
+		acc, err := scanAccount(rows)
+		if err != nil {
 			r.log.Error("Row scan failed", zap.Error(err))
 			continue
 		}
-		accounts = append(accounts, acc)
+		accounts = append(accounts, *acc)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -85,3 +115,135 @@ func (r *AccountRepository) GetAll(ctx context.Context) ([]models.Account, error
 
 	return accounts, nil
 }
+
+// UpdateStatus moves an account through its lifecycle. The row is locked so the
+// zero-balance check for closing cannot race with an incoming transfer.
+func (r *AccountRepository) UpdateStatus(ctx context.Context, id int64, to constants.AccountStatus) (*models.Account, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.log.Error("failed to begin tx", zap.Error(err))
+		return nil, constants.ErrSystem
+	}
+	defer func(tx *sql.Tx) {
+		_ = tx.Rollback()
+	}(tx)
+
+	acc, err := scanAccount(tx.QueryRowContext(ctx, "SELECT "+accountColumns+" FROM accounts WHERE account_id = $1 FOR UPDATE", id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, constants.ErrAccountNotFound
+		}
+		r.log.Error("Failed to lock account", zap.Int64("account_id", id), zap.Error(err))
+		return nil, constants.ErrSystem
+	}
+
+	if err := acc.TransitionTo(to); err != nil {
+		return nil, err
+	}
+
+	err = tx.QueryRowContext(ctx, "UPDATE accounts SET status = $1 WHERE account_id = $2 RETURNING version", acc.Status, id).Scan(&acc.Version)
+	if err != nil {
+		r.log.Error("Failed to update account status", zap.Int64("account_id", id), zap.Error(err))
+		return nil, constants.ErrSystem
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, constants.ErrSystem
+	}
+
+	return acc, nil
+}
+
+// UpdateOverdraftLimit changes an account's overdraft. The row is locked so a
+// lowered limit is checked against the balance it will actually apply to.
+func (r *AccountRepository) UpdateOverdraftLimit(ctx context.Context, id int64, limit decimal.Decimal) (*models.Account, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.log.Error("failed to begin tx", zap.Error(err))
+		return nil, constants.ErrSystem
+	}
+	defer func(tx *sql.Tx) {
+		_ = tx.Rollback()
+	}(tx)
+
+	acc, err := scanAccount(tx.QueryRowContext(ctx, "SELECT "+accountColumns+" FROM accounts WHERE account_id = $1 FOR UPDATE", id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, constants.ErrAccountNotFound
+		}
+		r.log.Error("Failed to lock account", zap.Int64("account_id", id), zap.Error(err))
+		return nil, constants.ErrSystem
+	}
+
+	if err := acc.SetOverdraftLimit(limit); err != nil {
+		return nil, err
+	}
+
+	err = tx.QueryRowContext(ctx, "UPDATE accounts SET overdraft_limit = $1 WHERE account_id = $2 RETURNING version", acc.OverdraftLimit, id).
+		Scan(&acc.Version)
+	if err != nil {
+		r.log.Error("Failed to update overdraft limit", zap.Int64("account_id", id), zap.Error(err))
+		return nil, constants.ErrSystem
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, constants.ErrSystem
+	}
+
+	return acc, nil
+}
+
+// GetBalanceAt returns the balance account id had at time at: the post balance
+// of the last transfer posted to it by then, or its opening balance if none
+// was. Each side is a single probe of idx_transfers_source_posted or
+// idx_transfers_dest_posted.
+func (r *AccountRepository) GetBalanceAt(ctx context.Context, id int64, at time.Time) (*models.BalanceAt, error) {
+	result := models.BalanceAt{AccountID: id, At: at}
+
+	var createdAt time.Time
+	err := r.db.QueryRowContext(ctx, "SELECT opening_balance, currency, created_at FROM accounts WHERE account_id = $1", id).
+		Scan(&result.Balance, &result.Currency, &createdAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, constants.ErrAccountNotFound
+		}
+		r.log.Error("Failed to get account", zap.Int64("account_id", id), zap.Error(err))
+		return nil, constants.ErrSystem
+	}
+	if at.Before(createdAt) {
+		return nil, constants.ErrAccountNotOpenYet
+	}
+
+	result.TransferID, err = lastPosting(ctx, r.db, id, at, &result.Balance)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		r.log.Error("Failed to get balance at time", zap.Int64("account_id", id), zap.Time("at", at), zap.Error(err))
+		return nil, constants.ErrSystem
+	}
+
+	return &result, nil
+}
+
+type rowQuerier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// lastPosting returns the last transfer posted to account id by at and scans
+// the balance it left the account with into balance; it returns sql.ErrNoRows,
+// leaving balance untouched, if nothing was posted by then.
+func lastPosting(ctx context.Context, q rowQuerier, id int64, at time.Time, balance *decimal.Decimal) (int64, error) {
+	var transferID int64
+	err := q.QueryRowContext(ctx, `
+        SELECT transfer_id, balance FROM (
+            (SELECT transfer_id, source_post_balance AS balance, posted_at, posting_seq FROM transfers
+             WHERE source_account_id = $1 AND posted_at <= $2
+             ORDER BY posted_at DESC, posting_seq DESC LIMIT 1)
+            UNION ALL
+            (SELECT transfer_id, destination_post_balance, posted_at, posting_seq FROM transfers
+             WHERE destination_account_id = $1 AND posted_at <= $2
+             ORDER BY posted_at DESC, posting_seq DESC LIMIT 1)
+        ) latest
+        ORDER BY posted_at DESC, posting_seq DESC LIMIT 1`,
+		id, at,
+	).Scan(&transferID, balance)
+	return transferID, err
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/shopspring/decimal"
@@ -26,23 +27,34 @@ func setupTest(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *AccountRepository) {
 	return db, mock, repo
 }
 
+func accountRecordRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"account_id", "balance", "held_balance", "currency", "status", "account_class", "overdraft_limit", "version"})
+}
+
 func TestAccountRepository_CreateAccount(t *testing.T) {
 	acc := &models.Account{
-		ID:      101,
-		Balance: decimal.NewFromFloat(500.00),
+		ID:       101,
+		Balance:  decimal.NewFromFloat(500.00),
+		Currency: "USD",
+		Status:   constants.AccountActive,
+		Class:    models.DefaultAccountClass,
 	}
 
 	t.Run("Success", func(t *testing.T) {
 		db, mock, repo := setupTest(t)
 		defer db.Close()
 
-		mock.ExpectExec(`INSERT INTO accounts`).
-			WithArgs(acc.ID, acc.Balance).
-			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO accounts .* RETURNING version`).
+			WithArgs(acc.ID, acc.Balance, acc.Currency, acc.Status, acc.Class, acc.OverdraftLimit).
+			WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(int64(1)))
+		expectEvent(mock, acc.ID, models.EventAccountCreated)
+		mock.ExpectCommit()
 
 		err := repo.CreateAccount(context.Background(), acc)
 
 		assert.NoError(t, err)
+		assert.Equal(t, int64(1), acc.Version)
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
@@ -50,9 +62,11 @@ func TestAccountRepository_CreateAccount(t *testing.T) {
 		db, mock, repo := setupTest(t)
 		defer db.Close()
 
-		mock.ExpectExec(`INSERT INTO accounts`).
-			WithArgs(acc.ID, acc.Balance).
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO accounts`).
+			WithArgs(acc.ID, acc.Balance, acc.Currency, acc.Status, acc.Class, acc.OverdraftLimit).
 			WillReturnError(errors.New("duplicate key violation"))
+		mock.ExpectRollback()
 
 		err := repo.CreateAccount(context.Background(), acc)
 
@@ -69,10 +83,10 @@ func TestAccountRepository_GetAccount(t *testing.T) {
 		db, mock, repo := setupTest(t)
 		defer db.Close()
 
-		rows := sqlmock.NewRows([]string{"account_id", "balance"}).
-			AddRow(accountID, expectedBalance)
+		rows := accountRecordRows().
+			AddRow(accountID, expectedBalance, decimal.Zero, "JPY", constants.AccountFrozen, "premium", decimal.Zero, int64(7))
 
-		mock.ExpectQuery(`SELECT account_id, balance FROM accounts`).
+		mock.ExpectQuery(`SELECT account_id, balance, held_balance, currency, status, account_class, overdraft_limit, version FROM accounts`).
 			WithArgs(accountID).
 			WillReturnRows(rows)
 
@@ -82,6 +96,10 @@ func TestAccountRepository_GetAccount(t *testing.T) {
 		assert.Equal(t, accountID, acc.ID)
 
 		assert.True(t, expectedBalance.Equal(acc.Balance))
+		assert.Equal(t, constants.AccountFrozen, acc.Status)
+		assert.Equal(t, "JPY", acc.Currency)
+		assert.Equal(t, "premium", acc.Class)
+		assert.Equal(t, int64(7), acc.Version)
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
@@ -89,9 +107,9 @@ func TestAccountRepository_GetAccount(t *testing.T) {
 		db, mock, repo := setupTest(t)
 		defer db.Close()
 
-		mock.ExpectQuery(`SELECT account_id, balance FROM accounts`).
+		mock.ExpectQuery(`SELECT account_id, balance, held_balance, currency, status, account_class, overdraft_limit, version FROM accounts`).
 			WithArgs(accountID).
-			WillReturnRows(sqlmock.NewRows([]string{"account_id", "balance"}))
+			WillReturnRows(accountRecordRows())
 
 		acc, err := repo.GetAccount(context.Background(), accountID)
 
@@ -104,7 +122,7 @@ func TestAccountRepository_GetAccount(t *testing.T) {
 		db, mock, repo := setupTest(t)
 		defer db.Close()
 
-		mock.ExpectQuery(`SELECT account_id, balance FROM accounts`).
+		mock.ExpectQuery(`SELECT account_id, balance, held_balance, currency, status, account_class, overdraft_limit, version FROM accounts`).
 			WithArgs(accountID).
 			WillReturnError(errors.New("connection died"))
 
@@ -121,11 +139,11 @@ func TestAccountRepository_GetAll(t *testing.T) {
 		db, mock, repo := setupTest(t)
 		defer db.Close()
 
-		rows := sqlmock.NewRows([]string{"account_id", "balance"}).
-			AddRow(1, decimal.NewFromFloat(100.0)).
-			AddRow(2, decimal.NewFromFloat(200.0))
+		rows := accountRecordRows().
+			AddRow(1, decimal.NewFromFloat(100.0), decimal.Zero, "USD", constants.AccountActive, "standard", decimal.Zero, int64(1)).
+			AddRow(2, decimal.NewFromFloat(200.0), decimal.Zero, "EUR", constants.AccountActive, "standard", decimal.Zero, int64(1))
 
-		mock.ExpectQuery(`SELECT account_id, balance FROM accounts`).
+		mock.ExpectQuery(`SELECT account_id, balance, held_balance, currency, status, account_class, overdraft_limit, version FROM accounts`).
 			WillReturnRows(rows)
 
 		accounts, err := repo.GetAll(context.Background())
@@ -141,7 +159,7 @@ func TestAccountRepository_GetAll(t *testing.T) {
 		db, mock, repo := setupTest(t)
 		defer db.Close()
 
-		mock.ExpectQuery(`SELECT account_id, balance FROM accounts`).
+		mock.ExpectQuery(`SELECT account_id, balance, held_balance, currency, status, account_class, overdraft_limit, version FROM accounts`).
 			WillReturnError(errors.New("syntax error"))
 
 		accounts, err := repo.GetAll(context.Background())
@@ -155,11 +173,11 @@ func TestAccountRepository_GetAll(t *testing.T) {
 		db, mock, repo := setupTest(t)
 		defer db.Close()
 
-		rows := sqlmock.NewRows([]string{"account_id", "balance"}).
-			AddRow(1, decimal.NewFromFloat(100.0)).
+		rows := accountRecordRows().
+			AddRow(1, decimal.NewFromFloat(100.0), decimal.Zero, "USD", constants.AccountActive, "standard", decimal.Zero, int64(1)).
 			RowError(0, errors.New("network packet loss"))
 
-		mock.ExpectQuery(`SELECT account_id, balance FROM accounts`).
+		mock.ExpectQuery(`SELECT account_id, balance, held_balance, currency, status, account_class, overdraft_limit, version FROM accounts`).
 			WillReturnRows(rows)
 
 		accounts, err := repo.GetAll(context.Background())
@@ -169,3 +187,229 @@ func TestAccountRepository_GetAll(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 }
+
+func TestAccountRepository_UpdateStatus(t *testing.T) {
+	accountID := int64(101)
+	lockQuery := `SELECT account_id, .*, version FROM accounts WHERE account_id = \$1 FOR UPDATE`
+
+	t.Run("Success: Account Frozen", func(t *testing.T) {
+		db, mock, repo := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockQuery).
+			WithArgs(accountID).
+			WillReturnRows(accountRecordRows().AddRow(accountID, decimal.NewFromFloat(50.0), decimal.Zero, "USD", constants.AccountActive, "standard", decimal.Zero, int64(3)))
+		mock.ExpectQuery(`UPDATE accounts SET status = \$1 WHERE account_id = \$2 RETURNING version`).
+			WithArgs(constants.AccountFrozen, accountID).
+			WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(int64(4)))
+		mock.ExpectCommit()
+
+		acc, err := repo.UpdateStatus(context.Background(), accountID, constants.AccountFrozen)
+
+		assert.NoError(t, err)
+		assert.Equal(t, constants.AccountFrozen, acc.Status)
+		assert.Equal(t, int64(4), acc.Version)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Close With Remaining Balance", func(t *testing.T) {
+		db, mock, repo := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockQuery).
+			WithArgs(accountID).
+			WillReturnRows(accountRecordRows().AddRow(accountID, decimal.NewFromFloat(50.0), decimal.Zero, "USD", constants.AccountActive, "standard", decimal.Zero, int64(3)))
+		mock.ExpectRollback()
+
+		acc, err := repo.UpdateStatus(context.Background(), accountID, constants.AccountClosed)
+
+		assert.ErrorIs(t, err, constants.ErrAccountBalanceNotZero)
+		assert.Nil(t, acc)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Close With Active Holds", func(t *testing.T) {
+		db, mock, repo := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockQuery).
+			WithArgs(accountID).
+			WillReturnRows(accountRecordRows().AddRow(accountID, decimal.Zero, decimal.NewFromFloat(20.0), "USD", constants.AccountActive, "standard", decimal.NewFromFloat(100.0), int64(3)))
+		mock.ExpectRollback()
+
+		acc, err := repo.UpdateStatus(context.Background(), accountID, constants.AccountClosed)
+
+		assert.ErrorIs(t, err, constants.ErrAccountHasHolds)
+		assert.Nil(t, acc)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Account Not Found", func(t *testing.T) {
+		db, mock, repo := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockQuery).
+			WithArgs(accountID).
+			WillReturnRows(accountRecordRows())
+		mock.ExpectRollback()
+
+		acc, err := repo.UpdateStatus(context.Background(), accountID, constants.AccountFrozen)
+
+		assert.ErrorIs(t, err, constants.ErrAccountNotFound)
+		assert.Nil(t, acc)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAccountRepository_UpdateOverdraftLimit(t *testing.T) {
+	accountID := int64(101)
+	lockQuery := `SELECT account_id, .*, version FROM accounts WHERE account_id = \$1 FOR UPDATE`
+
+	t.Run("Success: Limit Lowered To Cover Drawn Balance", func(t *testing.T) {
+		db, mock, repo := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockQuery).
+			WithArgs(accountID).
+			WillReturnRows(accountRecordRows().
+				AddRow(accountID, decimal.NewFromInt(-40), decimal.NewFromInt(10), "USD", constants.AccountActive, "standard", decimal.NewFromInt(100), int64(2)))
+		mock.ExpectQuery(`UPDATE accounts SET overdraft_limit = \$1 WHERE account_id = \$2 RETURNING version`).
+			WithArgs(decimal.NewFromInt(50), accountID).
+			WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(int64(3)))
+		mock.ExpectCommit()
+
+		acc, err := repo.UpdateOverdraftLimit(context.Background(), accountID, decimal.NewFromInt(50))
+
+		assert.NoError(t, err)
+		assert.True(t, decimal.NewFromInt(50).Equal(acc.OverdraftLimit))
+		assert.True(t, acc.Available().IsZero())
+		assert.Equal(t, int64(3), acc.Version)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Limit Below Drawn Balance", func(t *testing.T) {
+		db, mock, repo := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockQuery).
+			WithArgs(accountID).
+			WillReturnRows(accountRecordRows().
+				AddRow(accountID, decimal.NewFromInt(-40), decimal.Zero, "USD", constants.AccountActive, "standard", decimal.NewFromInt(100), int64(2)))
+		mock.ExpectRollback()
+
+		acc, err := repo.UpdateOverdraftLimit(context.Background(), accountID, decimal.NewFromInt(39))
+
+		assert.ErrorIs(t, err, constants.ErrOverdraftLimitTooLow)
+		assert.Nil(t, acc)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Limit Exceeds Currency Scale", func(t *testing.T) {
+		db, mock, repo := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockQuery).
+			WithArgs(accountID).
+			WillReturnRows(accountRecordRows().
+				AddRow(accountID, decimal.Zero, decimal.Zero, "JPY", constants.AccountActive, "standard", decimal.Zero, int64(1)))
+		mock.ExpectRollback()
+
+		_, err := repo.UpdateOverdraftLimit(context.Background(), accountID, decimal.RequireFromString("10.5"))
+
+		assert.ErrorIs(t, err, constants.ErrInvalidAmountScale)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Account Not Found", func(t *testing.T) {
+		db, mock, repo := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockQuery).
+			WithArgs(accountID).
+			WillReturnRows(accountRecordRows())
+		mock.ExpectRollback()
+
+		_, err := repo.UpdateOverdraftLimit(context.Background(), accountID, decimal.NewFromInt(10))
+
+		assert.ErrorIs(t, err, constants.ErrAccountNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAccountRepository_GetBalanceAt(t *testing.T) {
+	id := int64(101)
+	openedAt := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	at := time.Date(2026, 3, 1, 14, 0, 0, 0, time.UTC)
+	openingRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"opening_balance", "currency", "created_at"}).
+			AddRow(decimal.NewFromInt(500), "USD", openedAt)
+	}
+	const latestQuery = `SELECT transfer_id, balance FROM \(.*WHERE source_account_id = \$1 AND posted_at <= \$2.*WHERE destination_account_id = \$1 AND posted_at <= \$2`
+
+	t.Run("Success: Balance After Last Posting", func(t *testing.T) {
+		db, mock, repo := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT opening_balance, currency, created_at FROM accounts WHERE account_id = \$1`).
+			WithArgs(id).WillReturnRows(openingRows())
+		mock.ExpectQuery(latestQuery).WithArgs(id, at).
+			WillReturnRows(sqlmock.NewRows([]string{"transfer_id", "balance"}).AddRow(int64(42), decimal.NewFromInt(450)))
+
+		balance, err := repo.GetBalanceAt(context.Background(), id, at)
+
+		require.NoError(t, err)
+		assert.Equal(t, "450", balance.Balance.String())
+		assert.Equal(t, "USD", balance.Currency)
+		assert.Equal(t, int64(42), balance.TransferID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Opening Balance Before Any Posting", func(t *testing.T) {
+		db, mock, repo := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT opening_balance`).WithArgs(id).WillReturnRows(openingRows())
+		mock.ExpectQuery(latestQuery).WithArgs(id, at).
+			WillReturnRows(sqlmock.NewRows([]string{"transfer_id", "balance"}))
+
+		balance, err := repo.GetBalanceAt(context.Background(), id, at)
+
+		require.NoError(t, err)
+		assert.Equal(t, "500", balance.Balance.String())
+		assert.Zero(t, balance.TransferID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Before Account Was Opened", func(t *testing.T) {
+		db, mock, repo := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT opening_balance`).WithArgs(id).WillReturnRows(openingRows())
+
+		_, err := repo.GetBalanceAt(context.Background(), id, openedAt.Add(-time.Second))
+
+		assert.ErrorIs(t, err, constants.ErrAccountNotOpenYet)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Account Not Found", func(t *testing.T) {
+		db, mock, repo := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT opening_balance`).WithArgs(id).
+			WillReturnRows(sqlmock.NewRows([]string{"opening_balance", "currency", "created_at"}))
+
+		_, err := repo.GetBalanceAt(context.Background(), id, at)
+
+		assert.ErrorIs(t, err, constants.ErrAccountNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
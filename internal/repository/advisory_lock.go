@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// withAdvisoryLock runs fn only if this instance wins the Postgres advisory
+// lock key, reporting whether it did. The lock is session level, so it is
+// taken and released on one dedicated connection.
+func withAdvisoryLock(ctx context.Context, db *sql.DB, key int64, name string, log *zap.Logger, fn func(ctx context.Context) error) (bool, error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%s lock failed: %w", name, err)
+	}
+	defer conn.Close()
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, key).Scan(&acquired); err != nil {
+		return false, fmt.Errorf("%s lock failed: %w", name, err)
+	}
+	if !acquired {
+		return false, nil
+	}
+
+	defer func() {
+		if _, err := conn.ExecContext(context.WithoutCancel(ctx), `SELECT pg_advisory_unlock($1)`, key); err != nil {
+			log.Error("Failed to release advisory lock", zap.String("lock", name), zap.Error(err))
+			// Discard the connection rather than pool it while it still holds the lock.
+			_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+		}
+	}()
+
+	return true, fn(ctx)
+}
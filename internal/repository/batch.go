@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"github.com/shopspring/decimal"
+)
+
+// TransferBatch posts the legs of batch in one transaction and fills in each
+// leg's Result or Err. Every account involved is locked once, up front, in
+// account_id order. An all-or-nothing batch rolls back on the first leg that
+// cannot post and returns a *models.LegError; a best-effort batch records the
+// error on the leg and carries on. Legs that already carry an error are skipped.
+func (r *TransferRepository) TransferBatch(ctx context.Context, batch *models.BatchTransferRequest) error {
+	var pending []int
+	for i := range batch.Legs {
+		if batch.Legs[i].Err == nil {
+			pending = append(pending, i)
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	err := r.runTx(ctx, "batch", func(tx *sql.Tx) error {
+		return r.transferBatchTx(ctx, tx, batch, pending)
+	})
+	if err != nil {
+		// Nothing was committed, so no leg has a result to report.
+		for _, i := range pending {
+			batch.Legs[i].Result = nil
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *TransferRepository) transferBatchTx(ctx context.Context, tx *sql.Tx, batch *models.BatchTransferRequest, pending []int) error {
+	// A retried transaction starts over, so drop the outcome of an earlier attempt.
+	for _, i := range pending {
+		batch.Legs[i].Result, batch.Legs[i].Err = nil, nil
+	}
+
+	accounts, err := lockAccountSet(ctx, tx, batch.AccountIDs())
+	if err != nil {
+		return err
+	}
+
+	for _, i := range pending {
+		leg := &batch.Legs[i]
+
+		destAmount, err := r.checkLeg(ctx, tx, &leg.TransferRequest, accounts)
+		if errors.Is(err, constants.ErrSystem) {
+			return err
+		}
+		if err != nil {
+			if !batch.BestEffort {
+				return &models.LegError{Index: i, Err: err}
+			}
+			leg.Err = err
+			continue
+		}
+
+		src, dest := accounts[leg.SourceID], accounts[leg.DestinationID]
+		leg.Result, err = r.postTransfer(ctx, tx, &leg.TransferRequest, src, dest, destAmount)
+		if err == nil && leg.Fee != nil {
+			err = r.postFee(ctx, tx, &leg.TransferRequest, leg.Result, src, accounts[leg.Fee.AccountID])
+		}
+		if err != nil {
+			if errors.Is(err, constants.ErrSystem) {
+				return err
+			}
+			return &models.LegError{Index: i, Err: err}
+		}
+	}
+
+	return nil
+}
+
+// checkLeg verifies one leg, and its fee account if it pays a fee, against the
+// accounts locked for the batch and returns the amount to credit the
+// destination.
+func (r *TransferRepository) checkLeg(ctx context.Context, tx *sql.Tx, leg *models.TransferRequest, accounts map[int64]*models.Account) (decimal.Decimal, error) {
+	src, dest := accounts[leg.SourceID], accounts[leg.DestinationID]
+	if src == nil || dest == nil {
+		return decimal.Zero, constants.ErrAccountNotFound
+	}
+	if leg.Fee != nil {
+		if err := r.checkFeeAccount(leg, src, accounts[leg.Fee.AccountID]); err != nil {
+			return decimal.Zero, err
+		}
+	}
+
+	destAmount, err := checkTransfer(leg, src, dest)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if err := r.checkLimits(ctx, tx, src, leg.Amount); err != nil {
+		return decimal.Zero, err
+	}
+	return destAmount, nil
+}
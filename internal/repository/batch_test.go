@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+const lockThreeAccountsQuery = `SELECT account_id, balance, held_balance, currency, status, overdraft_limit FROM accounts WHERE account_id IN \(\$1, \$2, \$3\) ORDER BY account_id FOR UPDATE`
+
+func batchLeg(src, dest int64, amount int64) models.BatchLeg {
+	return models.BatchLeg{TransferRequest: models.TransferRequest{
+		SourceID: src, DestinationID: dest, Amount: decimal.NewFromInt(amount),
+	}}
+}
+
+// expectPostedLeg expects the writes of one same-currency USD transfer; a fee
+// on leg is recorded but not posted.
+func expectPostedLeg(mock sqlmock.Sqlmock, transferID int64, leg models.TransferRequest, srcPre, destPre int64) {
+	var groupID, feeOf any
+	if leg.GroupID != 0 {
+		groupID = leg.GroupID
+	}
+	if leg.FeeOf != 0 {
+		feeOf = leg.FeeOf
+	}
+	kind := leg.Kind
+	if kind == 0 {
+		kind = constants.KindTransfer
+	}
+	srcPost := decimal.NewFromInt(srcPre).Sub(leg.Amount)
+	destPost := decimal.NewFromInt(destPre).Add(leg.Amount)
+
+	mock.ExpectQuery(`INSERT INTO transfers`).
+		WithArgs(leg.SourceID, leg.DestinationID, leg.Amount, int64(0), constants.StatusPending,
+			decimal.NewFromInt(srcPre), decimal.NewFromInt(destPre), nil, "USD",
+			leg.Amount, "USD", nil, nil, groupID, leg.FeeAmount(), feeOf, kind).
+		WillReturnRows(sqlmock.NewRows([]string{"transfer_id", "created_at"}).AddRow(transferID, time.Now()))
+	mock.ExpectExec(`UPDATE accounts SET balance = \$1 WHERE account_id = \$2`).
+		WithArgs(srcPost, leg.SourceID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE accounts SET balance = \$1 WHERE account_id = \$2`).
+		WithArgs(destPost, leg.DestinationID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO ledger_entries`).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`UPDATE transfers SET status = \$1`).
+		WithArgs(constants.StatusCompleted, srcPost, destPost, transferID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectEvent(mock, leg.SourceID, models.EventTransferCompleted)
+}
+
+func expectLockThree(mock sqlmock.Sqlmock, balances ...int64) {
+	rows := accountRows()
+	for i, b := range balances {
+		rows.AddRow(int64(i+1), decimal.NewFromInt(b), decimal.Zero, "USD", constants.AccountActive, decimal.Zero)
+	}
+	mock.ExpectQuery(lockThreeAccountsQuery).WithArgs(int64(1), int64(2), int64(3)).WillReturnRows(rows)
+}
+
+func TestTransferRepository_TransferBatch(t *testing.T) {
+	t.Run("Success: Legs Share Locked Balances", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		batch := &models.BatchTransferRequest{Legs: []models.BatchLeg{batchLeg(1, 3, 30), batchLeg(1, 2, 50)}}
+
+		mock.ExpectBegin()
+		expectLockThree(mock, 100, 0, 0)
+		expectNoLimits(mock, batch.Legs[0].SourceID)
+		expectPostedLeg(mock, 10, batch.Legs[0].TransferRequest, 100, 0)
+		expectNoLimits(mock, batch.Legs[1].SourceID)
+		expectPostedLeg(mock, 11, batch.Legs[1].TransferRequest, 70, 0)
+		mock.ExpectCommit()
+
+		require.NoError(t, repo.TransferBatch(context.Background(), batch))
+		assert.Equal(t, int64(10), batch.Legs[0].Result.AuditID)
+		assert.Equal(t, "20", batch.Legs[1].Result.SourcePostBalance)
+		assert.Zero(t, batch.Failed())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Leg Fee Posted After The Leg", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		batch := &models.BatchTransferRequest{Legs: []models.BatchLeg{batchLeg(1, 2, 50)}}
+		batch.Legs[0].Fee = &models.TransferFee{Amount: decimal.NewFromInt(2), AccountID: 3}
+
+		mock.ExpectBegin()
+		expectLockThree(mock, 100, 0, 0)
+		expectNoLimits(mock, batch.Legs[0].SourceID)
+		expectPostedLeg(mock, 10, batch.Legs[0].TransferRequest, 100, 0)
+		expectPostedLeg(mock, 11, models.TransferRequest{
+			SourceID: 1, DestinationID: 3, Amount: decimal.NewFromInt(2), FeeOf: 10,
+		}, 50, 0)
+		mock.ExpectCommit()
+
+		require.NoError(t, repo.TransferBatch(context.Background(), batch))
+		assert.Equal(t, "2", batch.Legs[0].Result.Fee)
+		assert.Equal(t, "48", batch.Legs[0].Result.SourcePostBalance)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Leg Balance Covers Amount But Not Fee", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		batch := &models.BatchTransferRequest{Legs: []models.BatchLeg{batchLeg(1, 2, 50)}}
+		batch.Legs[0].Fee = &models.TransferFee{Amount: decimal.NewFromInt(2), AccountID: 3}
+
+		mock.ExpectBegin()
+		expectLockThree(mock, 51, 0, 0)
+		mock.ExpectRollback()
+
+		err := repo.TransferBatch(context.Background(), batch)
+		assert.ErrorIs(t, err, constants.ErrInsufficientFunds)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Atomic Batch Rolls Back On Failing Leg", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		batch := &models.BatchTransferRequest{Legs: []models.BatchLeg{batchLeg(1, 3, 80), batchLeg(1, 2, 50)}}
+
+		mock.ExpectBegin()
+		expectLockThree(mock, 100, 0, 0)
+		expectNoLimits(mock, batch.Legs[0].SourceID)
+		expectPostedLeg(mock, 10, batch.Legs[0].TransferRequest, 100, 0)
+		mock.ExpectRollback()
+
+		err := repo.TransferBatch(context.Background(), batch)
+		var legErr *models.LegError
+		require.ErrorAs(t, err, &legErr)
+		assert.Equal(t, 1, legErr.Index)
+		assert.ErrorIs(t, err, constants.ErrInsufficientFunds)
+		assert.Nil(t, batch.Legs[0].Result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Best Effort Skips Failing Legs", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		batch := &models.BatchTransferRequest{
+			Legs:       []models.BatchLeg{batchLeg(1, 3, 500), batchLeg(1, 4, 10), batchLeg(1, 2, 50)},
+			BestEffort: true,
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`WHERE account_id IN \(\$1, \$2, \$3, \$4\)`).
+			WithArgs(int64(1), int64(2), int64(3), int64(4)).
+			WillReturnRows(accountRows().
+				AddRow(int64(1), decimal.NewFromInt(100), decimal.Zero, "USD", constants.AccountActive, decimal.Zero).
+				AddRow(int64(2), decimal.Zero, decimal.Zero, "USD", constants.AccountActive, decimal.Zero).
+				AddRow(int64(3), decimal.Zero, decimal.Zero, "USD", constants.AccountActive, decimal.Zero))
+		expectNoLimits(mock, batch.Legs[2].SourceID)
+		expectPostedLeg(mock, 10, batch.Legs[2].TransferRequest, 100, 0)
+		mock.ExpectCommit()
+
+		require.NoError(t, repo.TransferBatch(context.Background(), batch))
+		assert.ErrorIs(t, batch.Legs[0].Err, constants.ErrInsufficientFunds)
+		assert.ErrorIs(t, batch.Legs[1].Err, constants.ErrAccountNotFound)
+		assert.Equal(t, int64(10), batch.Legs[2].Result.AuditID)
+		assert.Equal(t, 2, batch.Failed())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Legs Rejected Upstream Are Not Locked", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		batch := &models.BatchTransferRequest{
+			Legs:       []models.BatchLeg{batchLeg(5, 6, 10), batchLeg(1, 2, 10)},
+			BestEffort: true,
+		}
+		batch.Legs[0].Err = constants.ErrAccountFrozen
+
+		mock.ExpectBegin()
+		expectLockAccounts(mock,
+			models.Account{ID: 1, Balance: decimal.NewFromInt(100), Currency: "USD", Status: constants.AccountActive},
+			models.Account{ID: 2, Balance: decimal.Zero, Currency: "USD", Status: constants.AccountActive})
+		expectNoLimits(mock, batch.Legs[1].SourceID)
+		expectPostedLeg(mock, 10, batch.Legs[1].TransferRequest, 100, 0)
+		mock.ExpectCommit()
+
+		require.NoError(t, repo.TransferBatch(context.Background(), batch))
+		assert.ErrorIs(t, batch.Legs[0].Err, constants.ErrAccountFrozen)
+		assert.NotNil(t, batch.Legs[1].Result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -5,16 +5,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/jhaprabhatt/account-transfer-project/internal/config"
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
 	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 )
 
 type AccountCache struct {
-	client *redis.Client
+	client     *redis.Client
+	missingTTL time.Duration
 }
 
-func NewAccountCache() *AccountCache {
+// NewAccountCache connects to Redis. IDs found absent from the database are
+// cached as missing for missingTTL.
+func NewAccountCache(missingTTL time.Duration) *AccountCache {
 	addr := config.GetEnv("REDIS_ADDR", "localhost:6379")
 	password := config.GetEnv("REDIS_PASSWORD", "")
 
@@ -24,28 +29,105 @@ func NewAccountCache() *AccountCache {
 		DB:       0,
 	})
 
-	return &AccountCache{client: rdb}
+	return &AccountCache{client: rdb, missingTTL: missingTTL}
 }
 
+func accountKey(id int64) string {
+	return fmt.Sprintf("account:%d", id)
+}
+
+func missingKey(id int64) string {
+	return fmt.Sprintf("account:missing:%d", id)
+}
+
+// setAccountScript writes ARGV[1] to KEYS[1] unless the account already
+// cached there has a higher version than ARGV[2], so a refresh that lost a
+// race cannot overwrite a newer balance with an older one. The account exists,
+// so its missing marker KEYS[2] is cleared either way.
+var setAccountScript = redis.NewScript(`
+redis.call('DEL', KEYS[2])
+local current = redis.call('GET', KEYS[1])
+if current then
+    local ok, cached = pcall(cjson.decode, current)
+    if ok and type(cached) == 'table' and tonumber(cached.version) and tonumber(cached.version) > tonumber(ARGV[2]) then
+        return 0
+    end
+end
+redis.call('SET', KEYS[1], ARGV[1])
+return 1
+`)
+
+// SetAccount caches acc unless a newer version of it is already cached.
 func (c *AccountCache) SetAccount(ctx context.Context, acc *models.Account) error {
 	data, err := json.Marshal(acc)
 	if err != nil {
 		return fmt.Errorf("failed to marshal account: %w", err)
 	}
 
-	key := fmt.Sprintf("account:%d", acc.ID)
-	if err := c.client.Set(ctx, key, data, 0).Err(); err != nil {
+	key := accountKey(acc.ID)
+	if err := setAccountScript.Run(ctx, c.client, []string{key, missingKey(acc.ID)}, data, acc.Version).Err(); err != nil {
 		return fmt.Errorf("failed to set key %s: %w", key, err)
 	}
 
 	return nil
 }
 
+// setMissingScript marks KEYS[2] missing for ARGV[1] milliseconds, unless the
+// account was cached at KEYS[1] since the database said it did not exist.
+var setMissingScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+    return 0
+end
+redis.call('SET', KEYS[2], '1', 'PX', ARGV[1])
+return 1
+`)
+
+// SetMissing caches that the account does not exist, so lookups of unknown
+// IDs stop reaching the database until the marker expires or the account is
+// created.
+func (c *AccountCache) SetMissing(ctx context.Context, accountID int64) error {
+	key := missingKey(accountID)
+	err := setMissingScript.Run(ctx, c.client, []string{accountKey(accountID), key}, c.missingTTL.Milliseconds()).Err()
+	if err != nil {
+		return fmt.Errorf("failed to set key %s: %w", key, err)
+	}
+	return nil
+}
+
 func (c *AccountCache) Exists(ctx context.Context, accountID int64) (bool, error) {
-	key := fmt.Sprintf("account:%d", accountID)
+	key := accountKey(accountID)
 	count, err := c.client.Exists(ctx, key).Result()
 	if err != nil {
 		return false, fmt.Errorf("failed to check existence: %w", err)
 	}
 	return count > 0, nil
 }
+
+// GetAccount returns the cached account, ErrAccountNotFound if the account is
+// cached as missing, or nil without error on a cache miss. An entry that no
+// longer decodes is deleted and reported as a miss, so callers read through
+// and rewrite it instead of treating the cache as down.
+func (c *AccountCache) GetAccount(ctx context.Context, accountID int64) (*models.Account, error) {
+	key := accountKey(accountID)
+	vals, err := c.client.MGet(ctx, key, missingKey(accountID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key %s: %w", key, err)
+	}
+
+	data, ok := vals[0].(string)
+	if !ok {
+		if vals[1] != nil {
+			return nil, constants.ErrAccountNotFound
+		}
+		return nil, nil
+	}
+
+	var acc models.Account
+	if err := json.Unmarshal([]byte(data), &acc); err != nil {
+		if err := c.client.Del(ctx, key).Err(); err != nil {
+			return nil, fmt.Errorf("failed to delete corrupt key %s: %w", key, err)
+		}
+		return nil, nil
+	}
+	return &acc, nil
+}
@@ -6,11 +6,13 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/go-redis/redismock/v9"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
 	"github.com/jhaprabhatt/account-transfer-project/internal/models"
 )
 
@@ -18,8 +20,9 @@ func TestAccountCache_SetAccount(t *testing.T) {
 	acc := &models.Account{
 		ID:      101,
 		Balance: decimal.NewFromFloat(500.00),
+		Version: 7,
 	}
-	expectedKey := fmt.Sprintf("account:%d", acc.ID)
+	expectedKeys := []string{fmt.Sprintf("account:%d", acc.ID), fmt.Sprintf("account:missing:%d", acc.ID)}
 
 	expectedJSON, _ := json.Marshal(acc)
 
@@ -28,8 +31,21 @@ func TestAccountCache_SetAccount(t *testing.T) {
 
 		cache := &AccountCache{client: db}
 
-		mock.ExpectSet(expectedKey, expectedJSON, 0).
-			SetVal("OK")
+		mock.ExpectEvalSha(setAccountScript.Hash(), expectedKeys, expectedJSON, acc.Version).
+			SetVal(int64(1))
+
+		err := cache.SetAccount(context.Background(), acc)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Newer Version Already Cached", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		cache := &AccountCache{client: db}
+
+		mock.ExpectEvalSha(setAccountScript.Hash(), expectedKeys, expectedJSON, acc.Version).
+			SetVal(int64(0))
 
 		err := cache.SetAccount(context.Background(), acc)
 
@@ -41,7 +57,7 @@ func TestAccountCache_SetAccount(t *testing.T) {
 		db, mock := redismock.NewClientMock()
 		cache := &AccountCache{client: db}
 
-		mock.ExpectSet(expectedKey, expectedJSON, 0).
+		mock.ExpectEvalSha(setAccountScript.Hash(), expectedKeys, expectedJSON, acc.Version).
 			SetErr(errors.New("connection refused"))
 
 		err := cache.SetAccount(context.Background(), acc)
@@ -51,6 +67,35 @@ func TestAccountCache_SetAccount(t *testing.T) {
 	})
 }
 
+func TestAccountCache_SetMissing(t *testing.T) {
+	accountID := int64(404)
+	expectedKeys := []string{"account:404", "account:missing:404"}
+
+	t.Run("Success: Marker Set With TTL", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		cache := &AccountCache{client: db, missingTTL: 30 * time.Second}
+
+		mock.ExpectEvalSha(setMissingScript.Hash(), expectedKeys, int64(30000)).SetVal(int64(1))
+
+		err := cache.SetMissing(context.Background(), accountID)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Redis Error", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		cache := &AccountCache{client: db, missingTTL: 30 * time.Second}
+
+		mock.ExpectEvalSha(setMissingScript.Hash(), expectedKeys, int64(30000)).SetErr(errors.New("connection refused"))
+
+		err := cache.SetMissing(context.Background(), accountID)
+
+		assert.ErrorContains(t, err, "failed to set key account:missing:404")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestAccountCache_Exists(t *testing.T) {
 	accountID := int64(101)
 	expectedKey := fmt.Sprintf("account:%d", accountID)
@@ -96,10 +141,103 @@ func TestAccountCache_Exists(t *testing.T) {
 	})
 }
 
+func TestAccountCache_GetAccount(t *testing.T) {
+	acc := &models.Account{
+		ID:      101,
+		Balance: decimal.NewFromFloat(500.00),
+		Status:  constants.AccountFrozen,
+	}
+	expectedKey := fmt.Sprintf("account:%d", acc.ID)
+	missingKey := fmt.Sprintf("account:missing:%d", acc.ID)
+	cachedJSON, _ := json.Marshal(acc)
+
+	t.Run("Success: Account Cached", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		cache := &AccountCache{client: db}
+
+		mock.ExpectMGet(expectedKey, missingKey).SetVal([]any{string(cachedJSON), nil})
+
+		got, err := cache.GetAccount(context.Background(), acc.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, acc.ID, got.ID)
+		assert.Equal(t, constants.AccountFrozen, got.Status)
+		assert.True(t, acc.Balance.Equal(got.Balance))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Cache Miss (Returns Nil)", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		cache := &AccountCache{client: db}
+
+		mock.ExpectMGet(expectedKey, missingKey).SetVal([]any{nil, nil})
+
+		got, err := cache.GetAccount(context.Background(), acc.ID)
+
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Corrupt Entry Deleted And Treated As Miss", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		cache := &AccountCache{client: db}
+
+		mock.ExpectMGet(expectedKey, missingKey).SetVal([]any{"{not json", nil})
+		mock.ExpectDel(expectedKey).SetVal(1)
+
+		got, err := cache.GetAccount(context.Background(), acc.ID)
+
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Corrupt Entry Delete Fails", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		cache := &AccountCache{client: db}
+
+		mock.ExpectMGet(expectedKey, missingKey).SetVal([]any{"{not json", nil})
+		mock.ExpectDel(expectedKey).SetErr(errors.New("redis timeout"))
+
+		got, err := cache.GetAccount(context.Background(), acc.ID)
+
+		assert.ErrorContains(t, err, "failed to delete corrupt key")
+		assert.Nil(t, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Cached As Missing", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		cache := &AccountCache{client: db}
+
+		mock.ExpectMGet(expectedKey, missingKey).SetVal([]any{nil, "1"})
+
+		got, err := cache.GetAccount(context.Background(), acc.ID)
+
+		assert.ErrorIs(t, err, constants.ErrAccountNotFound)
+		assert.Nil(t, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Redis Error", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		cache := &AccountCache{client: db}
+
+		mock.ExpectMGet(expectedKey, missingKey).SetErr(errors.New("redis timeout"))
+
+		got, err := cache.GetAccount(context.Background(), acc.ID)
+
+		assert.ErrorContains(t, err, "failed to get key")
+		assert.Nil(t, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestNewAccountCache(t *testing.T) {
 	t.Setenv("REDIS_ADDR", "localhost:9999")
 	t.Setenv("REDIS_PASSWORD", "secret_pass")
-	cache := NewAccountCache()
+	cache := NewAccountCache(time.Minute)
 	assert.NotNil(t, cache)
 	assert.NotNil(t, cache.client)
 	opt := cache.client.Options()
@@ -107,13 +245,14 @@ func TestNewAccountCache(t *testing.T) {
 	assert.Equal(t, "localhost:9999", opt.Addr)
 	assert.Equal(t, "secret_pass", opt.Password)
 	assert.Equal(t, 0, opt.DB)
+	assert.Equal(t, time.Minute, cache.missingTTL)
 }
 
 func TestNewAccountCache_Defaults(t *testing.T) {
 	t.Setenv("REDIS_ADDR", "")
 	t.Setenv("REDIS_PASSWORD", "")
 
-	cache := NewAccountCache()
+	cache := NewAccountCache(time.Minute)
 	opt := cache.client.Options()
 	assert.Equal(t, "localhost:6379", opt.Addr)
 	assert.Empty(t, opt.Password)
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+func TestTransferRepository_Transfer_Cash(t *testing.T) {
+	settlement := models.Account{
+		ID: 1, Balance: decimal.Zero, Currency: "USD", Status: constants.AccountActive,
+		OverdraftLimit: decimal.NewFromInt(1000),
+	}
+	customer := models.Account{ID: 2, Balance: decimal.NewFromInt(100), Currency: "USD", Status: constants.AccountActive}
+
+	t.Run("Success: Deposit Draws On Settlement Without Limits", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+		req := &models.TransferRequest{SourceID: 1, DestinationID: 2, Amount: decimal.NewFromInt(50), Kind: constants.KindDeposit}
+
+		mock.ExpectBegin()
+		expectLockAccounts(mock, settlement, customer)
+		expectPostedLeg(mock, 10, *req, 0, 100)
+		mock.ExpectCommit()
+
+		result, err := repo.Transfer(context.Background(), req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "-50", result.SourcePostBalance)
+		assert.Equal(t, "150", result.DestinationPostBalance)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Withdrawal Counts Against Limits", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+		req := &models.TransferRequest{SourceID: 2, DestinationID: 1, Amount: decimal.NewFromInt(30), Kind: constants.KindWithdrawal}
+
+		mock.ExpectBegin()
+		expectLockAccounts(mock, settlement, customer)
+		expectNoLimits(mock, req.SourceID)
+		expectPostedLeg(mock, 11, *req, 100, 0)
+		mock.ExpectCommit()
+
+		result, err := repo.Transfer(context.Background(), req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "70", result.SourcePostBalance)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Deposit Beyond Settlement Overdraft", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		expectLockAccounts(mock, settlement, customer)
+		mock.ExpectRollback()
+
+		_, err := repo.Transfer(context.Background(), &models.TransferRequest{
+			SourceID: 1, DestinationID: 2, Amount: decimal.NewFromInt(1001), Kind: constants.KindDeposit,
+		})
+
+		assert.ErrorIs(t, err, constants.ErrInsufficientFunds)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
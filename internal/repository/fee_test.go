@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+func TestTransferRepository_Transfer_Fee(t *testing.T) {
+	newReq := func() *models.TransferRequest {
+		return &models.TransferRequest{
+			SourceID:      1,
+			DestinationID: 2,
+			Amount:        decimal.NewFromInt(50),
+			Fee:           &models.TransferFee{Amount: decimal.NewFromInt(2), AccountID: 3},
+		}
+	}
+
+	t.Run("Success: Fee Posted As Second Transfer", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+		req := newReq()
+
+		mock.ExpectBegin()
+		expectLockThree(mock, 100, 0, 0)
+		expectNoLimits(mock, req.SourceID)
+		expectPostedLeg(mock, 10, *req, 100, 0)
+		expectPostedLeg(mock, 11, models.TransferRequest{
+			SourceID: 1, DestinationID: 3, Amount: decimal.NewFromInt(2), FeeOf: 10,
+		}, 50, 0)
+		mock.ExpectCommit()
+
+		result, err := repo.Transfer(context.Background(), req)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), result.AuditID)
+		assert.Equal(t, "2", result.Fee)
+		assert.Equal(t, "48", result.SourcePostBalance)
+		assert.Equal(t, "50", result.SourceAmount)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Balance Covers Amount But Not Fee", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		expectLockThree(mock, 51, 0, 0)
+		mock.ExpectRollback()
+
+		_, err := repo.Transfer(context.Background(), newReq())
+
+		assert.ErrorIs(t, err, constants.ErrInsufficientFunds)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Fee Account In Another Currency", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockThreeAccountsQuery).WithArgs(int64(1), int64(2), int64(3)).
+			WillReturnRows(accountRows().
+				AddRow(int64(1), decimal.NewFromInt(100), decimal.Zero, "USD", constants.AccountActive, decimal.Zero).
+				AddRow(int64(2), decimal.Zero, decimal.Zero, "USD", constants.AccountActive, decimal.Zero).
+				AddRow(int64(3), decimal.Zero, decimal.Zero, "EUR", constants.AccountActive, decimal.Zero))
+		mock.ExpectRollback()
+
+		_, err := repo.Transfer(context.Background(), newReq())
+
+		assert.ErrorIs(t, err, constants.ErrFeeAccountMissing)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Replay Reports Balance After Fee", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+		req := newReq()
+		req.IdempotencyKey = "key-1"
+
+		mock.ExpectQuery(`SELECT transfer_id, correlation_id, source_account_id`).
+			WithArgs(req.IdempotencyKey).
+			WillReturnRows(sqlmock.NewRows([]string{
+				"transfer_id", "correlation_id", "source_account_id", "destination_account_id",
+				"amount", "currency", "destination_amount", "destination_currency", "fx_rate",
+				"source_post_balance", "destination_post_balance", "fee", "created_at",
+			}).AddRow(10, 555, 1, 2, decimal.NewFromInt(50), "USD", decimal.NewFromInt(50), "USD", nil,
+				decimal.NewFromInt(50), decimal.NewFromInt(50), decimal.NewFromInt(2), time.Now()))
+
+		result, err := repo.Transfer(context.Background(), req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "2", result.Fee)
+		assert.Equal(t, "48", result.SourcePostBalance)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"go.uber.org/zap"
+)
+
+type FxRateRepository struct {
+	db  *sql.DB
+	log *zap.Logger
+}
+
+func NewFxRateRepository(db *sql.DB, log *zap.Logger) *FxRateRepository {
+	return &FxRateRepository{db: db, log: log}
+}
+
+// LatestRate returns the newest rate for base->quote that is already in effect.
+func (r *FxRateRepository) LatestRate(ctx context.Context, base, quote string) (*models.FxRate, error) {
+	var rate models.FxRate
+	err := r.db.QueryRowContext(ctx, `
+        SELECT rate_id, base_currency, quote_currency, rate, effective_at
+        FROM fx_rates
+        WHERE base_currency = $1 AND quote_currency = $2 AND effective_at <= now()
+        ORDER BY effective_at DESC, rate_id DESC
+        LIMIT 1`, base, quote,
+	).Scan(&rate.ID, &rate.Base, &rate.Quote, &rate.Rate, &rate.EffectiveAt)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, constants.ErrFxRateNotFound
+	}
+	if err != nil {
+		r.log.Error("Failed to look up fx rate", zap.String("base", base), zap.String("quote", quote), zap.Error(err))
+		return nil, fmt.Errorf("get fx rate failed: %w", err)
+	}
+
+	return &rate, nil
+}
+
+// SaveRates appends rates in a single statement so a load applies all or nothing.
+func (r *FxRateRepository) SaveRates(ctx context.Context, rates []models.FxRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+
+	values := make([]string, 0, len(rates))
+	args := make([]any, 0, len(rates)*4)
+	for _, rate := range rates {
+		n := len(args)
+		values = append(values, fmt.Sprintf("($%d, $%d, $%d, $%d)", n+1, n+2, n+3, n+4))
+		args = append(args, rate.Base, rate.Quote, rate.Rate, rate.EffectiveAt)
+	}
+
+	query := `INSERT INTO fx_rates (base_currency, quote_currency, rate, effective_at) VALUES ` + strings.Join(values, ", ")
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		r.log.Error("Failed to save fx rates", zap.Int("count", len(rates)), zap.Error(err))
+		return fmt.Errorf("save fx rates failed: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+func setupFxRateTest(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *FxRateRepository) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	return db, mock, NewFxRateRepository(db, zap.NewNop())
+}
+
+func TestFxRateRepository_LatestRate(t *testing.T) {
+	query := `SELECT rate_id, base_currency, quote_currency, rate, effective_at FROM fx_rates WHERE base_currency = \$1 AND quote_currency = \$2`
+
+	t.Run("Success: Rate Found", func(t *testing.T) {
+		db, mock, repo := setupFxRateTest(t)
+		defer db.Close()
+
+		effectiveAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		mock.ExpectQuery(query).
+			WithArgs("USD", "EUR").
+			WillReturnRows(sqlmock.NewRows([]string{"rate_id", "base_currency", "quote_currency", "rate", "effective_at"}).
+				AddRow(3, "USD", "EUR", decimal.RequireFromString("0.92345"), effectiveAt))
+
+		rate, err := repo.LatestRate(context.Background(), "USD", "EUR")
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), rate.ID)
+		assert.Equal(t, "0.92345", rate.Rate.String())
+		assert.Equal(t, effectiveAt, rate.EffectiveAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Rate Not Found", func(t *testing.T) {
+		db, mock, repo := setupFxRateTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(query).WithArgs("USD", "JPY").WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.LatestRate(context.Background(), "USD", "JPY")
+		assert.Equal(t, constants.ErrFxRateNotFound, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: DB Error", func(t *testing.T) {
+		db, mock, repo := setupFxRateTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(query).WithArgs("USD", "EUR").WillReturnError(errors.New("db down"))
+
+		_, err := repo.LatestRate(context.Background(), "USD", "EUR")
+		assert.Error(t, err)
+		assert.NotEqual(t, constants.ErrFxRateNotFound, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestFxRateRepository_SaveRates(t *testing.T) {
+	effectiveAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	rates := []models.FxRate{
+		{Base: "USD", Quote: "EUR", Rate: decimal.RequireFromString("0.92"), EffectiveAt: effectiveAt},
+		{Base: "EUR", Quote: "USD", Rate: decimal.RequireFromString("1.087"), EffectiveAt: effectiveAt},
+	}
+
+	t.Run("Success: Single Multi-Row Insert", func(t *testing.T) {
+		db, mock, repo := setupFxRateTest(t)
+		defer db.Close()
+
+		mock.ExpectExec(`INSERT INTO fx_rates \(base_currency, quote_currency, rate, effective_at\) VALUES \(\$1, \$2, \$3, \$4\), \(\$5, \$6, \$7, \$8\)`).
+			WithArgs(
+				"USD", "EUR", rates[0].Rate, effectiveAt,
+				"EUR", "USD", rates[1].Rate, effectiveAt,
+			).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		assert.NoError(t, repo.SaveRates(context.Background(), rates))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Empty Input Is No-Op", func(t *testing.T) {
+		db, mock, repo := setupFxRateTest(t)
+		defer db.Close()
+
+		assert.NoError(t, repo.SaveRates(context.Background(), nil))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: DB Error", func(t *testing.T) {
+		db, mock, repo := setupFxRateTest(t)
+		defer db.Close()
+
+		mock.ExpectExec(`INSERT INTO fx_rates`).WillReturnError(errors.New("violates check constraint"))
+
+		assert.Error(t, repo.SaveRates(context.Background(), rates))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,370 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// Authorize reserves req.Amount on the source account. The transfer row is
+// written as PENDING and no ledger entries are posted until the hold is
+// captured, so only the available balance drops.
+func (r *TransferRepository) Authorize(ctx context.Context, req *models.AuthorizeRequest) (*models.Hold, error) {
+	var hold *models.Hold
+	err := r.runTx(ctx, "authorize", func(tx *sql.Tx) error {
+		var err error
+		hold, err = r.authorizeTx(ctx, tx, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hold, nil
+}
+
+func (r *TransferRepository) authorizeTx(ctx context.Context, tx *sql.Tx, req *models.AuthorizeRequest) (*models.Hold, error) {
+	correlationID, _ := ctx.Value(constants.CorrelationKey).(int64)
+
+	src, dest, err := lockAccounts(ctx, tx, req.SourceID, req.DestinationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := src.CheckDebit(); err != nil {
+		return nil, err
+	}
+
+	if err := dest.CheckCredit(); err != nil {
+		return nil, err
+	}
+
+	if err := req.CheckCurrency(src, dest); err != nil {
+		return nil, err
+	}
+
+	if !src.CanWithdraw(req.Amount) {
+		return nil, constants.ErrInsufficientFunds
+	}
+
+	// The hold counts towards the limits from now on, so capturing it is not checked again.
+	if err := r.checkLimits(ctx, tx, src, req.Amount); err != nil {
+		return nil, err
+	}
+
+	hold := &models.Hold{
+		CorrelationID: correlationID,
+		SourceID:      req.SourceID,
+		DestinationID: req.DestinationID,
+		Amount:        req.Amount,
+		Currency:      src.Currency,
+		Status:        constants.HoldActive,
+		ExpiresAt:     req.ExpiresAt,
+	}
+
+	err = tx.QueryRowContext(ctx, `
+        INSERT INTO transfers (
+            source_account_id, destination_account_id, amount,
+            correlation_id, status, source_prev_balance, destination_prev_balance,
+            currency, destination_amount, destination_currency
+        )
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $3, $8)
+        RETURNING transfer_id, created_at`,
+		req.SourceID, req.DestinationID, req.Amount, correlationID,
+		constants.StatusPending, src.Balance, dest.Balance, src.Currency,
+	).Scan(&hold.ID, &hold.CreatedAt)
+	if err != nil {
+		r.log.Error("failed to create pending transfer", zap.Error(err))
+		return nil, systemError(err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO holds (hold_id, account_id, amount, status, expires_at) VALUES ($1, $2, $3, $4, $5)",
+		hold.ID, hold.SourceID, hold.Amount, hold.Status, hold.ExpiresAt)
+	if err != nil {
+		r.log.Error("failed to create hold", zap.Int64("hold_id", hold.ID), zap.Error(err))
+		return nil, systemError(err)
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE accounts SET held_balance = held_balance + $1 WHERE account_id = $2", hold.Amount, hold.SourceID)
+	if err != nil {
+		return nil, systemError(err)
+	}
+
+	return hold, nil
+}
+
+// Capture settles a hold for req.Amount (zero means all of it) and releases
+// the remainder. The PENDING transfer row is completed in place with the
+// captured amount and the balances seen at capture time.
+func (r *TransferRepository) Capture(ctx context.Context, req *models.CaptureRequest, now time.Time) (*models.TransferResult, error) {
+	var (
+		result  *models.TransferResult
+		expired bool
+	)
+	err := r.runTx(ctx, "capture", func(tx *sql.Tx) error {
+		var err error
+		result, expired, err = r.captureTx(ctx, tx, req, now)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if expired {
+		return nil, constants.ErrHoldExpired
+	}
+	return result, nil
+}
+
+// captureTx reports expired instead of failing when the hold has expired, so
+// that the release it performs is committed.
+func (r *TransferRepository) captureTx(ctx context.Context, tx *sql.Tx, req *models.CaptureRequest, now time.Time) (*models.TransferResult, bool, error) {
+	hold, err := r.lockHold(ctx, tx, req.HoldID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := hold.CheckCapturable(now); err != nil {
+		if !errors.Is(err, constants.ErrHoldExpired) {
+			return nil, false, err
+		}
+		// Release the funds now rather than waiting for the sweeper.
+		if err := r.release(ctx, tx, hold, constants.HoldExpired); err != nil {
+			return nil, false, err
+		}
+		return nil, true, nil
+	}
+
+	amount, err := hold.CaptureAmount(req.Amount)
+	if err != nil {
+		return nil, false, err
+	}
+
+	src, dest, err := lockAccounts(ctx, tx, hold.SourceID, hold.DestinationID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := src.CheckDebit(); err != nil {
+		return nil, false, err
+	}
+
+	if err := dest.CheckCredit(); err != nil {
+		return nil, false, err
+	}
+
+	srcPost := src.Balance.Sub(amount)
+	destPost := dest.Balance.Add(amount)
+
+	_, err = tx.ExecContext(ctx, "UPDATE accounts SET balance = $1, held_balance = held_balance - $2 WHERE account_id = $3",
+		srcPost, hold.Amount, hold.SourceID)
+	if err != nil {
+		return nil, false, systemError(err)
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE accounts SET balance = $1 WHERE account_id = $2", destPost, hold.DestinationID)
+	if err != nil {
+		return nil, false, systemError(err)
+	}
+
+	journal := models.NewTransferJournal(hold.ID, hold.SourceID, hold.DestinationID, amount, hold.Currency)
+	if err := r.ledger.Post(ctx, tx, journal); err != nil {
+		return nil, false, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+        UPDATE transfers SET
+            status = $1,
+            amount = $2,
+            destination_amount = $2,
+            source_prev_balance = $3,
+            source_post_balance = $4,
+            destination_prev_balance = $5,
+            destination_post_balance = $6,
+            posting_seq = nextval('transfer_posting_seq'),
+            posted_at = clock_timestamp()
+        WHERE transfer_id = $7`,
+		constants.StatusCompleted, amount, src.Balance, srcPost, dest.Balance, destPost, hold.ID,
+	)
+	if err != nil {
+		r.log.Error("failed to complete captured transfer", zap.Int64("hold_id", hold.ID), zap.Error(err))
+		return nil, false, systemError(err)
+	}
+
+	err = insertEvent(ctx, tx, models.NewTransferCompletedEvent(&models.Transfer{
+		ID:                     hold.ID,
+		CorrelationID:          hold.CorrelationID,
+		SourceID:               hold.SourceID,
+		DestinationID:          hold.DestinationID,
+		Amount:                 amount,
+		Currency:               hold.Currency,
+		DestinationAmount:      amount,
+		DestinationCurrency:    hold.Currency,
+		SourcePostBalance:      srcPost,
+		DestinationPostBalance: destPost,
+	}))
+	if err != nil {
+		r.log.Error("failed to write transfer event", zap.Int64("hold_id", hold.ID), zap.Error(err))
+		return nil, false, systemError(err)
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE holds SET status = $1, captured_amount = $2, updated_at = now() WHERE hold_id = $3",
+		constants.HoldCaptured, amount, hold.ID)
+	if err != nil {
+		return nil, false, systemError(err)
+	}
+
+	return &models.TransferResult{
+		AuditID:             hold.ID,
+		CorrelationID:       hold.CorrelationID,
+		Status:              "SUCCESS",
+		SourceID:            hold.SourceID,
+		DestinationID:       hold.DestinationID,
+		SourcePostBalance:   srcPost.String(),
+		SourceAmount:        amount.String(),
+		SourceCurrency:      hold.Currency,
+		DestinationAmount:   amount.String(),
+		DestinationCurrency: hold.Currency,
+		CreatedAt:           hold.CreatedAt,
+	}, false, nil
+}
+
+// Void cancels an active hold and gives the reserved funds back.
+func (r *TransferRepository) Void(ctx context.Context, id int64) (*models.Hold, error) {
+	var hold *models.Hold
+	err := r.runTx(ctx, "void", func(tx *sql.Tx) error {
+		var err error
+		if hold, err = r.lockHold(ctx, tx, id); err != nil {
+			return err
+		}
+		if hold.Status != constants.HoldActive {
+			return constants.ErrHoldNotActive
+		}
+		return r.release(ctx, tx, hold, constants.HoldVoided)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hold, nil
+}
+
+// ExpireHolds releases every active hold whose expiry is not after now, each
+// in its own transaction so one failure does not hold back the rest, and
+// returns the holds it released.
+func (r *TransferRepository) ExpireHolds(ctx context.Context, now time.Time) ([]models.Hold, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT hold_id FROM holds WHERE status = $1 AND expires_at <= $2 ORDER BY expires_at",
+		constants.HoldActive, now)
+	if err != nil {
+		r.log.Error("Failed to query expired holds", zap.Error(err))
+		return nil, fmt.Errorf("list expired holds failed: %w", err)
+	}
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("list expired holds failed: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list expired holds failed: %w", err)
+	}
+
+	var expired []models.Hold
+	for _, id := range ids {
+		hold, err := r.expireHold(ctx, id, now)
+		if err != nil {
+			r.log.Error("Failed to expire hold", zap.Int64("hold_id", id), zap.Error(err))
+			continue
+		}
+		if hold != nil {
+			expired = append(expired, *hold)
+		}
+	}
+
+	return expired, nil
+}
+
+// expireHold re-checks the hold under lock, since it may have been captured
+// or voided after it was listed. It returns nil if the hold was not expired.
+func (r *TransferRepository) expireHold(ctx context.Context, id int64, now time.Time) (*models.Hold, error) {
+	var expired *models.Hold
+	err := r.runTx(ctx, "expire", func(tx *sql.Tx) error {
+		expired = nil
+		hold, err := r.lockHold(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if !errors.Is(hold.CheckCapturable(now), constants.ErrHoldExpired) {
+			return nil
+		}
+		if err := r.release(ctx, tx, hold, constants.HoldExpired); err != nil {
+			return err
+		}
+		expired = hold
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return expired, nil
+}
+
+func (r *TransferRepository) lockHold(ctx context.Context, tx *sql.Tx, id int64) (*models.Hold, error) {
+	var (
+		hold     = models.Hold{ID: id}
+		captured decimal.NullDecimal
+	)
+
+	err := tx.QueryRowContext(ctx, `
+        SELECT t.correlation_id, t.source_account_id, t.destination_account_id, h.amount, h.captured_amount,
+               t.currency, h.status, h.expires_at, h.created_at
+        FROM holds h JOIN transfers t ON t.transfer_id = h.hold_id
+        WHERE h.hold_id = $1
+        FOR UPDATE OF h`, id,
+	).Scan(&hold.CorrelationID, &hold.SourceID, &hold.DestinationID, &hold.Amount, &captured,
+		&hold.Currency, &hold.Status, &hold.ExpiresAt, &hold.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, constants.ErrHoldNotFound
+	}
+	if err != nil {
+		r.log.Error("Failed to lock hold", zap.Int64("hold_id", id), zap.Error(err))
+		return nil, systemError(err)
+	}
+
+	hold.CapturedAmount = captured.Decimal
+	return &hold, nil
+}
+
+// release returns the held amount to the source account's available balance
+// and marks the hold, and its PENDING transfer, as finished without payment.
+func (r *TransferRepository) release(ctx context.Context, tx *sql.Tx, hold *models.Hold, to constants.HoldStatus) error {
+	_, err := tx.ExecContext(ctx, "UPDATE accounts SET held_balance = held_balance - $1 WHERE account_id = $2", hold.Amount, hold.SourceID)
+	if err != nil {
+		return systemError(err)
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE holds SET status = $1, updated_at = now() WHERE hold_id = $2", to, hold.ID)
+	if err != nil {
+		return systemError(err)
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE transfers SET status = $1 WHERE transfer_id = $2", constants.StatusFailed, hold.ID)
+	if err != nil {
+		return systemError(err)
+	}
+
+	hold.Status = to
+	return nil
+}
@@ -0,0 +1,284 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+const lockHoldQuery = `SELECT t.correlation_id, t.source_account_id, t.destination_account_id, h.amount, h.captured_amount, t.currency, h.status, h.expires_at, h.created_at FROM holds h JOIN transfers t`
+
+func lockedAccount(id int64, balance, held decimal.Decimal) models.Account {
+	return models.Account{ID: id, Balance: balance, HeldBalance: held, Currency: "USD", Status: constants.AccountActive}
+}
+
+func holdRow(status constants.HoldStatus, expiresAt time.Time) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"correlation_id", "source_account_id", "destination_account_id", "amount", "captured_amount",
+		"currency", "status", "expires_at", "created_at",
+	}).AddRow(42, 100, 200, decimal.NewFromFloat(80), nil, "USD", status, expiresAt, time.Now())
+}
+
+func TestTransferRepository_Authorize(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+	req := &models.AuthorizeRequest{
+		TransferRequest: models.TransferRequest{SourceID: 100, DestinationID: 200, Amount: decimal.NewFromFloat(80)},
+		ExpiresAt:       expiresAt,
+	}
+	correlationID := int64(987654321)
+
+	t.Run("Success: Hold Placed Without Moving Balance", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		ctx := context.WithValue(context.Background(), constants.CorrelationKey, correlationID)
+
+		mock.ExpectBegin()
+		expectLockAccounts(mock,
+			lockedAccount(req.SourceID, decimal.NewFromFloat(100), decimal.NewFromFloat(20)),
+			lockedAccount(req.DestinationID, decimal.NewFromFloat(10), decimal.Zero))
+		expectNoLimits(mock, req.SourceID)
+
+		mock.ExpectQuery(`INSERT INTO transfers`).
+			WithArgs(req.SourceID, req.DestinationID, req.Amount, correlationID, constants.StatusPending,
+				decimal.NewFromFloat(100), decimal.NewFromFloat(10), "USD").
+			WillReturnRows(sqlmock.NewRows([]string{"transfer_id", "created_at"}).AddRow(7, time.Now()))
+
+		mock.ExpectExec(`INSERT INTO holds \(hold_id, account_id, amount, status, expires_at\)`).
+			WithArgs(int64(7), req.SourceID, req.Amount, constants.HoldActive, expiresAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		mock.ExpectExec(`UPDATE accounts SET held_balance = held_balance \+ \$1 WHERE account_id = \$2`).
+			WithArgs(req.Amount, req.SourceID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		mock.ExpectCommit()
+
+		hold, err := repo.Authorize(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), hold.ID)
+		assert.Equal(t, constants.HoldActive, hold.Status)
+		assert.Equal(t, "USD", hold.Currency)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Existing Holds Leave Too Little Available", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		expectLockAccounts(mock,
+			lockedAccount(req.SourceID, decimal.NewFromFloat(100), decimal.NewFromFloat(30)),
+			lockedAccount(req.DestinationID, decimal.NewFromFloat(10), decimal.Zero))
+		mock.ExpectRollback()
+
+		_, err := repo.Authorize(context.Background(), req)
+
+		assert.Equal(t, constants.ErrInsufficientFunds, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestTransferRepository_Capture(t *testing.T) {
+	now := time.Now()
+
+	t.Run("Success: Partial Capture Releases Remainder", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		captured := decimal.NewFromFloat(50)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockHoldQuery).WithArgs(int64(7)).
+			WillReturnRows(holdRow(constants.HoldActive, now.Add(time.Hour)))
+		expectLockAccounts(mock,
+			lockedAccount(int64(100), decimal.NewFromFloat(100), decimal.NewFromFloat(80)),
+			lockedAccount(int64(200), decimal.NewFromFloat(10), decimal.Zero))
+
+		mock.ExpectExec(`UPDATE accounts SET balance = \$1, held_balance = held_balance - \$2 WHERE account_id = \$3`).
+			WithArgs(decimal.NewFromFloat(50), decimal.NewFromFloat(80), int64(100)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE accounts SET balance = \$1 WHERE account_id = \$2`).
+			WithArgs(decimal.NewFromFloat(60), int64(200)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		mock.ExpectExec(`INSERT INTO ledger_entries`).
+			WithArgs(int64(7), int64(100), captured.Neg(), "USD", int64(7), int64(200), captured, "USD").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		mock.ExpectExec(`UPDATE transfers SET status = \$1, amount = \$2`).
+			WithArgs(constants.StatusCompleted, captured,
+				decimal.NewFromFloat(100), decimal.NewFromFloat(50),
+				decimal.NewFromFloat(10), decimal.NewFromFloat(60), int64(7)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		expectEvent(mock, int64(100), models.EventTransferCompleted)
+
+		mock.ExpectExec(`UPDATE holds SET status = \$1, captured_amount = \$2`).
+			WithArgs(constants.HoldCaptured, captured, int64(7)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		mock.ExpectCommit()
+
+		result, err := repo.Capture(context.Background(), &models.CaptureRequest{HoldID: 7, Amount: captured}, now)
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), result.AuditID)
+		assert.Equal(t, int64(42), result.CorrelationID)
+		assert.Equal(t, "50", result.SourceAmount)
+		assert.Equal(t, "50", result.SourcePostBalance)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Capture Exceeds Hold", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockHoldQuery).WithArgs(int64(7)).
+			WillReturnRows(holdRow(constants.HoldActive, now.Add(time.Hour)))
+		mock.ExpectRollback()
+
+		_, err := repo.Capture(context.Background(), &models.CaptureRequest{HoldID: 7, Amount: decimal.NewFromFloat(81)}, now)
+
+		assert.Equal(t, constants.ErrCaptureExceedsHold, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Expired Hold Is Released", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockHoldQuery).WithArgs(int64(7)).
+			WillReturnRows(holdRow(constants.HoldActive, now.Add(-time.Minute)))
+		mock.ExpectExec(`UPDATE accounts SET held_balance = held_balance - \$1 WHERE account_id = \$2`).
+			WithArgs(decimal.NewFromFloat(80), int64(100)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE holds SET status = \$1`).
+			WithArgs(constants.HoldExpired, int64(7)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE transfers SET status = \$1 WHERE transfer_id = \$2`).
+			WithArgs(constants.StatusFailed, int64(7)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		_, err := repo.Capture(context.Background(), &models.CaptureRequest{HoldID: 7}, now)
+
+		assert.Equal(t, constants.ErrHoldExpired, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Hold Already Captured", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockHoldQuery).WithArgs(int64(7)).
+			WillReturnRows(holdRow(constants.HoldCaptured, now.Add(time.Hour)))
+		mock.ExpectRollback()
+
+		_, err := repo.Capture(context.Background(), &models.CaptureRequest{HoldID: 7}, now)
+
+		assert.Equal(t, constants.ErrHoldNotActive, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Hold Not Found", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockHoldQuery).WithArgs(int64(9)).WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		_, err := repo.Capture(context.Background(), &models.CaptureRequest{HoldID: 9}, now)
+
+		assert.Equal(t, constants.ErrHoldNotFound, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestTransferRepository_Void(t *testing.T) {
+	t.Run("Success: Funds Released", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockHoldQuery).WithArgs(int64(7)).
+			WillReturnRows(holdRow(constants.HoldActive, time.Now().Add(time.Hour)))
+		mock.ExpectExec(`UPDATE accounts SET held_balance = held_balance - \$1 WHERE account_id = \$2`).
+			WithArgs(decimal.NewFromFloat(80), int64(100)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE holds SET status = \$1`).
+			WithArgs(constants.HoldVoided, int64(7)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE transfers SET status = \$1 WHERE transfer_id = \$2`).
+			WithArgs(constants.StatusFailed, int64(7)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		hold, err := repo.Void(context.Background(), 7)
+		require.NoError(t, err)
+		assert.Equal(t, constants.HoldVoided, hold.Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Hold Already Voided", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockHoldQuery).WithArgs(int64(7)).
+			WillReturnRows(holdRow(constants.HoldVoided, time.Now().Add(time.Hour)))
+		mock.ExpectRollback()
+
+		_, err := repo.Void(context.Background(), 7)
+
+		assert.Equal(t, constants.ErrHoldNotActive, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestTransferRepository_ExpireHolds(t *testing.T) {
+	t.Run("Success: Skips Holds Settled Since Listing", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		now := time.Now()
+
+		mock.ExpectQuery(`SELECT hold_id FROM holds WHERE status = \$1 AND expires_at <= \$2`).
+			WithArgs(constants.HoldActive, now).
+			WillReturnRows(sqlmock.NewRows([]string{"hold_id"}).AddRow(7).AddRow(8))
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockHoldQuery).WithArgs(int64(7)).
+			WillReturnRows(holdRow(constants.HoldActive, now.Add(-time.Minute)))
+		mock.ExpectExec(`UPDATE accounts SET held_balance`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE holds SET status = \$1`).
+			WithArgs(constants.HoldExpired, int64(7)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE transfers SET status = \$1`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockHoldQuery).WithArgs(int64(8)).
+			WillReturnRows(holdRow(constants.HoldCaptured, now.Add(-time.Minute)))
+		mock.ExpectCommit()
+
+		expired, err := repo.ExpireHolds(context.Background(), now)
+		require.NoError(t, err)
+		require.Len(t, expired, 1)
+		assert.Equal(t, int64(7), expired[0].ID)
+		assert.Equal(t, constants.HoldExpired, expired[0].Status)
+		assert.Equal(t, int64(100), expired[0].SourceID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -2,20 +2,90 @@ package repository
 
 import (
 	"context"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
 	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"github.com/shopspring/decimal"
 )
 
 type AccountRepo interface {
 	CreateAccount(ctx context.Context, acc *models.Account) error
 	GetAll(ctx context.Context) ([]models.Account, error)
 	GetAccount(ctx context.Context, id int64) (*models.Account, error)
+	UpdateStatus(ctx context.Context, id int64, to constants.AccountStatus) (*models.Account, error)
+	UpdateOverdraftLimit(ctx context.Context, id int64, limit decimal.Decimal) (*models.Account, error)
+	GetBalanceAt(ctx context.Context, id int64, at time.Time) (*models.BalanceAt, error)
+	GetStatement(ctx context.Context, q models.StatementQuery) (*models.Statement, error)
+	SetLimits(ctx context.Context, id int64, limits models.TransferLimits) (*models.AccountLimits, error)
+	GetLimits(ctx context.Context, id int64) (*models.AccountLimits, error)
+	ClearLimits(ctx context.Context, id int64) (*models.AccountLimits, error)
 }
 
 type Cache interface {
 	Exists(ctx context.Context, id int64) (bool, error)
+	GetAccount(ctx context.Context, id int64) (*models.Account, error)
 	SetAccount(ctx context.Context, acc *models.Account) error
+	SetMissing(ctx context.Context, id int64) error
 }
 
 type TransferRepo interface {
 	Transfer(ctx context.Context, req *models.TransferRequest) (*models.TransferResult, error)
+	GetTransfer(ctx context.Context, id int64) (*models.Transfer, error)
+	ListTransfers(ctx context.Context, q models.TransferQuery) (*models.TransferPage, error)
+	Authorize(ctx context.Context, req *models.AuthorizeRequest) (*models.Hold, error)
+	Capture(ctx context.Context, req *models.CaptureRequest, now time.Time) (*models.TransferResult, error)
+	Void(ctx context.Context, id int64) (*models.Hold, error)
+	ExpireHolds(ctx context.Context, now time.Time) ([]models.Hold, error)
+	Reverse(ctx context.Context, req *models.ReversalRequest) (*models.Reversal, error)
+	TransferBatch(ctx context.Context, batch *models.BatchTransferRequest) error
+	TransferMultiLeg(ctx context.Context, req *models.MultiLegTransferRequest) (*models.MultiLegResult, error)
+	WatchCursor(ctx context.Context, accountID, sinceTransferID int64) (models.TransferCursor, error)
+	ListPostedAfter(ctx context.Context, cursor models.TransferCursor, limit int) ([]models.Transfer, models.TransferCursor, error)
+	GetAccounts(ctx context.Context, ids []int64) ([]models.Account, error)
+}
+
+type ScheduleRepo interface {
+	Create(ctx context.Context, s *models.ScheduledTransfer) error
+	Cancel(ctx context.Context, id int64) (*models.ScheduledTransfer, error)
+	ListByAccount(ctx context.Context, accountID int64) ([]models.ScheduledTransfer, error)
+	Due(ctx context.Context, now time.Time, limit int) ([]models.ScheduledTransfer, error)
+	RecordRun(ctx context.Context, run *models.ScheduledRun, next time.Time) error
+	RetryLater(ctx context.Context, id int64, scheduledFor time.Time, attempts int) error
+	WithLeaderLock(ctx context.Context, fn func(ctx context.Context) error) (bool, error)
+}
+
+type WebhookRepo interface {
+	Create(ctx context.Context, w *models.WebhookSubscription) error
+	Get(ctx context.Context, id int64) (*models.WebhookSubscription, error)
+	List(ctx context.Context) ([]models.WebhookSubscription, error)
+	Delete(ctx context.Context, id int64) (*models.WebhookSubscription, error)
+	Due(ctx context.Context, now time.Time, limit int) ([]models.WebhookDelivery, error)
+	MarkDelivered(ctx context.Context, id int64, attempts int) error
+	RetryLater(ctx context.Context, id int64, attempts int, next time.Time, lastErr string) error
+	DeadLetter(ctx context.Context, id int64, attempts int, lastErr string) error
+	ListDeadLetters(ctx context.Context, subscriptionID int64) ([]models.WebhookDeadLetter, error)
+	Redeliver(ctx context.Context, deadLetterID int64) (int64, error)
+	WithLeaderLock(ctx context.Context, fn func(ctx context.Context) error) (bool, error)
+}
+
+type FxRateRepo interface {
+	LatestRate(ctx context.Context, base, quote string) (*models.FxRate, error)
+	SaveRates(ctx context.Context, rates []models.FxRate) error
+}
+
+type ReconcileRepo interface {
+	Snapshot(ctx context.Context, fn func(ReconcileSnapshot) error) error
+}
+
+type ReconcileSnapshot interface {
+	ListAccountBalances(ctx context.Context) ([]models.AccountBalance, error)
+	ForEachPostedTransfer(ctx context.Context, fn func(*models.Transfer) error) error
+}
+
+type OutboxRepo interface {
+	Pending(ctx context.Context, limit int) ([]models.Event, error)
+	MarkPublished(ctx context.Context, ids []int64) error
+	WithLeaderLock(ctx context.Context, fn func(ctx context.Context) error) (bool, error)
 }
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+type LedgerRepository struct {
+	db  *sql.DB
+	log *zap.Logger
+}
+
+func NewLedgerRepository(db *sql.DB, log *zap.Logger) *LedgerRepository {
+	return &LedgerRepository{db: db, log: log}
+}
+
+// Post writes every entry of the journal inside tx, so the legs commit or roll
+// back together with the balance updates they describe.
+func (r *LedgerRepository) Post(ctx context.Context, tx *sql.Tx, j models.Journal) error {
+	if err := j.Validate(); err != nil {
+		r.log.Error("refusing to post unbalanced journal", zap.Int64("transfer_id", j.TransferID))
+		return err
+	}
+
+	values := make([]string, 0, len(j.Entries))
+	args := make([]any, 0, len(j.Entries)*4)
+	for _, e := range j.Entries {
+		n := len(args)
+		values = append(values, fmt.Sprintf("($%d, $%d, $%d, $%d)", n+1, n+2, n+3, n+4))
+		args = append(args, e.TransferID, ledgerAccountID(e.AccountID), e.Amount, e.Currency)
+	}
+
+	query := `INSERT INTO ledger_entries (transfer_id, account_id, amount, currency) VALUES ` + strings.Join(values, ", ")
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		r.log.Error("failed to post ledger entries", zap.Int64("transfer_id", j.TransferID), zap.Error(err))
+		return systemError(err)
+	}
+
+	return nil
+}
+
+func (r *LedgerRepository) GetJournal(ctx context.Context, transferID int64) (*models.Journal, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT entry_id, transfer_id, COALESCE(account_id, 0), amount, currency, created_at
+        FROM ledger_entries WHERE transfer_id = $1 ORDER BY entry_id`, transferID)
+	if err != nil {
+		r.log.Error("Failed to query journal", zap.Int64("transfer_id", transferID), zap.Error(err))
+		return nil, fmt.Errorf("get journal failed: %w", err)
+	}
+	defer rows.Close()
+
+	j := &models.Journal{TransferID: transferID}
+	for rows.Next() {
+		var e models.LedgerEntry
+		if err := rows.Scan(&e.ID, &e.TransferID, &e.AccountID, &e.Amount, &e.Currency, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("get journal failed: %w", err)
+		}
+		j.Entries = append(j.Entries, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get journal failed: %w", err)
+	}
+
+	if len(j.Entries) == 0 {
+		return nil, constants.ErrTransferNotFound
+	}
+
+	return j, nil
+}
+
+// DerivedBalance recomputes an account's balance from its opening balance and
+// ledger entries, independently of accounts.balance.
+func (r *LedgerRepository) DerivedBalance(ctx context.Context, accountID int64) (decimal.Decimal, error) {
+	var balance decimal.Decimal
+	err := r.db.QueryRowContext(ctx, `
+        SELECT a.opening_balance + COALESCE(SUM(e.amount), 0)
+        FROM accounts a LEFT JOIN ledger_entries e ON e.account_id = a.account_id
+        WHERE a.account_id = $1
+        GROUP BY a.account_id, a.opening_balance`, accountID).Scan(&balance)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return decimal.Zero, constants.ErrAccountNotFound
+	}
+	if err != nil {
+		r.log.Error("Failed to derive balance", zap.Int64("account_id", accountID), zap.Error(err))
+		return decimal.Zero, fmt.Errorf("derive balance failed: %w", err)
+	}
+
+	return balance, nil
+}
+
+// ledgerAccountID stores FX position entries with a NULL account_id.
+func ledgerAccountID(id int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: id, Valid: id != models.FxPositionAccountID}
+}
@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+func setupLedgerTest(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *LedgerRepository) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	return db, mock, NewLedgerRepository(db, zap.NewNop())
+}
+
+func TestLedgerRepository_Post(t *testing.T) {
+	amount := decimal.NewFromFloat(25.5)
+
+	t.Run("Success: Both Legs Written In Transaction", func(t *testing.T) {
+		db, mock, repo := setupLedgerTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`INSERT INTO ledger_entries`).
+			WithArgs(int64(9), int64(100), amount.Neg(), "USD", int64(9), int64(200), amount, "USD").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		tx, err := db.Begin()
+		require.NoError(t, err)
+
+		err = repo.Post(context.Background(), tx, models.NewTransferJournal(9, 100, 200, amount, "USD"))
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: FX Legs Booked Through Position", func(t *testing.T) {
+		db, mock, repo := setupLedgerTest(t)
+		defer db.Close()
+
+		quote := &models.FxQuote{
+			RateID: 3, Rate: decimal.RequireFromString("0.9"),
+			SourceCurrency: "USD", DestinationCurrency: "EUR",
+			DestinationAmount: decimal.RequireFromString("22.95"),
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`INSERT INTO ledger_entries \(transfer_id, account_id, amount, currency\)`).
+			WithArgs(
+				int64(9), int64(100), amount.Neg(), "USD",
+				int64(9), nil, amount, "USD",
+				int64(9), nil, quote.DestinationAmount.Neg(), "EUR",
+				int64(9), int64(200), quote.DestinationAmount, "EUR",
+			).
+			WillReturnResult(sqlmock.NewResult(0, 4))
+		mock.ExpectCommit()
+
+		tx, err := db.Begin()
+		require.NoError(t, err)
+
+		err = repo.Post(context.Background(), tx, models.NewFxTransferJournal(9, 100, 200, quote, amount))
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Currencies Netted Against Each Other", func(t *testing.T) {
+		db, mock, repo := setupLedgerTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		tx, err := db.Begin()
+		require.NoError(t, err)
+
+		j := models.NewTransferJournal(9, 100, 200, amount, "USD")
+		j.Entries[1].Currency = "EUR"
+
+		err = repo.Post(context.Background(), tx, j)
+
+		assert.ErrorIs(t, err, constants.ErrUnbalancedJournal)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Unbalanced Journal Rejected Before Write", func(t *testing.T) {
+		db, mock, repo := setupLedgerTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		tx, err := db.Begin()
+		require.NoError(t, err)
+
+		j := models.NewTransferJournal(9, 100, 200, amount, "USD")
+		j.Entries[1].Amount = amount.Sub(decimal.NewFromFloat(0.01))
+
+		err = repo.Post(context.Background(), tx, j)
+
+		assert.ErrorIs(t, err, constants.ErrUnbalancedJournal)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Single Leg Rejected", func(t *testing.T) {
+		db, mock, repo := setupLedgerTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		tx, err := db.Begin()
+		require.NoError(t, err)
+
+		j := models.Journal{TransferID: 9, Entries: []models.LedgerEntry{{TransferID: 9, AccountID: 100}}}
+
+		err = repo.Post(context.Background(), tx, j)
+
+		assert.ErrorIs(t, err, constants.ErrUnbalancedJournal)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: DB Error", func(t *testing.T) {
+		db, mock, repo := setupLedgerTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`INSERT INTO ledger_entries`).WillReturnError(errors.New("connection died"))
+
+		tx, err := db.Begin()
+		require.NoError(t, err)
+
+		err = repo.Post(context.Background(), tx, models.NewTransferJournal(9, 100, 200, amount, "USD"))
+
+		assert.ErrorIs(t, err, constants.ErrSystem)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestLedgerRepository_GetJournal(t *testing.T) {
+	columns := []string{"entry_id", "transfer_id", "account_id", "amount", "currency", "created_at"}
+
+	t.Run("Success: Entries Returned", func(t *testing.T) {
+		db, mock, repo := setupLedgerTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM ledger_entries WHERE transfer_id = \$1`).
+			WithArgs(int64(9)).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(1, 9, 100, decimal.NewFromInt(-10), "USD", time.Now()).
+				AddRow(2, 9, 200, decimal.NewFromInt(10), "USD", time.Now()))
+
+		j, err := repo.GetJournal(context.Background(), 9)
+
+		require.NoError(t, err)
+		assert.Len(t, j.Entries, 2)
+		assert.NoError(t, j.Validate())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: No Entries", func(t *testing.T) {
+		db, mock, repo := setupLedgerTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM ledger_entries WHERE transfer_id = \$1`).
+			WithArgs(int64(9)).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		_, err := repo.GetJournal(context.Background(), 9)
+
+		assert.ErrorIs(t, err, constants.ErrTransferNotFound)
+	})
+}
+
+func TestLedgerRepository_DerivedBalance(t *testing.T) {
+	t.Run("Success: Opening Balance Plus Entries", func(t *testing.T) {
+		db, mock, repo := setupLedgerTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT a.opening_balance \+ COALESCE\(SUM\(e.amount\), 0\)`).
+			WithArgs(int64(100)).
+			WillReturnRows(sqlmock.NewRows([]string{"balance"}).AddRow(decimal.NewFromInt(450)))
+
+		balance, err := repo.DerivedBalance(context.Background(), 100)
+
+		require.NoError(t, err)
+		assert.True(t, decimal.NewFromInt(450).Equal(balance))
+	})
+
+	t.Run("Failure: Account Not Found", func(t *testing.T) {
+		db, mock, repo := setupLedgerTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT a.opening_balance`).
+			WithArgs(int64(100)).
+			WillReturnRows(sqlmock.NewRows([]string{"balance"}))
+
+		_, err := repo.DerivedBalance(context.Background(), 100)
+
+		assert.ErrorIs(t, err, constants.ErrAccountNotFound)
+	})
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// checkLimits enforces the transfer limits of src on a transfer of amount out
+// of it. The account must already be locked, so concurrent transfers from it
+// are counted one after the other.
+func (r *TransferRepository) checkLimits(ctx context.Context, tx *sql.Tx, src *models.Account, amount decimal.Decimal) error {
+	var override models.TransferLimits
+	err := tx.QueryRowContext(ctx, `
+        SELECT max_single, daily_amount, COALESCE(daily_count, 0), monthly_amount, COALESCE(monthly_count, 0)
+        FROM account_limits WHERE account_id = $1`, src.ID,
+	).Scan(&override.MaxSingle, &override.DailyAmount, &override.DailyCount, &override.MonthlyAmount, &override.MonthlyCount)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return systemError(err)
+	}
+
+	limits := r.limits.For(src.Currency).Override(override)
+	var used models.TransferUsage
+	if limits.Periodic() {
+		if used, err = transferUsage(ctx, tx, src.ID, time.Now().UTC()); err != nil {
+			return err
+		}
+	}
+	return limits.Check(amount, used)
+}
+
+// transferUsage totals the transfers out of accountID in the UTC day and month
+// containing now. Failed transfers, reversals and fees do not count; pending
+// holds do.
+func transferUsage(ctx context.Context, tx *sql.Tx, accountID int64, now time.Time) (models.TransferUsage, error) {
+	dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	var used models.TransferUsage
+	err := tx.QueryRowContext(ctx, `
+        SELECT COALESCE(SUM(amount) FILTER (WHERE created_at >= $2), 0), COUNT(*) FILTER (WHERE created_at >= $2),
+               COALESCE(SUM(amount), 0), COUNT(*)
+        FROM transfers
+        WHERE source_account_id = $1 AND created_at >= $3 AND status <> $4
+          AND reversal_of IS NULL AND fee_of IS NULL`,
+		accountID, dayStart, monthStart, constants.StatusFailed,
+	).Scan(&used.DailyAmount, &used.DailyCount, &used.MonthlyAmount, &used.MonthlyCount)
+	if err != nil {
+		return models.TransferUsage{}, systemError(err)
+	}
+	return used, nil
+}
+
+// SetLimits replaces the limits set for account id with limits, which must
+// suit its currency. Closed accounts take no new limits.
+func (r *AccountRepository) SetLimits(ctx context.Context, id int64, limits models.TransferLimits) (*models.AccountLimits, error) {
+	acc, err := r.GetAccount(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if acc.Status == constants.AccountClosed {
+		return nil, constants.ErrAccountClosed
+	}
+	if err := limits.Validate(acc.Currency); err != nil {
+		return nil, err
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+        INSERT INTO account_limits (account_id, max_single, daily_amount, daily_count, monthly_amount, monthly_count, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, NOW())
+        ON CONFLICT (account_id) DO UPDATE SET
+            max_single = EXCLUDED.max_single, daily_amount = EXCLUDED.daily_amount, daily_count = EXCLUDED.daily_count,
+            monthly_amount = EXCLUDED.monthly_amount, monthly_count = EXCLUDED.monthly_count, updated_at = EXCLUDED.updated_at`,
+		id, limits.MaxSingle, limits.DailyAmount, nullableCount(limits.DailyCount), limits.MonthlyAmount, nullableCount(limits.MonthlyCount))
+	if err != nil {
+		r.log.Error("Failed to set account limits", zap.Int64("account_id", id), zap.Error(err))
+		return nil, constants.ErrSystem
+	}
+
+	return &models.AccountLimits{AccountID: id, Limits: limits}, nil
+}
+
+// GetLimits returns the limits set for account id, none if it has no row in
+// account_limits.
+func (r *AccountRepository) GetLimits(ctx context.Context, id int64) (*models.AccountLimits, error) {
+	limits := models.AccountLimits{AccountID: id}
+	err := r.db.QueryRowContext(ctx, `
+        SELECT l.max_single, l.daily_amount, COALESCE(l.daily_count, 0), l.monthly_amount, COALESCE(l.monthly_count, 0)
+        FROM accounts a LEFT JOIN account_limits l ON l.account_id = a.account_id
+        WHERE a.account_id = $1`, id,
+	).Scan(&limits.Limits.MaxSingle, &limits.Limits.DailyAmount, &limits.Limits.DailyCount,
+		&limits.Limits.MonthlyAmount, &limits.Limits.MonthlyCount)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, constants.ErrAccountNotFound
+		}
+		r.log.Error("Failed to get account limits", zap.Int64("account_id", id), zap.Error(err))
+		return nil, constants.ErrSystem
+	}
+	return &limits, nil
+}
+
+// ClearLimits removes the limits set for account id, leaving it on the
+// defaults, and returns what is left: none.
+func (r *AccountRepository) ClearLimits(ctx context.Context, id int64) (*models.AccountLimits, error) {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM account_limits WHERE account_id = $1`, id); err != nil {
+		r.log.Error("Failed to clear account limits", zap.Int64("account_id", id), zap.Error(err))
+		return nil, constants.ErrSystem
+	}
+	return r.GetLimits(ctx, id)
+}
+
+// nullableCount stores an unset count as NULL, which check_limits_positive allows.
+func nullableCount(n int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: n, Valid: n > 0}
+}
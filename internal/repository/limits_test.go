@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+func TestTransferRepository_Transfer_Limits(t *testing.T) {
+	const (
+		limitsQuery = `SELECT max_single, daily_amount, COALESCE\(daily_count, 0\), monthly_amount, COALESCE\(monthly_count, 0\)\s+FROM account_limits WHERE account_id = \$1`
+		usageQuery  = `FROM transfers\s+WHERE source_account_id = \$1 AND created_at >= \$3 AND status <> \$4\s+AND reversal_of IS NULL AND fee_of IS NULL`
+	)
+	limitColumns := []string{"max_single", "daily_amount", "daily_count", "monthly_amount", "monthly_count"}
+	usageColumns := []string{"daily_amount", "daily_count", "monthly_amount", "monthly_count"}
+
+	req := &models.TransferRequest{SourceID: 1, DestinationID: 2, Amount: decimal.NewFromInt(50)}
+	defaults, err := models.ParseLimitDefaults("", "USD:200,JPY:30000", "", "", "30")
+	require.NoError(t, err)
+
+	setup := func(t *testing.T, limits models.LimitDefaults) (sqlmock.Sqlmock, *TransferRepository) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = db.Close() })
+		return mock, NewTransferRepository(db, DefaultTxPolicy(), limits, zap.NewNop())
+	}
+	expectLock := func(mock sqlmock.Sqlmock) {
+		expectLockAccounts(mock,
+			models.Account{ID: 1, Balance: decimal.NewFromInt(1000), Currency: "USD", Status: constants.AccountActive},
+			models.Account{ID: 2, Balance: decimal.Zero, Currency: "USD", Status: constants.AccountActive})
+	}
+
+	t.Run("Success: Within Default Limits", func(t *testing.T) {
+		mock, repo := setup(t, defaults)
+
+		mock.ExpectBegin()
+		expectLock(mock)
+		mock.ExpectQuery(limitsQuery).WithArgs(int64(1)).WillReturnRows(sqlmock.NewRows(limitColumns))
+		mock.ExpectQuery(usageQuery).
+			WithArgs(int64(1), sqlmock.AnyArg(), sqlmock.AnyArg(), constants.StatusFailed).
+			WillReturnRows(sqlmock.NewRows(usageColumns).AddRow(decimal.NewFromInt(150), 3, decimal.NewFromInt(900), 29))
+		expectPostedLeg(mock, 10, *req, 1000, 0)
+		mock.ExpectCommit()
+
+		_, err := repo.Transfer(context.Background(), req)
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Daily Amount Exceeded", func(t *testing.T) {
+		mock, repo := setup(t, defaults)
+
+		mock.ExpectBegin()
+		expectLock(mock)
+		mock.ExpectQuery(limitsQuery).WithArgs(int64(1)).WillReturnRows(sqlmock.NewRows(limitColumns))
+		mock.ExpectQuery(usageQuery).
+			WithArgs(int64(1), sqlmock.AnyArg(), sqlmock.AnyArg(), constants.StatusFailed).
+			WillReturnRows(sqlmock.NewRows(usageColumns).AddRow(decimal.NewFromInt(170), 4, decimal.NewFromInt(170), 4))
+		mock.ExpectRollback()
+
+		_, err := repo.Transfer(context.Background(), req)
+		assert.ErrorIs(t, err, constants.ErrLimitExceeded)
+		var limitErr *models.LimitError
+		require.ErrorAs(t, err, &limitErr)
+		assert.Equal(t, models.LimitDailyAmount, limitErr.Limit)
+		assert.Equal(t, "30", limitErr.Remaining.String())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Defaults Of Other Currencies Not Applied", func(t *testing.T) {
+		jpyOnly, err := models.ParseLimitDefaults("JPY:10", "", "", "", "")
+		require.NoError(t, err)
+		mock, repo := setup(t, jpyOnly)
+
+		mock.ExpectBegin()
+		expectLock(mock)
+		mock.ExpectQuery(limitsQuery).WithArgs(int64(1)).WillReturnRows(sqlmock.NewRows(limitColumns))
+		expectPostedLeg(mock, 10, *req, 1000, 0)
+		mock.ExpectCommit()
+
+		_, err = repo.Transfer(context.Background(), req)
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Account Override Caps Single Transfer", func(t *testing.T) {
+		mock, repo := setup(t, models.LimitDefaults{})
+
+		mock.ExpectBegin()
+		expectLock(mock)
+		mock.ExpectQuery(limitsQuery).WithArgs(int64(1)).
+			WillReturnRows(sqlmock.NewRows(limitColumns).AddRow(decimal.NewFromInt(25), nil, 0, nil, 0))
+		mock.ExpectRollback()
+
+		_, err := repo.Transfer(context.Background(), req)
+		var limitErr *models.LimitError
+		require.ErrorAs(t, err, &limitErr)
+		assert.Equal(t, models.LimitMaxSingle, limitErr.Limit)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Best Effort Batch Skips Leg Over Limit", func(t *testing.T) {
+		mock, repo := setup(t, models.LimitDefaults{})
+
+		batch := &models.BatchTransferRequest{
+			Legs:       []models.BatchLeg{batchLeg(1, 2, 10), batchLeg(1, 3, 10)},
+			BestEffort: true,
+		}
+
+		mock.ExpectBegin()
+		expectLockThree(mock, 100, 0, 0)
+		mock.ExpectQuery(limitsQuery).WithArgs(int64(1)).
+			WillReturnRows(sqlmock.NewRows(limitColumns).AddRow(nil, nil, 1, nil, 0))
+		mock.ExpectQuery(usageQuery).
+			WillReturnRows(sqlmock.NewRows(usageColumns).AddRow(decimal.Zero, 0, decimal.Zero, 0))
+		expectPostedLeg(mock, 10, batch.Legs[0].TransferRequest, 100, 0)
+		mock.ExpectQuery(limitsQuery).WithArgs(int64(1)).
+			WillReturnRows(sqlmock.NewRows(limitColumns).AddRow(nil, nil, 1, nil, 0))
+		mock.ExpectQuery(usageQuery).
+			WillReturnRows(sqlmock.NewRows(usageColumns).AddRow(decimal.NewFromInt(10), 1, decimal.NewFromInt(10), 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, repo.TransferBatch(context.Background(), batch))
+		assert.NotNil(t, batch.Legs[0].Result)
+		assert.ErrorIs(t, batch.Legs[1].Err, constants.ErrLimitExceeded)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAccountRepository_Limits(t *testing.T) {
+	accountID := int64(101)
+	const (
+		getAccountQuery = `SELECT account_id, .*, version FROM accounts WHERE account_id = \$1`
+		upsertQuery     = `INSERT INTO account_limits .* ON CONFLICT \(account_id\) DO UPDATE SET`
+		getLimitsQuery  = `FROM accounts a LEFT JOIN account_limits l ON l.account_id = a.account_id\s+WHERE a.account_id = \$1`
+	)
+	limitColumns := []string{"max_single", "daily_amount", "daily_count", "monthly_amount", "monthly_count"}
+	accountRow := func(currency string, status constants.AccountStatus) *sqlmock.Rows {
+		return accountRecordRows().
+			AddRow(accountID, decimal.NewFromInt(100), decimal.Zero, currency, status, "standard", decimal.Zero, int64(1))
+	}
+
+	t.Run("Success: Limits Set", func(t *testing.T) {
+		db, mock, repo := setupTest(t)
+		defer db.Close()
+
+		limits := models.TransferLimits{DailyAmount: decimal.NewNullDecimal(decimal.NewFromInt(5000)), DailyCount: 20}
+
+		mock.ExpectQuery(getAccountQuery).WithArgs(accountID).WillReturnRows(accountRow("USD", constants.AccountActive))
+		mock.ExpectExec(upsertQuery).
+			WithArgs(accountID, limits.MaxSingle, limits.DailyAmount, int64(20), limits.MonthlyAmount, nil).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		set, err := repo.SetLimits(context.Background(), accountID, limits)
+
+		require.NoError(t, err)
+		assert.Equal(t, &models.AccountLimits{AccountID: accountID, Limits: limits}, set)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Amount Exceeds Currency Scale", func(t *testing.T) {
+		db, mock, repo := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(getAccountQuery).WithArgs(accountID).WillReturnRows(accountRow("JPY", constants.AccountActive))
+
+		_, err := repo.SetLimits(context.Background(), accountID,
+			models.TransferLimits{MaxSingle: decimal.NewNullDecimal(decimal.RequireFromString("10.5"))})
+
+		assert.ErrorIs(t, err, constants.ErrInvalidAmountScale)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Account Closed", func(t *testing.T) {
+		db, mock, repo := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(getAccountQuery).WithArgs(accountID).WillReturnRows(accountRow("USD", constants.AccountClosed))
+
+		_, err := repo.SetLimits(context.Background(), accountID, models.TransferLimits{DailyCount: 5})
+
+		assert.ErrorIs(t, err, constants.ErrAccountClosed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Limits Read", func(t *testing.T) {
+		db, mock, repo := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(getLimitsQuery).WithArgs(accountID).
+			WillReturnRows(sqlmock.NewRows(limitColumns).AddRow(decimal.NewFromInt(250), nil, 0, nil, 40))
+
+		limits, err := repo.GetLimits(context.Background(), accountID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "250", limits.Limits.MaxSingle.Decimal.String())
+		assert.False(t, limits.Limits.DailyAmount.Valid)
+		assert.Equal(t, int64(40), limits.Limits.MonthlyCount)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Account Not Found", func(t *testing.T) {
+		db, mock, repo := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(getLimitsQuery).WithArgs(accountID).WillReturnRows(sqlmock.NewRows(limitColumns))
+
+		_, err := repo.GetLimits(context.Background(), accountID)
+
+		assert.ErrorIs(t, err, constants.ErrAccountNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Limits Cleared", func(t *testing.T) {
+		db, mock, repo := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectExec(`DELETE FROM account_limits WHERE account_id = \$1`).WithArgs(accountID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(getLimitsQuery).WithArgs(accountID).
+			WillReturnRows(sqlmock.NewRows(limitColumns).AddRow(nil, nil, 0, nil, 0))
+
+		limits, err := repo.ClearLimits(context.Background(), accountID)
+
+		require.NoError(t, err)
+		assert.Equal(t, &models.AccountLimits{AccountID: accountID}, limits)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+// TransferMultiLeg posts a multi-leg transfer as the pairwise transfers of
+// req.Split, all carrying one group ID drawn from transfer_group_seq. Every
+// account is locked up front in account_id order and the group commits or
+// rolls back as a whole.
+func (r *TransferRepository) TransferMultiLeg(ctx context.Context, req *models.MultiLegTransferRequest) (*models.MultiLegResult, error) {
+	var result *models.MultiLegResult
+	err := r.runTx(ctx, "multi_leg", func(tx *sql.Tx) error {
+		var err error
+		result, err = r.transferMultiLegTx(ctx, tx, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *TransferRepository) transferMultiLegTx(ctx context.Context, tx *sql.Tx, req *models.MultiLegTransferRequest) (*models.MultiLegResult, error) {
+	ids := req.AccountIDs()
+	accounts, err := lockAccountSet(ctx, tx, ids)
+	if err != nil {
+		return nil, err
+	}
+	// Validate guarantees the IDs are distinct.
+	if len(accounts) != len(ids) {
+		return nil, constants.ErrAccountNotFound
+	}
+
+	// Limits apply to each debit as a whole, not to the pairs it is split into.
+	for _, debit := range req.Debits {
+		if err := r.checkLimits(ctx, tx, accounts[debit.AccountID], debit.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &models.MultiLegResult{}
+	if err := tx.QueryRowContext(ctx, `SELECT nextval('transfer_group_seq')`).Scan(&result.GroupID); err != nil {
+		return nil, systemError(err)
+	}
+
+	for _, pair := range req.Split() {
+		pair.GroupID = result.GroupID
+		src, dest := accounts[pair.SourceID], accounts[pair.DestinationID]
+
+		destAmount, err := checkTransfer(&pair, src, dest)
+		if err != nil {
+			return nil, err
+		}
+
+		posted, err := r.postTransfer(ctx, tx, &pair, src, dest, destAmount)
+		if err != nil {
+			return nil, err
+		}
+		result.Transfers = append(result.Transfers, *posted)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+func accountLeg(id, amount int64) models.Leg {
+	return models.Leg{AccountID: id, Amount: decimal.NewFromInt(amount)}
+}
+
+func groupedTransfer(src, dest, amount, groupID int64) models.TransferRequest {
+	return models.TransferRequest{SourceID: src, DestinationID: dest, Amount: decimal.NewFromInt(amount), GroupID: groupID}
+}
+
+func TestTransferRepository_TransferMultiLeg(t *testing.T) {
+	t.Run("Success: Payout Split With Platform Fee", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		req := &models.MultiLegTransferRequest{Debits: []models.Leg{accountLeg(1, 100)}, Credits: []models.Leg{accountLeg(2, 95), accountLeg(3, 5)}}
+
+		mock.ExpectBegin()
+		expectLockThree(mock, 100, 0, 0)
+		expectNoLimits(mock, 1)
+		mock.ExpectQuery(`SELECT nextval\('transfer_group_seq'\)`).
+			WillReturnRows(sqlmock.NewRows([]string{"nextval"}).AddRow(int64(5)))
+		expectPostedLeg(mock, 10, groupedTransfer(1, 2, 95, 5), 100, 0)
+		expectPostedLeg(mock, 11, groupedTransfer(1, 3, 5, 5), 5, 0)
+		mock.ExpectCommit()
+
+		result, err := repo.TransferMultiLeg(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), result.GroupID)
+		require.Len(t, result.Transfers, 2)
+		assert.Equal(t, "0", result.Transfers[1].SourcePostBalance)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Several Debits Paired In Order", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		req := &models.MultiLegTransferRequest{Debits: []models.Leg{accountLeg(1, 60), accountLeg(2, 40)}, Credits: []models.Leg{accountLeg(3, 50), accountLeg(4, 50)}}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`WHERE account_id IN \(\$1, \$2, \$3, \$4\)`).
+			WithArgs(int64(1), int64(2), int64(3), int64(4)).
+			WillReturnRows(accountRows().
+				AddRow(int64(1), decimal.NewFromInt(60), decimal.Zero, "USD", constants.AccountActive, decimal.Zero).
+				AddRow(int64(2), decimal.NewFromInt(40), decimal.Zero, "USD", constants.AccountActive, decimal.Zero).
+				AddRow(int64(3), decimal.Zero, decimal.Zero, "USD", constants.AccountActive, decimal.Zero).
+				AddRow(int64(4), decimal.Zero, decimal.Zero, "USD", constants.AccountActive, decimal.Zero))
+		expectNoLimits(mock, 1)
+		expectNoLimits(mock, 2)
+		mock.ExpectQuery(`SELECT nextval\('transfer_group_seq'\)`).
+			WillReturnRows(sqlmock.NewRows([]string{"nextval"}).AddRow(int64(6)))
+		expectPostedLeg(mock, 10, groupedTransfer(1, 3, 50, 6), 60, 0)
+		expectPostedLeg(mock, 11, groupedTransfer(1, 4, 10, 6), 10, 0)
+		expectPostedLeg(mock, 12, groupedTransfer(2, 4, 40, 6), 40, 10)
+		mock.ExpectCommit()
+
+		result, err := repo.TransferMultiLeg(context.Background(), req)
+		require.NoError(t, err)
+		assert.Len(t, result.Transfers, 3)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Debit Not Covered Rolls Back Group", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		req := &models.MultiLegTransferRequest{Debits: []models.Leg{accountLeg(1, 100)}, Credits: []models.Leg{accountLeg(2, 95), accountLeg(3, 5)}}
+
+		mock.ExpectBegin()
+		expectLockThree(mock, 97, 0, 0)
+		expectNoLimits(mock, 1)
+		mock.ExpectQuery(`SELECT nextval\('transfer_group_seq'\)`).
+			WillReturnRows(sqlmock.NewRows([]string{"nextval"}).AddRow(int64(5)))
+		expectPostedLeg(mock, 10, groupedTransfer(1, 2, 95, 5), 97, 0)
+		mock.ExpectRollback()
+
+		_, err := repo.TransferMultiLeg(context.Background(), req)
+		assert.ErrorIs(t, err, constants.ErrInsufficientFunds)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Account Not Found", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		req := &models.MultiLegTransferRequest{Debits: []models.Leg{accountLeg(1, 100)}, Credits: []models.Leg{accountLeg(2, 95), accountLeg(3, 5)}}
+
+		mock.ExpectBegin()
+		expectLockThree(mock, 100, 0)
+		mock.ExpectRollback()
+
+		_, err := repo.TransferMultiLeg(context.Background(), req)
+		assert.ErrorIs(t, err, constants.ErrAccountNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"go.uber.org/zap"
+)
+
+// outboxLockKey is the Postgres advisory lock held by the Core instance
+// currently relaying outbox events. A single relay keeps them in order.
+const outboxLockKey int64 = 0x6f7574626f78 // "outbox"
+
+type OutboxRepository struct {
+	db  *sql.DB
+	log *zap.Logger
+}
+
+func NewOutboxRepository(db *sql.DB, log *zap.Logger) *OutboxRepository {
+	return &OutboxRepository{db: db, log: log}
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// insertEvent writes event to the outbox through q, normally the transaction
+// whose changes the event describes, so the event exists if and only if they
+// were committed. Events of one account are written while its row is locked,
+// so their event_ids follow the order the account changed in. The same
+// statement queues a webhook delivery for every active subscription to the
+// event's type and either account it concerns.
+func insertEvent(ctx context.Context, q execer, event *models.Event) error {
+	_, err := q.ExecContext(ctx, `
+        WITH event AS (
+            INSERT INTO outbox (account_id, event_type, event_version, payload, counterparty_id)
+            VALUES ($1, $2, $3, $4, NULLIF($6, 0))
+            RETURNING event_id
+        )
+        INSERT INTO webhook_deliveries (subscription_id, event_id)
+        SELECT s.subscription_id, event.event_id FROM event, webhook_subscriptions s
+        WHERE s.status = $5 AND $2 = ANY(s.event_types) AND (s.account_id IS NULL OR s.account_id IN ($1, $6))`,
+		event.AccountID, event.Type, event.Version, []byte(event.Payload), constants.WebhookActive, event.CounterpartyID)
+	if err != nil {
+		return fmt.Errorf("insert %s event failed: %w", event.Type, err)
+	}
+	return nil
+}
+
+// Pending returns up to limit unpublished events, oldest first.
+func (r *OutboxRepository) Pending(ctx context.Context, limit int) ([]models.Event, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT event_id, account_id, COALESCE(counterparty_id, 0), event_type, event_version, payload, created_at
+        FROM outbox WHERE published_at IS NULL ORDER BY event_id LIMIT $1`, limit)
+	if err != nil {
+		r.log.Error("Failed to query outbox", zap.Error(err))
+		return nil, fmt.Errorf("list pending events failed: %w", err)
+	}
+	defer rows.Close()
+
+	var events []models.Event
+	for rows.Next() {
+		var (
+			e       models.Event
+			payload []byte
+		)
+		if err := rows.Scan(&e.ID, &e.AccountID, &e.CounterpartyID, &e.Type, &e.Version, &payload, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("list pending events failed: %w", err)
+		}
+		e.Payload = payload
+		events = append(events, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list pending events failed: %w", err)
+	}
+
+	return events, nil
+}
+
+func (r *OutboxRepository) MarkPublished(ctx context.Context, ids []int64) error {
+	placeholders := make([]string, len(ids))
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = id
+	}
+
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE outbox SET published_at = now() WHERE event_id IN (`+strings.Join(placeholders, ", ")+`)`, args...)
+	if err != nil {
+		r.log.Error("Failed to mark events published", zap.Int("events", len(ids)), zap.Error(err))
+		return fmt.Errorf("mark events published failed: %w", err)
+	}
+	return nil
+}
+
+// WithLeaderLock runs fn only if this instance wins the outbox relay advisory
+// lock, reporting whether it did.
+func (r *OutboxRepository) WithLeaderLock(ctx context.Context, fn func(ctx context.Context) error) (bool, error) {
+	return withAdvisoryLock(ctx, r.db, outboxLockKey, "outbox", r.log, fn)
+}
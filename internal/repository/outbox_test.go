@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+func setupOutboxTest(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *OutboxRepository) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	return db, mock, NewOutboxRepository(db, zap.NewNop())
+}
+
+// expectEvent expects an event of eventType on accountID's stream to be
+// written to the outbox, queuing its webhook deliveries.
+func expectEvent(mock sqlmock.Sqlmock, accountID int64, eventType models.EventType) {
+	mock.ExpectExec(`INSERT INTO outbox \(account_id, event_type, event_version, payload, counterparty_id\)`).
+		WithArgs(accountID, eventType, 1, sqlmock.AnyArg(), constants.WebhookActive, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func TestOutboxRepository_Pending(t *testing.T) {
+	t.Run("Success: Unpublished Events Oldest First", func(t *testing.T) {
+		db, mock, repo := setupOutboxTest(t)
+		defer db.Close()
+
+		now := time.Now()
+		mock.ExpectQuery(`SELECT event_id, account_id, COALESCE\(counterparty_id, 0\), event_type, event_version, payload, created_at\s+FROM outbox WHERE published_at IS NULL ORDER BY event_id LIMIT \$1`).
+			WithArgs(10).
+			WillReturnRows(sqlmock.NewRows([]string{"event_id", "account_id", "counterparty_id", "event_type", "event_version", "payload", "created_at"}).
+				AddRow(int64(1), int64(101), int64(0), "AccountCreated", 1, []byte(`{"account_id":101}`), now).
+				AddRow(int64(2), int64(101), int64(202), "TransferCompleted", 1, []byte(`{"transfer_id":7}`), now))
+
+		events, err := repo.Pending(context.Background(), 10)
+
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, models.EventAccountCreated, events[0].Type)
+		assert.JSONEq(t, `{"transfer_id":7}`, string(events[1].Payload))
+		assert.Equal(t, int64(202), events[1].CounterpartyID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: DB Error", func(t *testing.T) {
+		db, mock, repo := setupOutboxTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM outbox`).WillReturnError(errors.New("connection reset"))
+
+		_, err := repo.Pending(context.Background(), 10)
+
+		assert.ErrorContains(t, err, "connection reset")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestOutboxRepository_MarkPublished(t *testing.T) {
+	db, mock, repo := setupOutboxTest(t)
+	defer db.Close()
+
+	mock.ExpectExec(`UPDATE outbox SET published_at = now\(\) WHERE event_id IN \(\$1, \$2\)`).
+		WithArgs(int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	require.NoError(t, repo.MarkPublished(context.Background(), []int64{1, 2}))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInsertEvent(t *testing.T) {
+	db, mock, _ := setupOutboxTest(t)
+	defer db.Close()
+
+	event := models.NewTransferFailedEvent(&models.TransferRequest{SourceID: 1, DestinationID: 2}, 555, errors.New("insufficient funds"))
+
+	mock.ExpectExec(`INSERT INTO outbox .*\s+INSERT INTO webhook_deliveries`).
+		WithArgs(int64(1), models.EventTransferFailed, models.TransferFailedVersion, []byte(event.Payload),
+			constants.WebhookActive, int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, insertEvent(context.Background(), db, event))
+
+	var payload models.TransferFailed
+	require.NoError(t, json.Unmarshal(event.Payload, &payload))
+	assert.Equal(t, "TRANSFER", payload.Kind)
+	assert.Equal(t, int64(555), payload.CorrelationID)
+	assert.Equal(t, "insufficient funds", payload.Reason)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
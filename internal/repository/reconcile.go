@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"go.uber.org/zap"
+)
+
+type ReconcileRepository struct {
+	db  *sql.DB
+	log *zap.Logger
+}
+
+func NewReconcileRepository(db *sql.DB, log *zap.Logger) *ReconcileRepository {
+	return &ReconcileRepository{db: db, log: log}
+}
+
+// Snapshot runs fn inside a read-only REPEATABLE READ transaction so balances
+// and transfers are read as of the same instant.
+func (r *ReconcileRepository) Snapshot(ctx context.Context, fn func(ReconcileSnapshot) error) error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		r.log.Error("failed to begin snapshot tx", zap.Error(err))
+		return constants.ErrSystem
+	}
+	defer func(tx *sql.Tx) {
+		_ = tx.Rollback()
+	}(tx)
+
+	return fn(&reconcileSnapshot{tx: tx, log: r.log})
+}
+
+type reconcileSnapshot struct {
+	tx  *sql.Tx
+	log *zap.Logger
+}
+
+func (s *reconcileSnapshot) ListAccountBalances(ctx context.Context) ([]models.AccountBalance, error) {
+	rows, err := s.tx.QueryContext(ctx, `SELECT account_id, opening_balance, balance FROM accounts ORDER BY account_id`)
+	if err != nil {
+		s.log.Error("Failed to query account balances", zap.Error(err))
+		return nil, fmt.Errorf("list account balances failed: %w", err)
+	}
+	defer rows.Close()
+
+	var balances []models.AccountBalance
+	for rows.Next() {
+		var b models.AccountBalance
+		if err := rows.Scan(&b.AccountID, &b.OpeningBalance, &b.Balance); err != nil {
+			return nil, fmt.Errorf("list account balances failed: %w", err)
+		}
+		balances = append(balances, b)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list account balances failed: %w", err)
+	}
+
+	return balances, nil
+}
+
+// ForEachPostedTransfer streams transfers that moved balances, including ones
+// reversed since, in posting order without holding the whole history in
+// memory. posting_seq is drawn while the accounts are locked, so per account it
+// is the order balances changed, even for a captured hold whose transfer_id
+// predates later transfers.
+func (s *reconcileSnapshot) ForEachPostedTransfer(ctx context.Context, fn func(*models.Transfer) error) error {
+	rows, err := s.tx.QueryContext(ctx,
+		`SELECT `+transferColumns+` FROM transfers WHERE status IN ($1, $2, $3) ORDER BY posting_seq`,
+		constants.StatusCompleted, constants.StatusReversed, constants.StatusPartiallyReversed)
+	if err != nil {
+		s.log.Error("Failed to query transfers", zap.Error(err))
+		return fmt.Errorf("stream transfers failed: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		t, err := scanTransfer(rows)
+		if err != nil {
+			return fmt.Errorf("stream transfers failed: %w", err)
+		}
+		if err := fn(t); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("stream transfers failed: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+func TestReconcileRepository_Snapshot(t *testing.T) {
+	t.Run("Success: Reads Accounts And Transfers In One Transaction", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewReconcileRepository(db, zap.NewNop())
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT account_id, opening_balance, balance FROM accounts`).
+			WillReturnRows(sqlmock.NewRows([]string{"account_id", "opening_balance", "balance"}).
+				AddRow(100, decimal.NewFromInt(100), decimal.NewFromInt(90)).
+				AddRow(200, decimal.NewFromInt(0), decimal.NewFromInt(10)))
+		mock.ExpectQuery(`FROM transfers WHERE status IN \(\$1, \$2, \$3\) ORDER BY posting_seq`).
+			WithArgs(constants.StatusCompleted, constants.StatusReversed, constants.StatusPartiallyReversed).
+			WillReturnRows(addTransferRow(sqlmock.NewRows(transferRowColumns), 1, 100, 200))
+		mock.ExpectRollback()
+
+		var (
+			accounts  []models.AccountBalance
+			transfers []int64
+		)
+		err = repo.Snapshot(context.Background(), func(snap ReconcileSnapshot) error {
+			var err error
+			if accounts, err = snap.ListAccountBalances(context.Background()); err != nil {
+				return err
+			}
+			return snap.ForEachPostedTransfer(context.Background(), func(tr *models.Transfer) error {
+				transfers = append(transfers, tr.ID)
+				return nil
+			})
+		})
+
+		require.NoError(t, err)
+		assert.Len(t, accounts, 2)
+		assert.Equal(t, []int64{1}, transfers)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Begin Error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewReconcileRepository(db, zap.NewNop())
+
+		mock.ExpectBegin().WillReturnError(errors.New("connection refused"))
+
+		err = repo.Snapshot(context.Background(), func(ReconcileSnapshot) error { return nil })
+
+		assert.Equal(t, constants.ErrSystem, err)
+	})
+
+	t.Run("Failure: Transfer Query Error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewReconcileRepository(db, zap.NewNop())
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`FROM transfers`).WillReturnError(errors.New("canceling statement"))
+		mock.ExpectRollback()
+
+		err = repo.Snapshot(context.Background(), func(snap ReconcileSnapshot) error {
+			return snap.ForEachPostedTransfer(context.Background(), func(*models.Transfer) error { return nil })
+		})
+
+		assert.ErrorContains(t, err, "stream transfers failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,227 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// Reverse posts a compensating transfer from the original destination back to
+// its source and adds the refund to the original's reversed_amount. The
+// original row keeps its balances; only its status says it has been reversed.
+// A request with an idempotency key already used replays that reversal.
+func (r *TransferRepository) Reverse(ctx context.Context, req *models.ReversalRequest) (*models.Reversal, error) {
+	if req.IdempotencyKey != "" {
+		reversal, err := r.replayReversal(ctx, req)
+		if err != nil || reversal != nil {
+			return reversal, err
+		}
+	}
+
+	var reversal *models.Reversal
+	err := r.runTx(ctx, "reverse", func(tx *sql.Tx) error {
+		var err error
+		reversal, err = r.reverseTx(ctx, tx, req)
+		return err
+	})
+	if errors.Is(err, errIdempotencyKeyTaken) {
+		// A concurrent request with the same key committed first; answer with its reversal.
+		reversal, err = r.replayReversal(ctx, req)
+		if err == nil && reversal == nil {
+			return nil, constants.ErrSystem
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return reversal, nil
+}
+
+// replayReversal returns the reversal previously posted with
+// req.IdempotencyKey alongside the original as it stands now, or nil if the
+// key has not been used yet.
+func (r *TransferRepository) replayReversal(ctx context.Context, req *models.ReversalRequest) (*models.Reversal, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+transferColumns+` FROM transfers WHERE idempotency_key = $1`, req.IdempotencyKey)
+
+	reversal, err := scanTransfer(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Error("failed to look up idempotency key", zap.Error(err))
+		return nil, constants.ErrSystem
+	}
+
+	if !req.SamePayload(reversal) {
+		r.log.Warn("idempotency key reused with different payload",
+			zap.String("idempotency_key", req.IdempotencyKey),
+			zap.Int64("audit_id", reversal.ID))
+		return nil, constants.ErrIdempotencyKeyReused
+	}
+
+	original, err := r.GetTransfer(ctx, reversal.ReversalOf)
+	if err != nil {
+		return nil, constants.ErrSystem
+	}
+
+	r.log.Info("replaying idempotent reversal",
+		zap.String("idempotency_key", req.IdempotencyKey),
+		zap.Int64("reversal_id", reversal.ID))
+	return &models.Reversal{Reversal: *reversal, Original: *original}, nil
+}
+
+func (r *TransferRepository) reverseTx(ctx context.Context, tx *sql.Tx, req *models.ReversalRequest) (*models.Reversal, error) {
+	correlationID, _ := ctx.Value(constants.CorrelationKey).(int64)
+
+	original, err := r.lockTransfer(ctx, tx, req.TransferID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := original.CheckReversible(); err != nil {
+		return nil, err
+	}
+
+	var destReversed decimal.Decimal
+	if original.FxRate.Valid {
+		err := tx.QueryRowContext(ctx, "SELECT COALESCE(SUM(amount), 0) FROM transfers WHERE reversal_of = $1", original.ID).
+			Scan(&destReversed)
+		if err != nil {
+			r.log.Error("failed to sum prior reversals", zap.Int64("transfer_id", original.ID), zap.Error(err))
+			return nil, systemError(err)
+		}
+	}
+
+	refund, debit, err := original.ReversalAmounts(req.Amount, destReversed, req.Rounding)
+	if err != nil {
+		return nil, err
+	}
+
+	payer, payee, err := lockAccounts(ctx, tx, original.DestinationID, original.SourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := payer.CheckDebit(); err != nil {
+		return nil, err
+	}
+
+	if err := payee.CheckCredit(); err != nil {
+		return nil, err
+	}
+
+	if !payer.CanWithdraw(debit) {
+		return nil, constants.ErrInsufficientFunds
+	}
+
+	reversal := models.Transfer{
+		CorrelationID:          correlationID,
+		Status:                 constants.StatusCompleted,
+		SourceID:               original.DestinationID,
+		DestinationID:          original.SourceID,
+		Amount:                 debit,
+		Currency:               original.DestinationCurrency,
+		DestinationAmount:      refund,
+		DestinationCurrency:    original.Currency,
+		FxRateID:               original.FxRateID,
+		FxRate:                 original.FxRate,
+		SourcePrevBalance:      payer.Balance,
+		SourcePostBalance:      payer.Balance.Sub(debit),
+		DestinationPrevBalance: payee.Balance,
+		DestinationPostBalance: payee.Balance.Add(refund),
+		ReversalOf:             original.ID,
+		Reason:                 req.Reason,
+	}
+
+	var fxRateID sql.NullInt64
+	if original.FxRate.Valid {
+		fxRateID = sql.NullInt64{Int64: original.FxRateID, Valid: true}
+	}
+
+	err = tx.QueryRowContext(ctx, `
+        INSERT INTO transfers (
+            source_account_id, destination_account_id, amount,
+            correlation_id, status, source_prev_balance, source_post_balance,
+            destination_prev_balance, destination_post_balance,
+            currency, destination_amount, destination_currency,
+            fx_rate_id, fx_rate, reversal_of, reason, idempotency_key, posting_seq, posted_at
+        )
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17,
+                nextval('transfer_posting_seq'), clock_timestamp())
+        RETURNING transfer_id, created_at`,
+		reversal.SourceID, reversal.DestinationID, reversal.Amount, correlationID, reversal.Status,
+		reversal.SourcePrevBalance, reversal.SourcePostBalance,
+		reversal.DestinationPrevBalance, reversal.DestinationPostBalance,
+		reversal.Currency, reversal.DestinationAmount, reversal.DestinationCurrency,
+		fxRateID, reversal.FxRate, reversal.ReversalOf, reversal.Reason, nullableString(req.IdempotencyKey),
+	).Scan(&reversal.ID, &reversal.CreatedAt)
+	if err != nil {
+		if isUniqueViolation(err, idempotencyKeyConstraint) {
+			return nil, errIdempotencyKeyTaken
+		}
+		r.log.Error("failed to create reversal", zap.Int64("transfer_id", original.ID), zap.Error(err))
+		return nil, systemError(err)
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE accounts SET balance = $1 WHERE account_id = $2", reversal.SourcePostBalance, reversal.SourceID)
+	if err != nil {
+		return nil, systemError(err)
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE accounts SET balance = $1 WHERE account_id = $2", reversal.DestinationPostBalance, reversal.DestinationID)
+	if err != nil {
+		return nil, systemError(err)
+	}
+
+	journal := models.NewTransferJournal(reversal.ID, reversal.SourceID, reversal.DestinationID, debit, reversal.Currency)
+	if original.FxRate.Valid {
+		journal = models.NewFxTransferJournal(reversal.ID, reversal.SourceID, reversal.DestinationID, &models.FxQuote{
+			RateID:              original.FxRateID,
+			Rate:                original.FxRate.Decimal,
+			SourceCurrency:      reversal.Currency,
+			DestinationCurrency: reversal.DestinationCurrency,
+			DestinationAmount:   refund,
+		}, debit)
+	}
+	if err := r.ledger.Post(ctx, tx, journal); err != nil {
+		return nil, err
+	}
+
+	original.Status = original.ReversedStatus(refund)
+	original.ReversedAmount = original.ReversedAmount.Add(refund)
+
+	_, err = tx.ExecContext(ctx, "UPDATE transfers SET status = $1, reversed_amount = $2 WHERE transfer_id = $3",
+		original.Status, original.ReversedAmount, original.ID)
+	if err != nil {
+		r.log.Error("failed to mark transfer reversed", zap.Int64("transfer_id", original.ID), zap.Error(err))
+		return nil, systemError(err)
+	}
+
+	if err := insertEvent(ctx, tx, models.NewTransferCompletedEvent(&reversal)); err != nil {
+		r.log.Error("failed to write transfer event", zap.Int64("transfer_id", reversal.ID), zap.Error(err))
+		return nil, systemError(err)
+	}
+
+	return &models.Reversal{Reversal: reversal, Original: *original}, nil
+}
+
+// lockTransfer reads a transfer FOR UPDATE inside tx.
+func (r *TransferRepository) lockTransfer(ctx context.Context, tx *sql.Tx, id int64) (*models.Transfer, error) {
+	row := tx.QueryRowContext(ctx, `SELECT `+transferColumns+` FROM transfers WHERE transfer_id = $1 FOR UPDATE`, id)
+
+	t, err := scanTransfer(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, constants.ErrTransferNotFound
+		}
+		r.log.Error("failed to lock transfer", zap.Int64("transfer_id", id), zap.Error(err))
+		return nil, systemError(err)
+	}
+	return t, nil
+}
@@ -0,0 +1,308 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+const (
+	lockTransferQuery   = `SELECT transfer_id, .* FROM transfers WHERE transfer_id = \$1 FOR UPDATE`
+	replayReversalQuery = `SELECT transfer_id, .* FROM transfers WHERE idempotency_key = \$1`
+	getTransferQuery    = `SELECT transfer_id, .* FROM transfers WHERE transfer_id = \$1$`
+)
+
+// originalRow is transfer 7: 10 USD from account 100 to account 200.
+func originalRow(status constants.TransferStatus, reversed decimal.Decimal) *sqlmock.Rows {
+	return sqlmock.NewRows(transferRowColumns).AddRow(7, 42, status, 100, 200, decimal.NewFromFloat(10), "USD",
+		decimal.NewFromFloat(10), "USD", 0, nil,
+		decimal.NewFromFloat(100), decimal.NewFromFloat(90), decimal.NewFromFloat(0), decimal.NewFromFloat(10),
+		0, reversed, "", 0, decimal.Zero, 0, constants.KindTransfer, time.Now())
+}
+
+// reversalRow is transfer 8: a 4 USD reversal of transfer 7 stored under an
+// idempotency key.
+func reversalRow() *sqlmock.Rows {
+	return sqlmock.NewRows(transferRowColumns).AddRow(8, 43, constants.StatusCompleted, 200, 100, decimal.NewFromFloat(4), "USD",
+		decimal.NewFromFloat(4), "USD", 0, nil,
+		decimal.NewFromFloat(25), decimal.NewFromFloat(21), decimal.NewFromFloat(90), decimal.NewFromFloat(94),
+		7, decimal.Zero, "customer refund", 0, decimal.Zero, 0, constants.KindTransfer, time.Now())
+}
+
+func TestTransferRepository_Reverse(t *testing.T) {
+	correlationID := int64(987654321)
+
+	t.Run("Success: Partial Reversal", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		ctx := context.WithValue(context.Background(), constants.CorrelationKey, correlationID)
+		refund := decimal.NewFromFloat(4)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockTransferQuery).WithArgs(int64(7)).
+			WillReturnRows(originalRow(constants.StatusCompleted, decimal.Zero))
+		expectLockAccounts(mock,
+			lockedAccount(int64(200), decimal.NewFromFloat(25), decimal.Zero),
+			lockedAccount(int64(100), decimal.NewFromFloat(90), decimal.Zero))
+
+		mock.ExpectQuery(`INSERT INTO transfers`).
+			WithArgs(int64(200), int64(100), refund, correlationID, constants.StatusCompleted,
+				decimal.NewFromFloat(25), decimal.NewFromFloat(21),
+				decimal.NewFromFloat(90), decimal.NewFromFloat(94),
+				"USD", refund, "USD", sql.NullInt64{}, decimal.NullDecimal{}, int64(7), "customer refund", sql.NullString{}).
+			WillReturnRows(sqlmock.NewRows([]string{"transfer_id", "created_at"}).AddRow(8, time.Now()))
+
+		mock.ExpectExec(`UPDATE accounts SET balance = \$1 WHERE account_id = \$2`).
+			WithArgs(decimal.NewFromFloat(21), int64(200)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE accounts SET balance = \$1 WHERE account_id = \$2`).
+			WithArgs(decimal.NewFromFloat(94), int64(100)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		mock.ExpectExec(`INSERT INTO ledger_entries`).
+			WithArgs(int64(8), int64(200), refund.Neg(), "USD", int64(8), int64(100), refund, "USD").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		mock.ExpectExec(`UPDATE transfers SET status = \$1, reversed_amount = \$2 WHERE transfer_id = \$3`).
+			WithArgs(constants.StatusPartiallyReversed, refund, int64(7)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		expectEvent(mock, int64(200), models.EventTransferCompleted)
+
+		mock.ExpectCommit()
+
+		result, err := repo.Reverse(ctx, &models.ReversalRequest{TransferID: 7, Amount: refund, Reason: "customer refund"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(8), result.Reversal.ID)
+		assert.Equal(t, int64(7), result.Reversal.ReversalOf)
+		assert.Equal(t, constants.StatusPartiallyReversed, result.Original.Status)
+		assert.True(t, refund.Equal(result.Original.ReversedAmount))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Final Converted Reversal Takes Destination Remainder", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		// 10 USD became 9.33 EUR at 0.9333; 5 USD (4.67 EUR) was already reversed.
+		rate := decimal.NewFromFloat(0.9333)
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockTransferQuery).WithArgs(int64(7)).
+			WillReturnRows(sqlmock.NewRows(transferRowColumns).AddRow(7, 42, constants.StatusPartiallyReversed, 100, 200,
+				decimal.NewFromFloat(10), "USD", decimal.NewFromFloat(9.33), "EUR", 3, rate,
+				decimal.NewFromFloat(100), decimal.NewFromFloat(90), decimal.NewFromFloat(0), decimal.NewFromFloat(9.33),
+				0, decimal.NewFromFloat(5), "", 0, decimal.Zero, 0, constants.KindTransfer, time.Now()))
+		mock.ExpectQuery(`SELECT COALESCE\(SUM\(amount\), 0\) FROM transfers WHERE reversal_of = \$1`).WithArgs(int64(7)).
+			WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(decimal.NewFromFloat(4.67)))
+		expectLockAccounts(mock,
+			models.Account{ID: 200, Balance: decimal.NewFromFloat(20), Currency: "EUR", Status: constants.AccountActive},
+			lockedAccount(100, decimal.NewFromFloat(95), decimal.Zero))
+
+		debit := decimal.NewFromFloat(4.66)
+		refund := decimal.NewFromFloat(5)
+		mock.ExpectQuery(`INSERT INTO transfers`).
+			WithArgs(int64(200), int64(100), debit, int64(0), constants.StatusCompleted,
+				decimal.NewFromFloat(20), decimal.NewFromFloat(15.34),
+				decimal.NewFromFloat(95), decimal.NewFromFloat(100),
+				"EUR", refund, "USD", sql.NullInt64{Int64: 3, Valid: true},
+				decimal.NullDecimal{Decimal: rate, Valid: true}, int64(7), "chargeback", sql.NullString{}).
+			WillReturnRows(sqlmock.NewRows([]string{"transfer_id", "created_at"}).AddRow(9, time.Now()))
+		mock.ExpectExec(`UPDATE accounts SET balance`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE accounts SET balance`).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		mock.ExpectExec(`INSERT INTO ledger_entries`).
+			WithArgs(
+				int64(9), int64(200), debit.Neg(), "EUR",
+				int64(9), nil, debit, "EUR",
+				int64(9), nil, refund.Neg(), "USD",
+				int64(9), int64(100), refund, "USD",
+			).
+			WillReturnResult(sqlmock.NewResult(0, 4))
+
+		mock.ExpectExec(`UPDATE transfers SET status = \$1, reversed_amount = \$2`).
+			WithArgs(constants.StatusReversed, decimal.NewFromFloat(10), int64(7)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		expectEvent(mock, int64(200), models.EventTransferCompleted)
+		mock.ExpectCommit()
+
+		result, err := repo.Reverse(context.Background(), &models.ReversalRequest{
+			TransferID: 7, Reason: "chargeback", Rounding: models.RoundHalfEven,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, constants.StatusReversed, result.Original.Status)
+		assert.Equal(t, "EUR", result.Reversal.Currency)
+		assert.True(t, debit.Equal(result.Reversal.Amount))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Destination Cannot Cover Refund", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockTransferQuery).WithArgs(int64(7)).
+			WillReturnRows(originalRow(constants.StatusCompleted, decimal.Zero))
+		expectLockAccounts(mock,
+			lockedAccount(int64(200), decimal.NewFromFloat(3), decimal.Zero),
+			lockedAccount(int64(100), decimal.NewFromFloat(90), decimal.Zero))
+		mock.ExpectRollback()
+
+		_, err := repo.Reverse(context.Background(), &models.ReversalRequest{TransferID: 7, Reason: "refund"})
+
+		assert.Equal(t, constants.ErrInsufficientFunds, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Exceeds Remaining Amount", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockTransferQuery).WithArgs(int64(7)).
+			WillReturnRows(originalRow(constants.StatusPartiallyReversed, decimal.NewFromFloat(8)))
+		mock.ExpectRollback()
+
+		_, err := repo.Reverse(context.Background(), &models.ReversalRequest{
+			TransferID: 7, Amount: decimal.NewFromFloat(3), Reason: "refund",
+		})
+
+		assert.Equal(t, constants.ErrReversalTooLarge, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Already Fully Reversed", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockTransferQuery).WithArgs(int64(7)).
+			WillReturnRows(originalRow(constants.StatusReversed, decimal.NewFromFloat(10)))
+		mock.ExpectRollback()
+
+		_, err := repo.Reverse(context.Background(), &models.ReversalRequest{TransferID: 7, Reason: "refund"})
+
+		assert.Equal(t, constants.ErrTransferNotReversible, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Fee Transfer Not Reversible", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		// Transfer 9 is the 1 USD fee collected from account 100 for transfer 7.
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockTransferQuery).WithArgs(int64(9)).
+			WillReturnRows(sqlmock.NewRows(transferRowColumns).AddRow(9, 42, constants.StatusCompleted, 100, 900, decimal.NewFromFloat(1), "USD",
+				decimal.NewFromFloat(1), "USD", 0, nil,
+				decimal.NewFromFloat(90), decimal.NewFromFloat(89), decimal.Zero, decimal.NewFromFloat(1),
+				0, decimal.Zero, "", 0, decimal.Zero, 7, constants.KindTransfer, time.Now()))
+		mock.ExpectRollback()
+
+		_, err := repo.Reverse(context.Background(), &models.ReversalRequest{TransferID: 9, Reason: "refund"})
+
+		assert.Equal(t, constants.ErrTransferNotReversible, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Idempotency Key Replays Reversal", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(replayReversalQuery).WithArgs("refund-7").WillReturnRows(reversalRow())
+		mock.ExpectQuery(getTransferQuery).WithArgs(int64(7)).
+			WillReturnRows(originalRow(constants.StatusPartiallyReversed, decimal.NewFromFloat(4)))
+
+		result, err := repo.Reverse(context.Background(), &models.ReversalRequest{
+			TransferID: 7, Amount: decimal.NewFromFloat(4), Reason: "customer refund", IdempotencyKey: "refund-7",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(8), result.Reversal.ID)
+		assert.Equal(t, constants.StatusPartiallyReversed, result.Original.Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Remainder Request Replays Reversal", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(replayReversalQuery).WithArgs("refund-7").WillReturnRows(reversalRow())
+		mock.ExpectQuery(getTransferQuery).WithArgs(int64(7)).
+			WillReturnRows(originalRow(constants.StatusPartiallyReversed, decimal.NewFromFloat(4)))
+
+		result, err := repo.Reverse(context.Background(), &models.ReversalRequest{
+			TransferID: 7, Reason: "customer refund", IdempotencyKey: "refund-7",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(8), result.Reversal.ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Idempotency Key Reused With Different Payload", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(replayReversalQuery).WithArgs("refund-7").WillReturnRows(reversalRow())
+
+		_, err := repo.Reverse(context.Background(), &models.ReversalRequest{
+			TransferID: 7, Amount: decimal.NewFromFloat(5), Reason: "customer refund", IdempotencyKey: "refund-7",
+		})
+		assert.Equal(t, constants.ErrIdempotencyKeyReused, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Concurrent Reversal With Same Key Replayed", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		refund := decimal.NewFromFloat(4)
+		mock.ExpectQuery(replayReversalQuery).WithArgs("refund-7").WillReturnError(sql.ErrNoRows)
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockTransferQuery).WithArgs(int64(7)).
+			WillReturnRows(originalRow(constants.StatusCompleted, decimal.Zero))
+		expectLockAccounts(mock,
+			lockedAccount(int64(200), decimal.NewFromFloat(25), decimal.Zero),
+			lockedAccount(int64(100), decimal.NewFromFloat(90), decimal.Zero))
+		mock.ExpectQuery(`INSERT INTO transfers`).
+			WithArgs(int64(200), int64(100), refund, int64(0), constants.StatusCompleted,
+				decimal.NewFromFloat(25), decimal.NewFromFloat(21),
+				decimal.NewFromFloat(90), decimal.NewFromFloat(94),
+				"USD", refund, "USD", sql.NullInt64{}, decimal.NullDecimal{}, int64(7), "customer refund",
+				sql.NullString{String: "refund-7", Valid: true}).
+			WillReturnError(&pgconn.PgError{Code: pgUniqueViolation, ConstraintName: idempotencyKeyConstraint})
+		mock.ExpectRollback()
+		mock.ExpectQuery(replayReversalQuery).WithArgs("refund-7").WillReturnRows(reversalRow())
+		mock.ExpectQuery(getTransferQuery).WithArgs(int64(7)).
+			WillReturnRows(originalRow(constants.StatusPartiallyReversed, refund))
+
+		result, err := repo.Reverse(context.Background(), &models.ReversalRequest{
+			TransferID: 7, Amount: refund, Reason: "customer refund", IdempotencyKey: "refund-7",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(8), result.Reversal.ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Transfer Not Found", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockTransferQuery).WithArgs(int64(9)).WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		_, err := repo.Reverse(context.Background(), &models.ReversalRequest{TransferID: 9, Reason: "refund"})
+
+		assert.Equal(t, constants.ErrTransferNotFound, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"go.uber.org/zap"
+)
+
+// schedulerLockKey is the Postgres advisory lock held by the Core instance
+// currently running due scheduled transfers.
+const schedulerLockKey int64 = 0x7363686564756c65 // "schedule"
+
+type ScheduleRepository struct {
+	db  *sql.DB
+	log *zap.Logger
+}
+
+func NewScheduleRepository(db *sql.DB, log *zap.Logger) *ScheduleRepository {
+	return &ScheduleRepository{db: db, log: log}
+}
+
+const scheduleColumns = `s.schedule_id, s.source_account_id, s.destination_account_id, s.amount,
+        COALESCE(s.currency, ''), s.convert, s.schedule, s.status, s.next_run_at, s.created_at`
+
+func scanSchedule(row rowScanner, extra ...any) (*models.ScheduledTransfer, error) {
+	var (
+		s         models.ScheduledTransfer
+		nextRunAt sql.NullTime
+	)
+	dest := append([]any{&s.ID, &s.SourceID, &s.DestinationID, &s.Amount,
+		&s.Currency, &s.Convert, &s.Schedule, &s.Status, &nextRunAt, &s.CreatedAt}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	s.NextRunAt = nextRunAt.Time
+	return &s, nil
+}
+
+func (r *ScheduleRepository) Create(ctx context.Context, s *models.ScheduledTransfer) error {
+	err := r.db.QueryRowContext(ctx, `
+        INSERT INTO scheduled_transfers (
+            source_account_id, destination_account_id, amount, currency, convert, schedule, status, next_run_at
+        )
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+        RETURNING schedule_id, created_at`,
+		s.SourceID, s.DestinationID, s.Amount, nullableString(s.Currency), s.Convert, s.Schedule,
+		s.Status, s.NextRunAt,
+	).Scan(&s.ID, &s.CreatedAt)
+	if err != nil {
+		r.log.Error("Failed to create scheduled transfer", zap.Int64("source", s.SourceID), zap.Error(err))
+		return fmt.Errorf("create scheduled transfer failed: %w", err)
+	}
+	return nil
+}
+
+// Cancel stops an active schedule; its past runs are kept.
+func (r *ScheduleRepository) Cancel(ctx context.Context, id int64) (*models.ScheduledTransfer, error) {
+	row := r.db.QueryRowContext(ctx, `
+        UPDATE scheduled_transfers s SET status = $1, next_run_at = NULL, updated_at = now()
+        WHERE schedule_id = $2 AND status = $3
+        RETURNING `+scheduleColumns,
+		constants.ScheduleCancelled, id, constants.ScheduleActive)
+
+	s, err := scanSchedule(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		var status constants.ScheduleStatus
+		err = r.db.QueryRowContext(ctx, `SELECT status FROM scheduled_transfers WHERE schedule_id = $1`, id).Scan(&status)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, constants.ErrScheduleNotFound
+		}
+		if err == nil {
+			return nil, constants.ErrScheduleNotActive
+		}
+	}
+	if err != nil {
+		r.log.Error("Failed to cancel scheduled transfer", zap.Int64("schedule_id", id), zap.Error(err))
+		return nil, fmt.Errorf("cancel scheduled transfer failed: %w", err)
+	}
+	return s, nil
+}
+
+// ListByAccount returns the schedules paying out of an account, each with its
+// most recent run.
+func (r *ScheduleRepository) ListByAccount(ctx context.Context, accountID int64) ([]models.ScheduledTransfer, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT `+scheduleColumns+`,
+               lr.scheduled_for, lr.status, COALESCE(lr.transfer_id, 0), COALESCE(lr.error, ''), lr.executed_at
+        FROM scheduled_transfers s
+        LEFT JOIN LATERAL (
+            SELECT scheduled_for, status, transfer_id, error, executed_at FROM scheduled_transfer_runs
+            WHERE schedule_id = s.schedule_id ORDER BY scheduled_for DESC LIMIT 1
+        ) lr ON true
+        WHERE s.source_account_id = $1
+        ORDER BY s.schedule_id`, accountID)
+	if err != nil {
+		r.log.Error("Failed to list scheduled transfers", zap.Int64("account_id", accountID), zap.Error(err))
+		return nil, fmt.Errorf("list scheduled transfers failed: %w", err)
+	}
+	defer rows.Close()
+
+	var schedules []models.ScheduledTransfer
+	for rows.Next() {
+		var (
+			run          models.ScheduledRun
+			scheduledFor sql.NullTime
+			runStatus    sql.NullInt64
+			executedAt   sql.NullTime
+		)
+		s, err := scanSchedule(rows, &scheduledFor, &runStatus, &run.TransferID, &run.Error, &executedAt)
+		if err != nil {
+			return nil, fmt.Errorf("list scheduled transfers failed: %w", err)
+		}
+		if scheduledFor.Valid {
+			run.ScheduleID = s.ID
+			run.ScheduledFor = scheduledFor.Time
+			run.Status = constants.RunStatus(runStatus.Int64)
+			run.ExecutedAt = executedAt.Time
+			s.LastRun = &run
+		}
+		schedules = append(schedules, *s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list scheduled transfers failed: %w", err)
+	}
+	return schedules, nil
+}
+
+// Due returns up to limit active schedules whose next run is at or before now,
+// oldest first, with the attempts already made at that run.
+func (r *ScheduleRepository) Due(ctx context.Context, now time.Time, limit int) ([]models.ScheduledTransfer, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT `+scheduleColumns+`, s.attempts FROM scheduled_transfers s
+        WHERE s.status = $1 AND s.next_run_at <= $2
+        ORDER BY s.next_run_at LIMIT $3`,
+		constants.ScheduleActive, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list due scheduled transfers failed: %w", err)
+	}
+	defer rows.Close()
+
+	var due []models.ScheduledTransfer
+	for rows.Next() {
+		var attempts int
+		s, err := scanSchedule(rows, &attempts)
+		if err != nil {
+			return nil, fmt.Errorf("list due scheduled transfers failed: %w", err)
+		}
+		s.Attempts = attempts
+		due = append(due, *s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list due scheduled transfers failed: %w", err)
+	}
+	return due, nil
+}
+
+// RecordRun stores the outcome of the occurrence due at run.ScheduledFor and
+// moves the schedule on to next, completing it when next is zero. The update
+// only applies while the schedule is still active and due at that occurrence,
+// so a cancellation in the meantime is not undone.
+func (r *ScheduleRepository) RecordRun(ctx context.Context, run *models.ScheduledRun, next time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("record scheduled run failed: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `
+        INSERT INTO scheduled_transfer_runs (schedule_id, scheduled_for, status, transfer_id, error)
+        VALUES ($1, $2, $3, $4, $5)
+        ON CONFLICT (schedule_id, scheduled_for) DO NOTHING`,
+		run.ScheduleID, run.ScheduledFor, run.Status,
+		sql.NullInt64{Int64: run.TransferID, Valid: run.TransferID != 0}, nullableString(run.Error))
+	if err != nil {
+		return fmt.Errorf("record scheduled run failed: %w", err)
+	}
+
+	status, nextRunAt := constants.ScheduleActive, sql.NullTime{Time: next, Valid: !next.IsZero()}
+	if next.IsZero() {
+		status = constants.ScheduleCompleted
+	}
+	_, err = tx.ExecContext(ctx, `
+        UPDATE scheduled_transfers SET status = $1, next_run_at = $2, attempts = 0, updated_at = now()
+        WHERE schedule_id = $3 AND status = $4 AND next_run_at = $5`,
+		status, nextRunAt, run.ScheduleID, constants.ScheduleActive, run.ScheduledFor)
+	if err != nil {
+		return fmt.Errorf("record scheduled run failed: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("record scheduled run failed: %w", err)
+	}
+	return nil
+}
+
+// RetryLater records a failed try of the occurrence due at scheduledFor, which
+// stays due. Like RecordRun it leaves a cancelled or advanced schedule alone.
+func (r *ScheduleRepository) RetryLater(ctx context.Context, id int64, scheduledFor time.Time, attempts int) error {
+	_, err := r.db.ExecContext(ctx, `
+        UPDATE scheduled_transfers SET attempts = $1, updated_at = now()
+        WHERE schedule_id = $2 AND status = $3 AND next_run_at = $4`,
+		attempts, id, constants.ScheduleActive, scheduledFor)
+	if err != nil {
+		return fmt.Errorf("record scheduled attempt failed: %w", err)
+	}
+	return nil
+}
+
+// WithLeaderLock runs fn only if this instance wins the scheduler advisory
+// lock, reporting whether it did.
+func (r *ScheduleRepository) WithLeaderLock(ctx context.Context, fn func(ctx context.Context) error) (bool, error) {
+	return withAdvisoryLock(ctx, r.db, schedulerLockKey, "scheduler", r.log, fn)
+}
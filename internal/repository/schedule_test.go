@@ -0,0 +1,250 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+func setupScheduleTest(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *ScheduleRepository) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	return db, mock, NewScheduleRepository(db, zap.NewNop())
+}
+
+var scheduleRowColumns = []string{"schedule_id", "source_account_id", "destination_account_id", "amount",
+	"currency", "convert", "schedule", "status", "next_run_at", "created_at"}
+
+func TestScheduleRepository_Create(t *testing.T) {
+	db, mock, repo := setupScheduleTest(t)
+	defer db.Close()
+
+	next := time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC)
+	sched := &models.ScheduledTransfer{
+		SourceID: 1, DestinationID: 2, Amount: decimal.NewFromInt(25),
+		Schedule: "0 9 1 * *", Status: constants.ScheduleActive, NextRunAt: next,
+	}
+
+	mock.ExpectQuery(`INSERT INTO scheduled_transfers`).
+		WithArgs(int64(1), int64(2), sched.Amount, nil, false, "0 9 1 * *", constants.ScheduleActive, next).
+		WillReturnRows(sqlmock.NewRows([]string{"schedule_id", "created_at"}).AddRow(int64(7), time.Now()))
+
+	require.NoError(t, repo.Create(context.Background(), sched))
+	assert.Equal(t, int64(7), sched.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestScheduleRepository_Cancel(t *testing.T) {
+	cancelQuery := `UPDATE scheduled_transfers s SET status = \$1, next_run_at = NULL`
+	statusQuery := `SELECT status FROM scheduled_transfers WHERE schedule_id = \$1`
+
+	t.Run("Success: Active Schedule Cancelled", func(t *testing.T) {
+		db, mock, repo := setupScheduleTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(cancelQuery).
+			WithArgs(constants.ScheduleCancelled, int64(7), constants.ScheduleActive).
+			WillReturnRows(sqlmock.NewRows(scheduleRowColumns).
+				AddRow(int64(7), int64(1), int64(2), decimal.NewFromInt(25), "", false, "@daily",
+					constants.ScheduleCancelled, nil, time.Now()))
+
+		sched, err := repo.Cancel(context.Background(), 7)
+		require.NoError(t, err)
+		assert.Equal(t, constants.ScheduleCancelled, sched.Status)
+		assert.True(t, sched.NextRunAt.IsZero())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Not Found", func(t *testing.T) {
+		db, mock, repo := setupScheduleTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(cancelQuery).WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery(statusQuery).WithArgs(int64(7)).WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.Cancel(context.Background(), 7)
+		assert.ErrorIs(t, err, constants.ErrScheduleNotFound)
+	})
+
+	t.Run("Failure: Already Completed", func(t *testing.T) {
+		db, mock, repo := setupScheduleTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(cancelQuery).WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery(statusQuery).WithArgs(int64(7)).
+			WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow(constants.ScheduleCompleted))
+
+		_, err := repo.Cancel(context.Background(), 7)
+		assert.ErrorIs(t, err, constants.ErrScheduleNotActive)
+	})
+}
+
+func TestScheduleRepository_ListByAccount(t *testing.T) {
+	db, mock, repo := setupScheduleTest(t)
+	defer db.Close()
+
+	ranAt := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	columns := append(scheduleRowColumns, "scheduled_for", "run_status", "transfer_id", "error", "executed_at")
+	mock.ExpectQuery(`FROM scheduled_transfers s\s+LEFT JOIN LATERAL`).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(int64(7), int64(1), int64(2), decimal.NewFromInt(25), "", false, "0 9 1 * *",
+				constants.ScheduleActive, ranAt.AddDate(0, 1, 0), time.Now(),
+				ranAt, int64(constants.RunFailed), int64(0), "insufficient funds", ranAt).
+			AddRow(int64(8), int64(1), int64(3), decimal.NewFromInt(5), "", false, "",
+				constants.ScheduleActive, ranAt.AddDate(0, 2, 0), time.Now(),
+				nil, nil, int64(0), "", nil))
+
+	schedules, err := repo.ListByAccount(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, schedules, 2)
+	require.NotNil(t, schedules[0].LastRun)
+	assert.Equal(t, constants.RunFailed, schedules[0].LastRun.Status)
+	assert.Equal(t, "insufficient funds", schedules[0].LastRun.Error)
+	assert.Nil(t, schedules[1].LastRun)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestScheduleRepository_Due(t *testing.T) {
+	db, mock, repo := setupScheduleTest(t)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery(`WHERE s.status = \$1 AND s.next_run_at <= \$2\s+ORDER BY s.next_run_at LIMIT \$3`).
+		WithArgs(constants.ScheduleActive, now, 100).
+		WillReturnRows(sqlmock.NewRows(append(scheduleRowColumns, "attempts")).
+			AddRow(int64(7), int64(1), int64(2), decimal.NewFromInt(25), "", false, "@daily",
+				constants.ScheduleActive, now.Add(-time.Minute), now, 2))
+
+	due, err := repo.Due(context.Background(), now, 100)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, int64(7), due[0].ID)
+	assert.Equal(t, 2, due[0].Attempts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestScheduleRepository_RecordRun(t *testing.T) {
+	runAt := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	insertRun := `INSERT INTO scheduled_transfer_runs .* ON CONFLICT \(schedule_id, scheduled_for\) DO NOTHING`
+	advance := `UPDATE scheduled_transfers SET status = \$1, next_run_at = \$2, attempts = 0`
+
+	t.Run("Success: Recurring Schedule Advances", func(t *testing.T) {
+		db, mock, repo := setupScheduleTest(t)
+		defer db.Close()
+
+		next := runAt.AddDate(0, 1, 0)
+		run := &models.ScheduledRun{ScheduleID: 7, ScheduledFor: runAt, Status: constants.RunSucceeded, TransferID: 42}
+
+		mock.ExpectBegin()
+		mock.ExpectExec(insertRun).
+			WithArgs(int64(7), runAt, constants.RunSucceeded, int64(42), nil).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(advance).
+			WithArgs(constants.ScheduleActive, next, int64(7), constants.ScheduleActive, runAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, repo.RecordRun(context.Background(), run, next))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: One-Off Schedule Completes", func(t *testing.T) {
+		db, mock, repo := setupScheduleTest(t)
+		defer db.Close()
+
+		run := &models.ScheduledRun{ScheduleID: 7, ScheduledFor: runAt, Status: constants.RunFailed, Error: "insufficient funds"}
+
+		mock.ExpectBegin()
+		mock.ExpectExec(insertRun).
+			WithArgs(int64(7), runAt, constants.RunFailed, nil, "insufficient funds").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(advance).
+			WithArgs(constants.ScheduleCompleted, nil, int64(7), constants.ScheduleActive, runAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, repo.RecordRun(context.Background(), run, time.Time{}))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestScheduleRepository_RetryLater(t *testing.T) {
+	db, mock, repo := setupScheduleTest(t)
+	defer db.Close()
+
+	runAt := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	mock.ExpectExec(`UPDATE scheduled_transfers SET attempts = \$1, updated_at = now\(\)\s+WHERE schedule_id = \$2 AND status = \$3 AND next_run_at = \$4`).
+		WithArgs(3, int64(7), constants.ScheduleActive, runAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, repo.RetryLater(context.Background(), 7, runAt, 3))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestScheduleRepository_WithLeaderLock(t *testing.T) {
+	lockQuery := `SELECT pg_try_advisory_lock\(\$1\)`
+	unlockQuery := `SELECT pg_advisory_unlock\(\$1\)`
+
+	t.Run("Success: Lock Acquired And Released", func(t *testing.T) {
+		db, mock, repo := setupScheduleTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(lockQuery).WithArgs(schedulerLockKey).
+			WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(true))
+		mock.ExpectExec(unlockQuery).WithArgs(schedulerLockKey).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		var ran bool
+		acquired, err := repo.WithLeaderLock(context.Background(), func(context.Context) error {
+			ran = true
+			return nil
+		})
+		require.NoError(t, err)
+		assert.True(t, acquired)
+		assert.True(t, ran)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Another Instance Holds The Lock", func(t *testing.T) {
+		db, mock, repo := setupScheduleTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(lockQuery).WithArgs(schedulerLockKey).
+			WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(false))
+
+		acquired, err := repo.WithLeaderLock(context.Background(), func(context.Context) error {
+			t.Fatal("fn must not run without the lock")
+			return nil
+		})
+		require.NoError(t, err)
+		assert.False(t, acquired)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Lock Released When fn Fails", func(t *testing.T) {
+		db, mock, repo := setupScheduleTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(lockQuery).WithArgs(schedulerLockKey).
+			WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(true))
+		mock.ExpectExec(unlockQuery).WithArgs(schedulerLockKey).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		boom := errors.New("boom")
+		acquired, err := repo.WithLeaderLock(context.Background(), func(context.Context) error { return boom })
+		assert.True(t, acquired)
+		assert.ErrorIs(t, err, boom)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// GetStatement builds the statement of q.AccountID from the transfers posted
+// to it after q.From up to q.To. The opening balance and the lines are read in
+// one read-only REPEATABLE READ transaction, so the running balance of the
+// last line is the closing balance even while transfers keep posting.
+func (r *AccountRepository) GetStatement(ctx context.Context, q models.StatementQuery) (*models.Statement, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		r.log.Error("failed to begin statement tx", zap.Error(err))
+		return nil, constants.ErrSystem
+	}
+	defer func(tx *sql.Tx) {
+		_ = tx.Rollback()
+	}(tx)
+
+	st := models.Statement{AccountID: q.AccountID, From: q.From, To: q.To}
+	err = tx.QueryRowContext(ctx, "SELECT opening_balance, currency FROM accounts WHERE account_id = $1", q.AccountID).
+		Scan(&st.OpeningBalance, &st.Currency)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, constants.ErrAccountNotFound
+		}
+		r.log.Error("Failed to get account", zap.Int64("account_id", q.AccountID), zap.Error(err))
+		return nil, constants.ErrSystem
+	}
+
+	if _, err := lastPosting(ctx, tx, q.AccountID, q.From, &st.OpeningBalance); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		r.log.Error("Failed to get opening balance", zap.Int64("account_id", q.AccountID), zap.Error(err))
+		return nil, constants.ErrSystem
+	}
+	st.ClosingBalance = st.OpeningBalance
+
+	if err := r.addStatementLines(ctx, tx, &st); err != nil {
+		r.log.Error("Failed to list statement lines", zap.Int64("account_id", q.AccountID), zap.Error(err))
+		return nil, constants.ErrSystem
+	}
+
+	return &st, nil
+}
+
+// addStatementLines scans both legs through idx_transfers_source_posted and
+// idx_transfers_dest_posted and merges them in posting order.
+func (r *AccountRepository) addStatementLines(ctx context.Context, tx *sql.Tx, st *models.Statement) error {
+	const columns = `transfer_id, source_account_id, destination_account_id, amount, destination_amount,
+            source_post_balance, destination_post_balance, kind, COALESCE(fee_of, 0), COALESCE(reversal_of, 0),
+            posted_at, posting_seq`
+
+	rows, err := tx.QueryContext(ctx, `
+        SELECT `+columns+` FROM transfers WHERE source_account_id = $1 AND posted_at > $2 AND posted_at <= $3
+        UNION ALL
+        SELECT `+columns+` FROM transfers WHERE destination_account_id = $1 AND posted_at > $2 AND posted_at <= $3
+        ORDER BY posted_at, posting_seq`,
+		st.AccountID, st.From, st.To)
+	if err != nil {
+		return fmt.Errorf("query statement lines failed: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			line                          models.StatementLine
+			srcID, destID                 int64
+			amount, destAmount            decimal.Decimal
+			srcPost, destPost             decimal.Decimal
+			kind                          constants.TransferKind
+			feeOf, reversalOf, postingSeq int64
+			postedAt                      time.Time
+		)
+		if err := rows.Scan(&line.TransferID, &srcID, &destID, &amount, &destAmount, &srcPost, &destPost,
+			&kind, &feeOf, &reversalOf, &postedAt, &postingSeq); err != nil {
+			return fmt.Errorf("scan statement line failed: %w", err)
+		}
+
+		line.PostedAt = postedAt
+		line.Type = models.StatementLineType(kind, feeOf, reversalOf)
+		if srcID == st.AccountID {
+			line.CounterpartyID, line.Debit, line.Balance = destID, amount, srcPost
+		} else {
+			line.CounterpartyID, line.Credit, line.Balance = srcID, destAmount, destPost
+		}
+		st.Add(line)
+	}
+
+	return rows.Err()
+}
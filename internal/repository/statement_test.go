@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+func TestAccountRepository_GetStatement(t *testing.T) {
+	q := models.StatementQuery{
+		AccountID: 101,
+		From:      time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		To:        time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+	}
+	const (
+		openingQuery = `SELECT transfer_id, balance FROM \(.*posted_at <= \$2`
+		linesQuery   = `SELECT transfer_id, source_account_id, .* FROM transfers WHERE source_account_id = \$1 AND posted_at > \$2 AND posted_at <= \$3\s+UNION ALL`
+	)
+	lineRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{
+			"transfer_id", "source_account_id", "destination_account_id", "amount", "destination_amount",
+			"source_post_balance", "destination_post_balance", "kind", "fee_of", "reversal_of", "posted_at", "posting_seq",
+		})
+	}
+
+	t.Run("Success: Running Balance From Last Posting Before Range", func(t *testing.T) {
+		db, mock, repo := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT opening_balance, currency FROM accounts WHERE account_id = \$1`).WithArgs(q.AccountID).
+			WillReturnRows(sqlmock.NewRows([]string{"opening_balance", "currency"}).AddRow(decimal.NewFromInt(500), "USD"))
+		mock.ExpectQuery(openingQuery).WithArgs(q.AccountID, q.From).
+			WillReturnRows(sqlmock.NewRows([]string{"transfer_id", "balance"}).AddRow(int64(7), decimal.NewFromInt(300)))
+		mock.ExpectQuery(linesQuery).WithArgs(q.AccountID, q.From, q.To).
+			WillReturnRows(lineRows().
+				AddRow(int64(10), int64(101), int64(202), decimal.NewFromInt(50), decimal.NewFromInt(50),
+					decimal.NewFromInt(250), decimal.NewFromInt(50), constants.KindTransfer, int64(0), int64(0), q.From.Add(time.Hour), int64(1)).
+				AddRow(int64(11), int64(101), int64(900), decimal.NewFromInt(1), decimal.NewFromInt(1),
+					decimal.NewFromInt(249), decimal.NewFromInt(1), constants.KindTransfer, int64(10), int64(0), q.From.Add(time.Hour), int64(2)).
+				AddRow(int64(12), int64(800), int64(101), decimal.NewFromInt(100), decimal.NewFromInt(100),
+					decimal.NewFromInt(-100), decimal.NewFromInt(349), constants.KindDeposit, int64(0), int64(0), q.From.Add(2*time.Hour), int64(3)))
+		mock.ExpectRollback()
+
+		st, err := repo.GetStatement(context.Background(), q)
+
+		require.NoError(t, err)
+		assert.Equal(t, "300", st.OpeningBalance.String())
+		assert.Equal(t, "349", st.ClosingBalance.String())
+		assert.Equal(t, "51", st.TotalDebits.String())
+		assert.Equal(t, "100", st.TotalCredits.String())
+		require.Len(t, st.Lines, 3)
+		assert.Equal(t, int64(202), st.Lines[0].CounterpartyID)
+		assert.Equal(t, "50", st.Lines[0].Debit.String())
+		assert.Equal(t, models.StatementLineFee, st.Lines[1].Type)
+		assert.Equal(t, "DEPOSIT", st.Lines[2].Type)
+		assert.Equal(t, "100", st.Lines[2].Credit.String())
+		assert.Equal(t, int64(800), st.Lines[2].CounterpartyID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Opening Balance When Nothing Posted Before Range", func(t *testing.T) {
+		db, mock, repo := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT opening_balance, currency FROM accounts`).WithArgs(q.AccountID).
+			WillReturnRows(sqlmock.NewRows([]string{"opening_balance", "currency"}).AddRow(decimal.NewFromInt(500), "USD"))
+		mock.ExpectQuery(openingQuery).WithArgs(q.AccountID, q.From).
+			WillReturnRows(sqlmock.NewRows([]string{"transfer_id", "balance"}))
+		mock.ExpectQuery(linesQuery).WithArgs(q.AccountID, q.From, q.To).WillReturnRows(lineRows())
+		mock.ExpectRollback()
+
+		st, err := repo.GetStatement(context.Background(), q)
+
+		require.NoError(t, err)
+		assert.Equal(t, "500", st.OpeningBalance.String())
+		assert.Equal(t, "500", st.ClosingBalance.String())
+		assert.Empty(t, st.Lines)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Account Not Found", func(t *testing.T) {
+		db, mock, repo := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT opening_balance, currency FROM accounts`).WithArgs(q.AccountID).
+			WillReturnRows(sqlmock.NewRows([]string{"opening_balance", "currency"}))
+		mock.ExpectRollback()
+
+		_, err := repo.GetStatement(context.Background(), q)
+
+		assert.ErrorIs(t, err, constants.ErrAccountNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -3,118 +3,590 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
 	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
 )
 
-type contextKey string
+const (
+	pgUniqueViolation        = "23505"
+	idempotencyKeyConstraint = "uq_transfers_idempotency_key"
+)
 
-const CorrelationKey contextKey = "correlation_id"
+var errIdempotencyKeyTaken = errors.New("idempotency key taken by concurrent transfer")
 
 type TransferRepository struct {
-	db  *sql.DB
-	log *zap.Logger
+	db     *sql.DB
+	ledger *LedgerRepository
+	policy TxPolicy
+	limits models.LimitDefaults
+	log    *zap.Logger
 }
 
-func NewTransferRepository(db *sql.DB, log *zap.Logger) *TransferRepository {
-	return &TransferRepository{db: db, log: log}
+// NewTransferRepository enforces limits on every account without its own row
+// in account_limits, and for the limits that row leaves unset.
+func NewTransferRepository(db *sql.DB, policy TxPolicy, limits models.LimitDefaults, log *zap.Logger) *TransferRepository {
+	return &TransferRepository{db: db, ledger: NewLedgerRepository(db, log), policy: policy, limits: limits, log: log}
 }
 
 func (r *TransferRepository) Transfer(ctx context.Context, req *models.TransferRequest) (*models.TransferResult, error) {
+	if req.IdempotencyKey != "" {
+		result, err := r.replay(ctx, req)
+		if err != nil || result != nil {
+			return result, err
+		}
+	}
+
+	result, err := r.transfer(ctx, req)
+	switch {
+	case errors.Is(err, errIdempotencyKeyTaken):
+		// A concurrent request with the same key committed first; answer with its result.
+		result, err = r.replay(ctx, req)
+		if err == nil && result == nil {
+			return nil, constants.ErrSystem
+		}
+	case err != nil && !errors.Is(err, constants.ErrSystem):
+		r.recordFailure(ctx, req, err)
+	}
+
+	return result, err
+}
+
+// recordFailure writes a TransferFailed event for a transfer rejected by the
+// checks made under lock. The rejecting transaction was rolled back, so the
+// event is written on its own; failing to write it does not change the answer.
+func (r *TransferRepository) recordFailure(ctx context.Context, req *models.TransferRequest, reason error) {
+	correlationID, _ := ctx.Value(constants.CorrelationKey).(int64)
+	if err := insertEvent(ctx, r.db, models.NewTransferFailedEvent(req, correlationID, reason)); err != nil {
+		r.log.Error("failed to write transfer failed event",
+			zap.Int64("correlation_id", correlationID),
+			zap.Error(err))
+	}
+}
+
+// replay returns the stored result of a transfer previously executed with
+// req.IdempotencyKey, or nil if the key has not been used yet.
+func (r *TransferRepository) replay(ctx context.Context, req *models.TransferRequest) (*models.TransferResult, error) {
+	var (
+		stored     models.TransferRequest
+		result     models.TransferResult
+		srcPost    decimal.Decimal
+		destPost   decimal.Decimal
+		destAmount decimal.Decimal
+		fee        decimal.Decimal
+		fxRate     decimal.NullDecimal
+		createdAt  time.Time
+	)
 
-	correlationID, _ := ctx.Value(CorrelationKey).(int64)
+	err := r.db.QueryRowContext(ctx, `
+        SELECT transfer_id, correlation_id, source_account_id, destination_account_id,
+               amount, currency, destination_amount, destination_currency, fx_rate,
+               source_post_balance, destination_post_balance, fee, created_at
+        FROM transfers WHERE idempotency_key = $1`,
+		req.IdempotencyKey,
+	).Scan(&result.AuditID, &result.CorrelationID, &stored.SourceID, &stored.DestinationID,
+		&stored.Amount, &stored.Currency, &destAmount, &result.DestinationCurrency, &fxRate,
+		&srcPost, &destPost, &fee, &createdAt)
 
-	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
 	if err != nil {
-		r.log.Error("failed to begin tx", zap.Error(err))
+		r.log.Error("failed to look up idempotency key", zap.Error(err))
 		return nil, constants.ErrSystem
 	}
-	defer func(tx *sql.Tx) {
-		err := tx.Rollback()
-		if err != nil {
-			r.log.Error("failed to rollback tx", zap.Error(err))
-		}
-	}(tx)
 
-	firstID, secondID := req.SourceID, req.DestinationID
-	if firstID > secondID {
-		firstID, secondID = secondID, firstID
+	// The stored row only says whether a conversion happened, so a same-currency
+	// transfer replays for requests with or without the convert flag.
+	stored.Convert = fxRate.Valid || req.Convert
+	if !stored.SamePayload(req) {
+		r.log.Warn("idempotency key reused with different payload",
+			zap.String("idempotency_key", req.IdempotencyKey),
+			zap.Int64("audit_id", result.AuditID))
+		return nil, constants.ErrIdempotencyKeyReused
 	}
 
-	var srcPre, destPre decimal.Decimal
+	r.log.Info("replaying idempotent transfer",
+		zap.String("idempotency_key", req.IdempotencyKey),
+		zap.Int64("audit_id", result.AuditID))
 
-	err = tx.QueryRowContext(ctx, "SELECT balance FROM accounts WHERE account_id = $1 FOR UPDATE", req.SourceID).Scan(&srcPre)
+	result.Status = "SUCCESS"
+	// The fee is posted right after the transfer, under the same lock.
+	result.SourcePostBalance = srcPost.Sub(fee).String()
+	result.DestinationPostBalance = destPost.String()
+	result.SourceAmount = stored.Amount.String()
+	result.SourceCurrency = stored.Currency
+	result.DestinationAmount = destAmount.String()
+	result.FxRate = models.NullDecimalString(fxRate)
+	if fee.IsPositive() {
+		result.Fee = fee.String()
+	}
+	result.CreatedAt = createdAt
+	return &result, nil
+}
+
+func (r *TransferRepository) transfer(ctx context.Context, req *models.TransferRequest) (*models.TransferResult, error) {
+	var result *models.TransferResult
+	err := r.runTx(ctx, "transfer", func(tx *sql.Tx) error {
+		var err error
+		result, err = r.transferTx(ctx, tx, req)
+		return err
+	})
 	if err != nil {
-		return nil, constants.ErrAccountNotFound
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *TransferRepository) transferTx(ctx context.Context, tx *sql.Tx, req *models.TransferRequest) (*models.TransferResult, error) {
+	if req.Fee != nil {
+		return r.transferWithFeeTx(ctx, tx, req)
+	}
+
+	src, dest, err := lockAccounts(ctx, tx, req.SourceID, req.DestinationID)
+	if err != nil {
+		return nil, err
 	}
 
-	err = tx.QueryRowContext(ctx, "SELECT balance FROM accounts WHERE account_id = $1 FOR UPDATE", req.DestinationID).Scan(&destPre)
+	destAmount, err := checkTransfer(req, src, dest)
 	if err != nil {
+		return nil, err
+	}
+
+	// A deposit draws on the settlement account, which no limit applies to.
+	if req.Kind != constants.KindDeposit {
+		if err := r.checkLimits(ctx, tx, src, req.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.postTransfer(ctx, tx, req, src, dest, destAmount)
+}
+
+// transferWithFeeTx posts the transfer and then its fee, as a second transfer
+// from the source to the fee account marked fee_of the first, so the ledger
+// and the balance chains the reconciler replays cover the fee too. Limits
+// apply to the amount alone.
+func (r *TransferRepository) transferWithFeeTx(ctx context.Context, tx *sql.Tx, req *models.TransferRequest) (*models.TransferResult, error) {
+	locked, err := lockAccountSet(ctx, tx, []int64{req.SourceID, req.DestinationID, req.Fee.AccountID})
+	if err != nil {
+		return nil, err
+	}
+
+	src, dest, feeAcc := locked[req.SourceID], locked[req.DestinationID], locked[req.Fee.AccountID]
+	if src == nil || dest == nil {
 		return nil, constants.ErrAccountNotFound
 	}
+	if err := r.checkFeeAccount(req, src, feeAcc); err != nil {
+		return nil, err
+	}
 
-	if srcPre.LessThan(req.Amount) {
-		return nil, constants.ErrInsufficientFunds
+	destAmount, err := checkTransfer(req, src, dest)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.checkLimits(ctx, tx, src, req.Amount); err != nil {
+		return nil, err
+	}
+
+	result, err := r.postTransfer(ctx, tx, req, src, dest, destAmount)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.postFee(ctx, tx, req, result, src, feeAcc); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// checkFeeAccount verifies the locked fee account can take req's fee from src.
+func (r *TransferRepository) checkFeeAccount(req *models.TransferRequest, src, feeAcc *models.Account) error {
+	if feeAcc == nil || feeAcc.Currency != src.Currency || feeAcc.CheckCredit() != nil {
+		r.log.Error("fee account unusable",
+			zap.Int64("fee_account_id", req.Fee.AccountID),
+			zap.String("currency", src.Currency))
+		return constants.ErrFeeAccountMissing
+	}
+	return nil
+}
+
+// postFee posts req's fee, once req itself is posted as result, and reports it
+// on result with the source balance after it.
+func (r *TransferRepository) postFee(ctx context.Context, tx *sql.Tx, req *models.TransferRequest, result *models.TransferResult, src, feeAcc *models.Account) error {
+	feeReq := &models.TransferRequest{
+		SourceID:      req.SourceID,
+		DestinationID: req.Fee.AccountID,
+		Amount:        req.Fee.Amount,
+		FeeOf:         result.AuditID,
+	}
+	if _, err := r.postTransfer(ctx, tx, feeReq, src, feeAcc, req.Fee.Amount); err != nil {
+		return err
+	}
+
+	result.Fee = req.Fee.Amount.String()
+	result.SourcePostBalance = src.Balance.String()
+	return nil
+}
+
+// checkTransfer verifies req against the locked accounts and returns the amount
+// to credit the destination. It writes nothing, so a failure leaves the
+// transaction usable for other work.
+func checkTransfer(req *models.TransferRequest, src, dest *models.Account) (decimal.Decimal, error) {
+	if err := src.CheckDebit(); err != nil {
+		return decimal.Zero, err
+	}
+
+	if err := dest.CheckCredit(); err != nil {
+		return decimal.Zero, err
+	}
+
+	if err := req.CheckCurrency(src, dest); err != nil {
+		return decimal.Zero, err
+	}
+
+	destAmount := req.Amount
+	if src.Currency != dest.Currency {
+		q := req.Quote
+		if q == nil || q.SourceCurrency != src.Currency || q.DestinationCurrency != dest.Currency {
+			return decimal.Zero, constants.ErrCurrencyMismatch
+		}
+		destAmount = q.DestinationAmount
+	}
+
+	if !src.CanWithdraw(req.Debit()) {
+		return decimal.Zero, constants.ErrInsufficientFunds
+	}
+
+	return destAmount, nil
+}
+
+// postTransfer writes a transfer already passed by checkTransfer and moves the
+// in-memory balances of src and dest along with the stored ones.
+func (r *TransferRepository) postTransfer(ctx context.Context, tx *sql.Tx, req *models.TransferRequest, src, dest *models.Account, destAmount decimal.Decimal) (*models.TransferResult, error) {
+
+	correlationID, _ := ctx.Value(constants.CorrelationKey).(int64)
+
+	var (
+		fxRateID sql.NullInt64
+		fxRate   decimal.NullDecimal
+	)
+	if src.Currency != dest.Currency {
+		fxRateID = sql.NullInt64{Int64: req.Quote.RateID, Valid: true}
+		fxRate = decimal.NullDecimal{Decimal: req.Quote.Rate, Valid: true}
+	}
+
+	srcPre, destPre := src.Balance, dest.Balance
+
+	kind := req.Kind
+	if kind == 0 {
+		kind = constants.KindTransfer
 	}
 
 	var transferID int64
 	var createdAt time.Time
-	err = tx.QueryRowContext(ctx, `
+	err := tx.QueryRowContext(ctx, `
         INSERT INTO transfers (
             source_account_id, destination_account_id, amount, 
-            correlation_id, status, source_prev_balance, destination_prev_balance
+            correlation_id, status, source_prev_balance, destination_prev_balance,
+            idempotency_key, currency, destination_amount, destination_currency,
+            fx_rate_id, fx_rate, group_id, fee, fee_of, kind
         )
-        VALUES ($1, $2, $3, $4, $5, $6, $7) 
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
         RETURNING transfer_id, created_at`,
 		req.SourceID, req.DestinationID, req.Amount, correlationID,
-		constants.StatusPending, srcPre, destPre,
+		constants.StatusPending, srcPre, destPre, nullableString(req.IdempotencyKey), src.Currency,
+		destAmount, dest.Currency, fxRateID, fxRate, sql.NullInt64{Int64: req.GroupID, Valid: req.GroupID != 0},
+		req.FeeAmount(), sql.NullInt64{Int64: req.FeeOf, Valid: req.FeeOf != 0}, kind,
 	).Scan(&transferID, &createdAt)
 
 	if err != nil {
+		if isUniqueViolation(err, idempotencyKeyConstraint) {
+			return nil, errIdempotencyKeyTaken
+		}
 		r.log.Error("failed to create audit log", zap.Error(err))
-		return nil, constants.ErrSystem
+		return nil, systemError(err)
 	}
 
 	var srcPost, destPost decimal.Decimal
 	srcPost = srcPre.Sub(req.Amount)
-	destPost = destPre.Add(req.Amount)
+	destPost = destPre.Add(destAmount)
 
 	_, err = tx.ExecContext(ctx, "UPDATE accounts SET balance = $1 WHERE account_id = $2", srcPost, req.SourceID)
 	if err != nil {
-		return nil, constants.ErrSystem
+		return nil, systemError(err)
 	}
 
 	_, err = tx.ExecContext(ctx, "UPDATE accounts SET balance = $1 WHERE account_id = $2", destPost, req.DestinationID)
 	if err != nil {
-		return nil, constants.ErrSystem
+		return nil, systemError(err)
+	}
+
+	journal := models.NewTransferJournal(transferID, req.SourceID, req.DestinationID, req.Amount, src.Currency)
+	if fxRate.Valid {
+		journal = models.NewFxTransferJournal(transferID, req.SourceID, req.DestinationID, req.Quote, req.Amount)
+	}
+	if err := r.ledger.Post(ctx, tx, journal); err != nil {
+		return nil, err
 	}
 
 	_, err = tx.ExecContext(ctx, `
         UPDATE transfers SET 
             status = $1,
             source_post_balance = $2, 
-            destination_post_balance = $3
+            destination_post_balance = $3,
+            posting_seq = nextval('transfer_posting_seq'),
+            posted_at = clock_timestamp()
         WHERE transfer_id = $4`,
 		constants.StatusCompleted, srcPost, destPost, transferID,
 	)
 	if err != nil {
 		r.log.Error("failed to finalize audit", zap.Error(err))
-		return nil, constants.ErrSystem
+		return nil, systemError(err)
 	}
 
-	if err := tx.Commit(); err != nil {
-		return nil, constants.ErrSystem
+	err = insertEvent(ctx, tx, models.NewTransferCompletedEvent(&models.Transfer{
+		ID:                     transferID,
+		CorrelationID:          correlationID,
+		SourceID:               req.SourceID,
+		DestinationID:          req.DestinationID,
+		Amount:                 req.Amount,
+		Currency:               src.Currency,
+		DestinationAmount:      destAmount,
+		DestinationCurrency:    dest.Currency,
+		SourcePostBalance:      srcPost,
+		DestinationPostBalance: destPost,
+		GroupID:                req.GroupID,
+		FeeOf:                  req.FeeOf,
+		Kind:                   kind,
+	}))
+	if err != nil {
+		r.log.Error("failed to write transfer event", zap.Int64("transfer_id", transferID), zap.Error(err))
+		return nil, systemError(err)
 	}
 
+	src.Balance, dest.Balance = srcPost, destPost
+
 	return &models.TransferResult{
-		AuditID:           transferID,
-		CorrelationID:     correlationID,
-		Status:            "SUCCESS",
-		SourcePostBalance: srcPost.String(),
-		CreatedAt:         createdAt,
+		AuditID:                transferID,
+		CorrelationID:          correlationID,
+		Status:                 "SUCCESS",
+		SourceID:               req.SourceID,
+		DestinationID:          req.DestinationID,
+		SourcePostBalance:      srcPost.String(),
+		DestinationPostBalance: destPost.String(),
+		SourceAmount:           req.Amount.String(),
+		SourceCurrency:         src.Currency,
+		DestinationAmount:      destAmount.String(),
+		DestinationCurrency:    dest.Currency,
+		FxRate:                 models.NullDecimalString(fxRate),
+		CreatedAt:              createdAt,
 	}, nil
 }
+
+// lockAccounts reads two accounts FOR UPDATE in a single statement and returns
+// them in argument order.
+func lockAccounts(ctx context.Context, tx *sql.Tx, firstID, secondID int64) (*models.Account, *models.Account, error) {
+	locked, err := lockAccountSet(ctx, tx, []int64{firstID, secondID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	first, second := locked[firstID], locked[secondID]
+	if first == nil || second == nil {
+		return nil, nil, constants.ErrAccountNotFound
+	}
+	return first, second, nil
+}
+
+// lockAccountSet reads the given accounts FOR UPDATE in a single statement,
+// keyed by ID; IDs without a row are left out. Rows are locked in account_id
+// order whatever the argument order, so transactions locking overlapping sets
+// of accounts cannot deadlock.
+func lockAccountSet(ctx context.Context, tx *sql.Tx, ids []int64) (map[int64]*models.Account, error) {
+	ids = slices.Compact(slices.Sorted(slices.Values(ids)))
+	placeholders, args := inList(ids)
+
+	rows, err := tx.QueryContext(ctx, `
+        SELECT account_id, balance, held_balance, currency, status, overdraft_limit FROM accounts
+        WHERE account_id IN (`+placeholders+`) ORDER BY account_id FOR UPDATE`,
+		args...)
+	if err != nil {
+		return nil, systemError(err)
+	}
+	defer rows.Close()
+
+	locked := make(map[int64]*models.Account, len(ids))
+	for rows.Next() {
+		var acc models.Account
+		if err := rows.Scan(&acc.ID, &acc.Balance, &acc.HeldBalance, &acc.Currency, &acc.Status, &acc.OverdraftLimit); err != nil {
+			return nil, systemError(err)
+		}
+		locked[acc.ID] = &acc
+	}
+	if err := rows.Err(); err != nil {
+		return nil, systemError(err)
+	}
+	return locked, nil
+}
+
+// inList returns "$1, $2, ..." and the matching args for an IN (...) clause.
+func inList(ids []int64) (string, []any) {
+	placeholders := make([]string, len(ids))
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = id
+	}
+	return strings.Join(placeholders, ", "), args
+}
+
+// GetAccounts reads the committed rows of the given accounts, without locking
+// them, for refreshing the account cache. IDs without a row are left out.
+func (r *TransferRepository) GetAccounts(ctx context.Context, ids []int64) ([]models.Account, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	placeholders, args := inList(ids)
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+accountColumns+` FROM accounts WHERE account_id IN (`+placeholders+`) ORDER BY account_id`, args...)
+	if err != nil {
+		r.log.Error("Failed to read accounts", zap.Int64s("account_ids", ids), zap.Error(err))
+		return nil, fmt.Errorf("get accounts failed: %w", err)
+	}
+	defer rows.Close()
+
+	var accounts []models.Account
+	for rows.Next() {
+		acc, err := scanAccount(rows)
+		if err != nil {
+			return nil, fmt.Errorf("get accounts failed: %w", err)
+		}
+		accounts = append(accounts, *acc)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get accounts failed: %w", err)
+	}
+	return accounts, nil
+}
+
+func nullableString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation && pgErr.ConstraintName == constraint
+}
+
+const transferColumns = `transfer_id, correlation_id, status, source_account_id, destination_account_id, amount, currency,
+        destination_amount, destination_currency, COALESCE(fx_rate_id, 0), fx_rate,
+        source_prev_balance, source_post_balance, destination_prev_balance, destination_post_balance,
+        COALESCE(reversal_of, 0), reversed_amount, COALESCE(reason, ''), COALESCE(group_id, 0),
+        fee, COALESCE(fee_of, 0), kind, created_at`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanTransfer(row rowScanner, extra ...any) (*models.Transfer, error) {
+	var t models.Transfer
+	dest := append([]any{&t.ID, &t.CorrelationID, &t.Status, &t.SourceID, &t.DestinationID, &t.Amount, &t.Currency,
+		&t.DestinationAmount, &t.DestinationCurrency, &t.FxRateID, &t.FxRate,
+		&t.SourcePrevBalance, &t.SourcePostBalance, &t.DestinationPrevBalance, &t.DestinationPostBalance,
+		&t.ReversalOf, &t.ReversedAmount, &t.Reason, &t.GroupID,
+		&t.Fee, &t.FeeOf, &t.Kind, &t.CreatedAt}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *TransferRepository) GetTransfer(ctx context.Context, id int64) (*models.Transfer, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+transferColumns+` FROM transfers WHERE transfer_id = $1`, id)
+
+	t, err := scanTransfer(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, constants.ErrTransferNotFound
+		}
+		r.log.Error("Failed to get transfer", zap.Int64("transfer_id", id), zap.Error(err))
+		return nil, fmt.Errorf("get transfer failed: %w", err)
+	}
+
+	return t, nil
+}
+
+// ListTransfers pages through an account's transfers newest first using keyset
+// pagination on transfer_id, served by idx_transfers_source / idx_transfers_dest.
+func (r *TransferRepository) ListTransfers(ctx context.Context, q models.TransferQuery) (*models.TransferPage, error) {
+	var (
+		where []string
+		args  = []any{q.AccountID}
+	)
+
+	switch q.Direction {
+	case models.DirectionOutgoing:
+		where = append(where, "source_account_id = $1")
+	case models.DirectionIncoming:
+		where = append(where, "destination_account_id = $1")
+	default:
+		where = append(where, "(source_account_id = $1 OR destination_account_id = $1)")
+	}
+
+	if q.Cursor > 0 {
+		args = append(args, q.Cursor)
+		where = append(where, fmt.Sprintf("transfer_id < $%d", len(args)))
+	}
+	if !q.From.IsZero() {
+		args = append(args, q.From)
+		where = append(where, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if !q.To.IsZero() {
+		args = append(args, q.To)
+		where = append(where, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+
+	// Fetch one extra row to learn whether another page exists.
+	args = append(args, q.Limit+1)
+	query := fmt.Sprintf(`SELECT %s FROM transfers WHERE %s ORDER BY transfer_id DESC LIMIT $%d`,
+		transferColumns, strings.Join(where, " AND "), len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.log.Error("Failed to list transfers", zap.Int64("account_id", q.AccountID), zap.Error(err))
+		return nil, fmt.Errorf("list transfers failed: %w", err)
+	}
+	defer rows.Close()
+
+	page := &models.TransferPage{}
+	for rows.Next() {
+		t, err := scanTransfer(rows)
+		if err != nil {
+			r.log.Error("Row scan failed", zap.Error(err))
+			return nil, fmt.Errorf("list transfers failed: %w", err)
+		}
+		page.Transfers = append(page.Transfers, *t)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Row iteration error", zap.Error(err))
+		return nil, fmt.Errorf("list transfers failed: %w", err)
+	}
+
+	if len(page.Transfers) > q.Limit {
+		page.Transfers = page.Transfers[:q.Limit]
+		page.NextCursor = page.Transfers[q.Limit-1].ID
+	}
+
+	return page, nil
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -22,11 +23,36 @@ func setupTransferTest(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *TransferReposit
 	require.NoError(t, err)
 
 	logger := zap.NewNop()
-	repo := NewTransferRepository(db, logger)
+	repo := NewTransferRepository(db, DefaultTxPolicy(), models.LimitDefaults{}, logger)
 
 	return db, mock, repo
 }
 
+const lockAccountsQuery = `SELECT account_id, balance, held_balance, currency, status, overdraft_limit FROM accounts WHERE account_id IN \(\$1, \$2\) ORDER BY account_id FOR UPDATE`
+
+// expectNoLimits expects the limits lookup for an account without its own
+// limits, under a repository without defaults.
+func expectNoLimits(mock sqlmock.Sqlmock, accountID int64) {
+	mock.ExpectQuery(`FROM account_limits WHERE account_id = \$1`).WithArgs(accountID).WillReturnError(sql.ErrNoRows)
+}
+
+func accountRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"account_id", "balance", "held_balance", "currency", "status", "overdraft_limit"})
+}
+
+// expectLockAccounts expects both accounts to be locked in one statement, in
+// account_id order whatever order they are given in.
+func expectLockAccounts(mock sqlmock.Sqlmock, a, b models.Account) {
+	if a.ID > b.ID {
+		a, b = b, a
+	}
+	mock.ExpectQuery(lockAccountsQuery).
+		WithArgs(a.ID, b.ID).
+		WillReturnRows(accountRows().
+			AddRow(a.ID, a.Balance, a.HeldBalance, a.Currency, a.Status, a.OverdraftLimit).
+			AddRow(b.ID, b.Balance, b.HeldBalance, b.Currency, b.Status, b.OverdraftLimit))
+}
+
 func TestTransferRepository_Transfer(t *testing.T) {
 
 	req := &models.TransferRequest{
@@ -40,23 +66,21 @@ func TestTransferRepository_Transfer(t *testing.T) {
 		db, mock, repo := setupTransferTest(t)
 		defer db.Close()
 
-		ctx := context.WithValue(context.Background(), CorrelationKey, correlationID)
+		ctx := context.WithValue(context.Background(), constants.CorrelationKey, correlationID)
 
 		mock.ExpectBegin()
 
-		mock.ExpectQuery(`SELECT balance FROM accounts WHERE account_id = \$1 FOR UPDATE`).
-			WithArgs(req.SourceID).
-			WillReturnRows(sqlmock.NewRows([]string{"balance"}).AddRow(decimal.NewFromFloat(1000.0)))
-
-		mock.ExpectQuery(`SELECT balance FROM accounts WHERE account_id = \$1 FOR UPDATE`).
-			WithArgs(req.DestinationID).
-			WillReturnRows(sqlmock.NewRows([]string{"balance"}).AddRow(decimal.NewFromFloat(500.0)))
+		expectLockAccounts(mock,
+			models.Account{ID: req.SourceID, Balance: decimal.NewFromFloat(1000.0), Currency: "USD", Status: constants.AccountActive},
+			models.Account{ID: req.DestinationID, Balance: decimal.NewFromFloat(500.0), Currency: "USD", Status: constants.AccountActive})
+		expectNoLimits(mock, req.SourceID)
 
 		mock.ExpectQuery(`INSERT INTO transfers`).
 			WithArgs(
 				req.SourceID, req.DestinationID, req.Amount, correlationID,
 				constants.StatusPending,
-				decimal.NewFromFloat(1000.0), decimal.NewFromFloat(500.0),
+				decimal.NewFromFloat(1000.0), decimal.NewFromFloat(500.0), nil, "USD",
+				req.Amount, "USD", nil, nil, nil, decimal.Zero, nil, constants.KindTransfer,
 			).
 			WillReturnRows(sqlmock.NewRows([]string{"transfer_id", "created_at"}).AddRow(1, time.Now()))
 
@@ -68,6 +92,10 @@ func TestTransferRepository_Transfer(t *testing.T) {
 			WithArgs(decimal.NewFromFloat(550.0), req.DestinationID).
 			WillReturnResult(sqlmock.NewResult(0, 1))
 
+		mock.ExpectExec(`INSERT INTO ledger_entries \(transfer_id, account_id, amount, currency\) VALUES \(\$1, \$2, \$3, \$4\), \(\$5, \$6, \$7, \$8\)`).
+			WithArgs(int64(1), req.SourceID, req.Amount.Neg(), "USD", int64(1), req.DestinationID, req.Amount, "USD").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
 		mock.ExpectExec(`UPDATE transfers SET status = \$1`).
 			WithArgs(
 				constants.StatusCompleted,
@@ -75,6 +103,7 @@ func TestTransferRepository_Transfer(t *testing.T) {
 				int64(1),
 			).
 			WillReturnResult(sqlmock.NewResult(0, 1))
+		expectEvent(mock, req.SourceID, models.EventTransferCompleted)
 
 		mock.ExpectCommit()
 
@@ -90,9 +119,9 @@ func TestTransferRepository_Transfer(t *testing.T) {
 
 		mock.ExpectBegin()
 
-		mock.ExpectQuery(`SELECT balance FROM accounts WHERE account_id = \$1 FOR UPDATE`).
-			WithArgs(req.SourceID).
-			WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery(lockAccountsQuery).
+			WithArgs(req.SourceID, req.DestinationID).
+			WillReturnRows(accountRows().AddRow(req.DestinationID, decimal.NewFromFloat(500.0), decimal.Zero, "USD", constants.AccountActive, decimal.Zero))
 
 		mock.ExpectRollback()
 
@@ -108,15 +137,12 @@ func TestTransferRepository_Transfer(t *testing.T) {
 
 		mock.ExpectBegin()
 
-		mock.ExpectQuery(`SELECT balance FROM accounts WHERE account_id = \$1 FOR UPDATE`).
-			WithArgs(req.SourceID).
-			WillReturnRows(sqlmock.NewRows([]string{"balance"}).AddRow(decimal.NewFromFloat(40.0)))
-
-		mock.ExpectQuery(`SELECT balance FROM accounts WHERE account_id = \$1 FOR UPDATE`).
-			WithArgs(req.DestinationID).
-			WillReturnRows(sqlmock.NewRows([]string{"balance"}).AddRow(decimal.NewFromFloat(500.0)))
+		expectLockAccounts(mock,
+			models.Account{ID: req.SourceID, Balance: decimal.NewFromFloat(40.0), Currency: "USD", Status: constants.AccountActive},
+			models.Account{ID: req.DestinationID, Balance: decimal.NewFromFloat(500.0), Currency: "USD", Status: constants.AccountActive})
 
 		mock.ExpectRollback()
+		expectEvent(mock, req.SourceID, models.EventTransferFailed)
 
 		_, err := repo.Transfer(context.Background(), req)
 
@@ -124,15 +150,170 @@ func TestTransferRepository_Transfer(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
+	t.Run("Success: Draws Into Overdraft", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		expectLockAccounts(mock,
+			models.Account{ID: req.SourceID, Balance: decimal.NewFromInt(20), Currency: "USD", Status: constants.AccountActive,
+				OverdraftLimit: decimal.NewFromInt(100)},
+			models.Account{ID: req.DestinationID, Balance: decimal.NewFromInt(500), Currency: "USD", Status: constants.AccountActive})
+		expectNoLimits(mock, req.SourceID)
+		expectPostedLeg(mock, 1, *req, 20, 500)
+		mock.ExpectCommit()
+
+		result, err := repo.Transfer(context.Background(), req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "-30", result.SourcePostBalance)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Beyond Overdraft", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		expectLockAccounts(mock,
+			models.Account{ID: req.SourceID, Balance: decimal.NewFromInt(20), HeldBalance: decimal.NewFromInt(5), Currency: "USD",
+				Status: constants.AccountActive, OverdraftLimit: decimal.NewFromInt(30)},
+			models.Account{ID: req.DestinationID, Balance: decimal.NewFromInt(500), Currency: "USD", Status: constants.AccountActive})
+		mock.ExpectRollback()
+
+		_, err := repo.Transfer(context.Background(), req)
+
+		assert.ErrorIs(t, err, constants.ErrInsufficientFunds)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Currency Mismatch", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+
+		expectLockAccounts(mock,
+			models.Account{ID: req.SourceID, Balance: decimal.NewFromFloat(1000.0), Currency: "USD", Status: constants.AccountActive},
+			models.Account{ID: req.DestinationID, Balance: decimal.NewFromFloat(500.0), Currency: "EUR", Status: constants.AccountActive})
+
+		mock.ExpectRollback()
+
+		_, err := repo.Transfer(context.Background(), req)
+
+		assert.Equal(t, constants.ErrCurrencyMismatch, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Cross-Currency Transfer Uses Quote", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		ctx := context.WithValue(context.Background(), constants.CorrelationKey, correlationID)
+		destAmount := decimal.RequireFromString("46.17")
+		fxReq := &models.TransferRequest{
+			SourceID:      req.SourceID,
+			DestinationID: req.DestinationID,
+			Amount:        req.Amount,
+			Convert:       true,
+			Quote: &models.FxQuote{
+				RateID:              7,
+				Rate:                decimal.RequireFromString("0.92345"),
+				SourceCurrency:      "USD",
+				DestinationCurrency: "EUR",
+				DestinationAmount:   destAmount,
+			},
+		}
+
+		mock.ExpectBegin()
+
+		expectLockAccounts(mock,
+			models.Account{ID: req.SourceID, Balance: decimal.NewFromFloat(1000.0), Currency: "USD", Status: constants.AccountActive},
+			models.Account{ID: req.DestinationID, Balance: decimal.NewFromFloat(500.0), Currency: "EUR", Status: constants.AccountActive})
+		expectNoLimits(mock, req.SourceID)
+
+		mock.ExpectQuery(`INSERT INTO transfers`).
+			WithArgs(
+				req.SourceID, req.DestinationID, req.Amount, correlationID,
+				constants.StatusPending,
+				decimal.NewFromFloat(1000.0), decimal.NewFromFloat(500.0), nil, "USD",
+				destAmount, "EUR", int64(7), decimal.RequireFromString("0.92345"), nil, decimal.Zero, nil, constants.KindTransfer,
+			).
+			WillReturnRows(sqlmock.NewRows([]string{"transfer_id", "created_at"}).AddRow(1, time.Now()))
+
+		mock.ExpectExec(`UPDATE accounts SET balance = \$1 WHERE account_id = \$2`).
+			WithArgs(decimal.NewFromFloat(950.0), req.SourceID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		mock.ExpectExec(`UPDATE accounts SET balance = \$1 WHERE account_id = \$2`).
+			WithArgs(decimal.RequireFromString("546.17"), req.DestinationID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		mock.ExpectExec(`INSERT INTO ledger_entries \(transfer_id, account_id, amount, currency\) VALUES`).
+			WithArgs(
+				int64(1), req.SourceID, req.Amount.Neg(), "USD",
+				int64(1), nil, req.Amount, "USD",
+				int64(1), nil, destAmount.Neg(), "EUR",
+				int64(1), req.DestinationID, destAmount, "EUR",
+			).
+			WillReturnResult(sqlmock.NewResult(0, 4))
+
+		mock.ExpectExec(`UPDATE transfers SET status = \$1`).
+			WithArgs(
+				constants.StatusCompleted,
+				decimal.NewFromFloat(950.0), decimal.RequireFromString("546.17"),
+				int64(1),
+			).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		expectEvent(mock, req.SourceID, models.EventTransferCompleted)
+
+		mock.ExpectCommit()
+
+		result, err := repo.Transfer(ctx, fxReq)
+		require.NoError(t, err)
+		assert.Equal(t, "50", result.SourceAmount)
+		assert.Equal(t, "46.17", result.DestinationAmount)
+		assert.Equal(t, "EUR", result.DestinationCurrency)
+		assert.Equal(t, "0.92345", result.FxRate)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Cross-Currency Without Quote", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		fxReq := &models.TransferRequest{
+			SourceID:      req.SourceID,
+			DestinationID: req.DestinationID,
+			Amount:        req.Amount,
+			Convert:       true,
+		}
+
+		mock.ExpectBegin()
+
+		expectLockAccounts(mock,
+			models.Account{ID: req.SourceID, Balance: decimal.NewFromFloat(1000.0), Currency: "USD", Status: constants.AccountActive},
+			models.Account{ID: req.DestinationID, Balance: decimal.NewFromFloat(500.0), Currency: "EUR", Status: constants.AccountActive})
+
+		mock.ExpectRollback()
+
+		_, err := repo.Transfer(context.Background(), fxReq)
+
+		assert.Equal(t, constants.ErrCurrencyMismatch, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
 	t.Run("Failure: DB Error during Insert", func(t *testing.T) {
 		db, mock, repo := setupTransferTest(t)
 		defer db.Close()
 
-		ctx := context.WithValue(context.Background(), CorrelationKey, correlationID)
+		ctx := context.WithValue(context.Background(), constants.CorrelationKey, correlationID)
 		mock.ExpectBegin()
 
-		mock.ExpectQuery(`SELECT balance`).WithArgs(req.SourceID).WillReturnRows(sqlmock.NewRows([]string{"balance"}).AddRow(decimal.NewFromFloat(1000.0)))
-		mock.ExpectQuery(`SELECT balance`).WithArgs(req.DestinationID).WillReturnRows(sqlmock.NewRows([]string{"balance"}).AddRow(decimal.NewFromFloat(500.0)))
+		expectLockAccounts(mock,
+			models.Account{ID: req.SourceID, Balance: decimal.NewFromFloat(1000.0), Currency: "USD", Status: constants.AccountActive},
+			models.Account{ID: req.DestinationID, Balance: decimal.NewFromFloat(500.0), Currency: "USD", Status: constants.AccountActive})
+		expectNoLimits(mock, req.SourceID)
 
 		mock.ExpectQuery(`INSERT INTO transfers`).
 			WillReturnError(errors.New("connection died"))
@@ -141,7 +322,266 @@ func TestTransferRepository_Transfer(t *testing.T) {
 
 		_, err := repo.Transfer(ctx, req)
 
-		assert.Equal(t, constants.ErrSystem, err)
+		assert.ErrorIs(t, err, constants.ErrSystem)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestTransferRepository_Transfer_Idempotency(t *testing.T) {
+	req := &models.TransferRequest{
+		SourceID:       100,
+		DestinationID:  200,
+		Amount:         decimal.NewFromFloat(50.0),
+		IdempotencyKey: "key-1",
+	}
+	storedColumns := []string{
+		"transfer_id", "correlation_id", "source_account_id", "destination_account_id",
+		"amount", "currency", "destination_amount", "destination_currency", "fx_rate",
+		"source_post_balance", "destination_post_balance", "fee", "created_at",
+	}
+	createdAt := time.Now()
+
+	t.Run("Success: Replays Stored Result", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT transfer_id, correlation_id, source_account_id`).
+			WithArgs(req.IdempotencyKey).
+			WillReturnRows(sqlmock.NewRows(storedColumns).
+				AddRow(7, 555, req.SourceID, req.DestinationID, decimal.NewFromFloat(50.0), "USD", decimal.NewFromFloat(50.0), "USD", nil, decimal.NewFromFloat(950.0), decimal.NewFromFloat(550.0), decimal.Zero, createdAt))
+
+		result, err := repo.Transfer(context.Background(), req)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), result.AuditID)
+		assert.Equal(t, int64(555), result.CorrelationID)
+		assert.Equal(t, "950", result.SourcePostBalance)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Key Reused With Different Payload", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT transfer_id, correlation_id, source_account_id`).
+			WithArgs(req.IdempotencyKey).
+			WillReturnRows(sqlmock.NewRows(storedColumns).
+				AddRow(7, 555, req.SourceID, req.DestinationID, decimal.NewFromFloat(75.0), "USD", decimal.NewFromFloat(75.0), "USD", nil, decimal.NewFromFloat(925.0), decimal.NewFromFloat(575.0), decimal.Zero, createdAt))
+
+		_, err := repo.Transfer(context.Background(), req)
+
+		assert.Equal(t, constants.ErrIdempotencyKeyReused, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Concurrent Duplicate Replays Winner", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT transfer_id, correlation_id, source_account_id`).
+			WithArgs(req.IdempotencyKey).
+			WillReturnRows(sqlmock.NewRows(storedColumns))
+
+		mock.ExpectBegin()
+		expectLockAccounts(mock,
+			models.Account{ID: req.SourceID, Balance: decimal.NewFromFloat(1000.0), Currency: "USD", Status: constants.AccountActive},
+			models.Account{ID: req.DestinationID, Balance: decimal.NewFromFloat(500.0), Currency: "USD", Status: constants.AccountActive})
+		expectNoLimits(mock, req.SourceID)
+		mock.ExpectQuery(`INSERT INTO transfers`).
+			WillReturnError(&pgconn.PgError{Code: pgUniqueViolation, ConstraintName: idempotencyKeyConstraint})
+		mock.ExpectRollback()
+
+		mock.ExpectQuery(`SELECT transfer_id, correlation_id, source_account_id`).
+			WithArgs(req.IdempotencyKey).
+			WillReturnRows(sqlmock.NewRows(storedColumns).
+				AddRow(8, 556, req.SourceID, req.DestinationID, decimal.NewFromFloat(50.0), "USD", decimal.NewFromFloat(50.0), "USD", nil, decimal.NewFromFloat(950.0), decimal.NewFromFloat(550.0), decimal.Zero, createdAt))
+
+		result, err := repo.Transfer(context.Background(), req)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(8), result.AuditID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+var transferRowColumns = []string{
+	"transfer_id", "correlation_id", "status", "source_account_id", "destination_account_id", "amount", "currency",
+	"destination_amount", "destination_currency", "fx_rate_id", "fx_rate",
+	"source_prev_balance", "source_post_balance", "destination_prev_balance", "destination_post_balance",
+	"reversal_of", "reversed_amount", "reason", "group_id", "fee", "fee_of", "kind", "created_at",
+}
+
+func addTransferRow(rows *sqlmock.Rows, id, src, dest int64) *sqlmock.Rows {
+	return rows.AddRow(id, 42, constants.StatusCompleted, src, dest, decimal.NewFromFloat(10), "USD",
+		decimal.NewFromFloat(10), "USD", 0, nil,
+		decimal.NewFromFloat(100), decimal.NewFromFloat(90), decimal.NewFromFloat(0), decimal.NewFromFloat(10),
+		0, decimal.Zero, "", 0, decimal.Zero, 0, constants.KindTransfer, time.Now())
+}
+
+func TestTransferRepository_GetTransfer(t *testing.T) {
+	t.Run("Success: Transfer Found", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT transfer_id, correlation_id, status, .* FROM transfers WHERE transfer_id = \$1`).
+			WithArgs(int64(7)).
+			WillReturnRows(addTransferRow(sqlmock.NewRows(transferRowColumns), 7, 100, 200))
+
+		tr, err := repo.GetTransfer(context.Background(), 7)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), tr.ID)
+		assert.Equal(t, constants.StatusCompleted, tr.Status)
+		assert.True(t, decimal.NewFromFloat(90).Equal(tr.SourcePostBalance))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Transfer Not Found", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM transfers WHERE transfer_id = \$1`).
+			WithArgs(int64(7)).
+			WillReturnRows(sqlmock.NewRows(transferRowColumns))
+
+		_, err := repo.GetTransfer(context.Background(), 7)
+
+		assert.ErrorIs(t, err, constants.ErrTransferNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Generic DB Error", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM transfers WHERE transfer_id = \$1`).
+			WithArgs(int64(7)).
+			WillReturnError(errors.New("connection died"))
+
+		_, err := repo.GetTransfer(context.Background(), 7)
+
+		assert.ErrorContains(t, err, "get transfer failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestTransferRepository_ListTransfers(t *testing.T) {
+	t.Run("Success: First Page With More Rows", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		rows := sqlmock.NewRows(transferRowColumns)
+		addTransferRow(rows, 30, 100, 200)
+		addTransferRow(rows, 20, 300, 100)
+		addTransferRow(rows, 10, 100, 300)
+
+		mock.ExpectQuery(`WHERE \(source_account_id = \$1 OR destination_account_id = \$1\) ORDER BY transfer_id DESC LIMIT \$2`).
+			WithArgs(int64(100), 3).
+			WillReturnRows(rows)
+
+		page, err := repo.ListTransfers(context.Background(), models.TransferQuery{AccountID: 100, Limit: 2})
+
+		require.NoError(t, err)
+		assert.Len(t, page.Transfers, 2)
+		assert.Equal(t, int64(20), page.NextCursor)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Outgoing After Cursor In Time Range", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+		to := from.AddDate(0, 1, 0)
+
+		mock.ExpectQuery(`WHERE source_account_id = \$1 AND transfer_id < \$2 AND created_at >= \$3 AND created_at < \$4 ORDER BY transfer_id DESC LIMIT \$5`).
+			WithArgs(int64(100), int64(20), from, to, 51).
+			WillReturnRows(addTransferRow(sqlmock.NewRows(transferRowColumns), 10, 100, 300))
+
+		page, err := repo.ListTransfers(context.Background(), models.TransferQuery{
+			AccountID: 100, Direction: models.DirectionOutgoing, From: from, To: to, Cursor: 20, Limit: 50,
+		})
+
+		require.NoError(t, err)
+		assert.Len(t, page.Transfers, 1)
+		assert.Zero(t, page.NextCursor)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Incoming", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`WHERE destination_account_id = \$1 ORDER BY`).
+			WithArgs(int64(100), 11).
+			WillReturnRows(sqlmock.NewRows(transferRowColumns))
+
+		page, err := repo.ListTransfers(context.Background(), models.TransferQuery{
+			AccountID: 100, Direction: models.DirectionIncoming, Limit: 10,
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, page.Transfers)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Query Error", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM transfers WHERE`).
+			WillReturnError(errors.New("syntax error"))
+
+		_, err := repo.ListTransfers(context.Background(), models.TransferQuery{AccountID: 100, Limit: 10})
+
+		assert.ErrorContains(t, err, "list transfers failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestTransferRepository_GetAccounts(t *testing.T) {
+	t.Run("Success: Current Balances And Versions", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT account_id, .*, version FROM accounts WHERE account_id IN \(\$1, \$2\) ORDER BY account_id`).
+			WithArgs(int64(100), int64(200)).
+			WillReturnRows(sqlmock.NewRows([]string{"account_id", "balance", "held_balance", "currency", "status", "account_class", "overdraft_limit", "version"}).
+				AddRow(int64(100), decimal.NewFromInt(400), decimal.Zero, "USD", constants.AccountActive, "standard", decimal.Zero, int64(6)).
+				AddRow(int64(200), decimal.NewFromInt(600), decimal.Zero, "USD", constants.AccountActive, "standard", decimal.Zero, int64(2)))
+
+		accounts, err := repo.GetAccounts(context.Background(), []int64{100, 200})
+
+		require.NoError(t, err)
+		require.Len(t, accounts, 2)
+		assert.Equal(t, "400", accounts[0].Balance.String())
+		assert.Equal(t, int64(6), accounts[0].Version)
+		assert.Equal(t, int64(2), accounts[1].Version)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: No IDs Skips Query", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		accounts, err := repo.GetAccounts(context.Background(), nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, accounts)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Query Error", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM accounts WHERE account_id IN`).
+			WithArgs(int64(100)).
+			WillReturnError(errors.New("connection reset"))
+
+		_, err := repo.GetAccounts(context.Background(), []int64{100})
+
+		assert.ErrorContains(t, err, "get accounts failed")
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 }
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"expvar"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+const (
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+)
+
+// Retry counters, keyed by "<operation>.<SQLSTATE>" and served on /debug/vars.
+var (
+	txRetries   = expvar.NewMap("transfer_tx_retries")
+	txExhausted = expvar.NewMap("transfer_tx_retries_exhausted")
+)
+
+// TxPolicy sets the isolation level of money-moving transactions and how often
+// one is retried after Postgres aborts it with a serialization failure or
+// deadlock. Retries back off exponentially from BaseDelay with full jitter.
+type TxPolicy struct {
+	Isolation   sql.IsolationLevel
+	MaxAttempts int
+	BaseDelay   time.Duration
+}
+
+func DefaultTxPolicy() TxPolicy {
+	return TxPolicy{Isolation: sql.LevelSerializable, MaxAttempts: 3, BaseDelay: 10 * time.Millisecond}
+}
+
+func ParseIsolationLevel(s string) (sql.IsolationLevel, error) {
+	switch s {
+	case "read_committed":
+		return sql.LevelReadCommitted, nil
+	case "repeatable_read":
+		return sql.LevelRepeatableRead, nil
+	case "serializable":
+		return sql.LevelSerializable, nil
+	default:
+		return 0, constants.ErrInvalidIsolationLevel
+	}
+}
+
+func (p TxPolicy) backoff(attempt int) time.Duration {
+	ceiling := p.BaseDelay << (attempt - 1)
+	if ceiling <= 0 {
+		return 0
+	}
+	return rand.N(ceiling + 1)
+}
+
+// runTx runs fn in a transaction, retrying the whole transaction when it is
+// aborted with a serialization failure or deadlock. fn must not have effects
+// outside tx, since it may run more than once.
+func (r *TransferRepository) runTx(ctx context.Context, op string, fn func(tx *sql.Tx) error) error {
+	correlationID, _ := ctx.Value(constants.CorrelationKey).(int64)
+
+	for attempt := 1; ; attempt++ {
+		err := r.attemptTx(ctx, fn)
+		code := retryableCode(err)
+		if code == "" {
+			return err
+		}
+
+		key := op + "." + code
+		if attempt >= r.policy.MaxAttempts {
+			txExhausted.Add(key, 1)
+			r.log.Error("transaction retries exhausted",
+				zap.String("op", op),
+				zap.String("sqlstate", code),
+				zap.Int("attempts", attempt),
+				zap.Int64("correlation_id", correlationID))
+			return err
+		}
+
+		txRetries.Add(key, 1)
+		delay := r.policy.backoff(attempt)
+		r.log.Warn("retrying transaction",
+			zap.String("op", op),
+			zap.String("sqlstate", code),
+			zap.Int("attempt", attempt),
+			zap.Duration("delay", delay),
+			zap.Int64("correlation_id", correlationID))
+
+		select {
+		case <-ctx.Done():
+			return systemError(ctx.Err())
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (r *TransferRepository) attemptTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: r.policy.Isolation})
+	if err != nil {
+		r.log.Error("failed to begin tx", zap.Error(err))
+		return systemError(err)
+	}
+	defer func(tx *sql.Tx) {
+		_ = tx.Rollback()
+	}(tx)
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return systemError(err)
+	}
+	return nil
+}
+
+// retryableCode returns the SQLSTATE of a serialization failure or deadlock
+// anywhere in err's chain, or "" for any other error.
+func retryableCode(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && (pgErr.Code == pgSerializationFailure || pgErr.Code == pgDeadlockDetected) {
+		return pgErr.Code
+	}
+	return ""
+}
+
+// systemError reports a database failure as ErrSystem while keeping the
+// cause, so runTx can still recognise retryable errors.
+func systemError(err error) error {
+	return fmt.Errorf("%w: %w", constants.ErrSystem, err)
+}
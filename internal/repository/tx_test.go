@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"expvar"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+func counter(m *expvar.Map, key string) int64 {
+	if v, ok := m.Get(key).(*expvar.Int); ok {
+		return v.Value()
+	}
+	return 0
+}
+
+func TestTransferRepository_RunTx(t *testing.T) {
+	policy := TxPolicy{Isolation: sql.LevelSerializable, MaxAttempts: 2, BaseDelay: time.Millisecond}
+
+	expectRelease := func(mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`UPDATE accounts SET held_balance`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE holds SET status = \$1`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE transfers SET status = \$1`).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	t.Run("Success: Serialization Failure Retried", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewTransferRepository(db, policy, models.LimitDefaults{}, zap.NewNop())
+
+		before := counter(txRetries, "void.40001")
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockHoldQuery).WithArgs(int64(7)).
+			WillReturnError(&pgconn.PgError{Code: pgSerializationFailure})
+		mock.ExpectRollback()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockHoldQuery).WithArgs(int64(7)).
+			WillReturnRows(holdRow(constants.HoldActive, time.Now().Add(time.Hour)))
+		expectRelease(mock)
+		mock.ExpectCommit()
+
+		hold, err := repo.Void(context.Background(), 7)
+		require.NoError(t, err)
+		assert.Equal(t, constants.HoldVoided, hold.Status)
+		assert.Equal(t, before+1, counter(txRetries, "void.40001"))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Deadlock At Commit Retried", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewTransferRepository(db, policy, models.LimitDefaults{}, zap.NewNop())
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockHoldQuery).WithArgs(int64(7)).
+			WillReturnRows(holdRow(constants.HoldActive, time.Now().Add(time.Hour)))
+		expectRelease(mock)
+		mock.ExpectCommit().WillReturnError(&pgconn.PgError{Code: pgDeadlockDetected})
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockHoldQuery).WithArgs(int64(7)).
+			WillReturnRows(holdRow(constants.HoldActive, time.Now().Add(time.Hour)))
+		expectRelease(mock)
+		mock.ExpectCommit()
+
+		_, err = repo.Void(context.Background(), 7)
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Retries Exhausted", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewTransferRepository(db, policy, models.LimitDefaults{}, zap.NewNop())
+
+		before := counter(txExhausted, "void.40P01")
+
+		for i := 0; i < policy.MaxAttempts; i++ {
+			mock.ExpectBegin()
+			mock.ExpectQuery(lockHoldQuery).WithArgs(int64(7)).
+				WillReturnError(&pgconn.PgError{Code: pgDeadlockDetected})
+			mock.ExpectRollback()
+		}
+
+		_, err = repo.Void(context.Background(), 7)
+
+		assert.ErrorIs(t, err, constants.ErrSystem)
+		assert.Equal(t, before+1, counter(txExhausted, "void.40P01"))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Business Errors Not Retried", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewTransferRepository(db, policy, models.LimitDefaults{}, zap.NewNop())
+
+		mock.ExpectBegin()
+		expectLockAccounts(mock, lockedAccount(100, decimal.NewFromFloat(1), decimal.Zero), lockedAccount(200, decimal.Zero, decimal.Zero))
+		mock.ExpectRollback()
+
+		_, err = repo.Transfer(context.Background(), &models.TransferRequest{
+			SourceID: 100, DestinationID: 200, Amount: decimal.NewFromFloat(50),
+		})
+
+		assert.Equal(t, constants.ErrInsufficientFunds, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestParseIsolationLevel(t *testing.T) {
+	cases := map[string]sql.IsolationLevel{
+		"read_committed":  sql.LevelReadCommitted,
+		"repeatable_read": sql.LevelRepeatableRead,
+		"serializable":    sql.LevelSerializable,
+	}
+	for in, want := range cases {
+		got, err := ParseIsolationLevel(in)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+
+	_, err := ParseIsolationLevel("snapshot")
+	assert.Equal(t, constants.ErrInvalidIsolationLevel, err)
+}
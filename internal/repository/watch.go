@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"go.uber.org/zap"
+)
+
+// WatchCursor returns the position just after transfer sinceTransferID in the
+// postings to accountID, or with sinceTransferID 0 just after its latest
+// posting. The transfer must have been posted to the account.
+func (r *TransferRepository) WatchCursor(ctx context.Context, accountID, sinceTransferID int64) (models.TransferCursor, error) {
+	cursor := models.TransferCursor{AccountID: accountID}
+
+	var err error
+	if sinceTransferID == 0 {
+		err = r.db.QueryRowContext(ctx, `
+        SELECT GREATEST(
+            (SELECT MAX(posting_seq) FROM transfers WHERE source_account_id = $1),
+            (SELECT MAX(posting_seq) FROM transfers WHERE destination_account_id = $1),
+            0)`,
+			accountID).Scan(&cursor.PostingSeq)
+	} else {
+		err = r.db.QueryRowContext(ctx, `
+        SELECT posting_seq FROM transfers
+        WHERE transfer_id = $1 AND (source_account_id = $2 OR destination_account_id = $2) AND posting_seq IS NOT NULL`,
+			sinceTransferID, accountID).Scan(&cursor.PostingSeq)
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return cursor, constants.ErrTransferNotFound
+		}
+		r.log.Error("Failed to resolve watch cursor",
+			zap.Int64("account_id", accountID), zap.Int64("since_transfer_id", sinceTransferID), zap.Error(err))
+		return cursor, fmt.Errorf("resolve watch cursor failed: %w", err)
+	}
+
+	return cursor, nil
+}
+
+// ListPostedAfter returns up to limit transfers posted to the cursor's account
+// after it, in posting order, and the cursor advanced past them. posting_seq
+// is drawn while the accounts are locked, so a transfer committing later can
+// never take a position the cursor has already passed. Transfers reversed
+// since they posted are included; the reversal follows as its own transfer.
+func (r *TransferRepository) ListPostedAfter(ctx context.Context, cursor models.TransferCursor, limit int) ([]models.Transfer, models.TransferCursor, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT `+transferColumns+`, posting_seq FROM transfers WHERE source_account_id = $1 AND posting_seq > $2
+        UNION ALL
+        SELECT `+transferColumns+`, posting_seq FROM transfers WHERE destination_account_id = $1 AND posting_seq > $2
+        ORDER BY posting_seq LIMIT $3`,
+		cursor.AccountID, cursor.PostingSeq, limit)
+	if err != nil {
+		r.log.Error("Failed to list posted transfers", zap.Int64("account_id", cursor.AccountID), zap.Error(err))
+		return nil, cursor, fmt.Errorf("list posted transfers failed: %w", err)
+	}
+	defer rows.Close()
+
+	var transfers []models.Transfer
+	next := cursor
+	for rows.Next() {
+		t, err := scanTransfer(rows, &next.PostingSeq)
+		if err != nil {
+			r.log.Error("Row scan failed", zap.Error(err))
+			return nil, cursor, fmt.Errorf("list posted transfers failed: %w", err)
+		}
+		transfers = append(transfers, *t)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Row iteration error", zap.Error(err))
+		return nil, cursor, fmt.Errorf("list posted transfers failed: %w", err)
+	}
+
+	return transfers, next, nil
+}
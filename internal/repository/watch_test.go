@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+func addPostedRow(rows *sqlmock.Rows, id, src, dest, seq int64) *sqlmock.Rows {
+	return rows.AddRow(id, 42, constants.StatusCompleted, src, dest, decimal.NewFromFloat(10), "USD",
+		decimal.NewFromFloat(10), "USD", 0, nil,
+		decimal.NewFromFloat(100), decimal.NewFromFloat(90), decimal.NewFromFloat(0), decimal.NewFromFloat(10),
+		0, decimal.Zero, "", 0, decimal.Zero, 0, constants.KindTransfer, time.Now(), seq)
+}
+
+func TestTransferRepository_WatchCursor(t *testing.T) {
+	t.Run("Success: Latest Posting When Since Is Zero", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT GREATEST\(`).
+			WithArgs(int64(100)).
+			WillReturnRows(sqlmock.NewRows([]string{"greatest"}).AddRow(int64(57)))
+
+		cursor, err := repo.WatchCursor(context.Background(), 100, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.TransferCursor{AccountID: 100, PostingSeq: 57}, cursor)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Position Of Since Transfer", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT posting_seq FROM transfers\s+WHERE transfer_id = \$1 AND \(source_account_id = \$2 OR destination_account_id = \$2\)`).
+			WithArgs(int64(7), int64(100)).
+			WillReturnRows(sqlmock.NewRows([]string{"posting_seq"}).AddRow(int64(12)))
+
+		cursor, err := repo.WatchCursor(context.Background(), 100, 7)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(12), cursor.PostingSeq)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Since Transfer Not Posted To Account", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT posting_seq FROM transfers`).
+			WithArgs(int64(7), int64(100)).
+			WillReturnRows(sqlmock.NewRows([]string{"posting_seq"}))
+
+		_, err := repo.WatchCursor(context.Background(), 100, 7)
+
+		assert.ErrorIs(t, err, constants.ErrTransferNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestTransferRepository_ListPostedAfter(t *testing.T) {
+	const query = `FROM transfers WHERE source_account_id = \$1 AND posting_seq > \$2\s+UNION ALL\s+.* ORDER BY posting_seq LIMIT \$3`
+	cursor := models.TransferCursor{AccountID: 100, PostingSeq: 10}
+
+	t.Run("Success: Cursor Advances Past Last Transfer", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		rows := sqlmock.NewRows(append(append([]string{}, transferRowColumns...), "posting_seq"))
+		addPostedRow(rows, 20, 100, 200, 11)
+		addPostedRow(rows, 18, 300, 100, 14)
+
+		mock.ExpectQuery(query).WithArgs(int64(100), int64(10), 50).WillReturnRows(rows)
+
+		transfers, next, err := repo.ListPostedAfter(context.Background(), cursor, 50)
+
+		require.NoError(t, err)
+		require.Len(t, transfers, 2)
+		assert.Equal(t, int64(20), transfers[0].ID)
+		assert.Equal(t, int64(18), transfers[1].ID)
+		assert.Equal(t, models.TransferCursor{AccountID: 100, PostingSeq: 14}, next)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success: Nothing New Keeps Cursor", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(query).WithArgs(int64(100), int64(10), 50).
+			WillReturnRows(sqlmock.NewRows(append(append([]string{}, transferRowColumns...), "posting_seq")))
+
+		transfers, next, err := repo.ListPostedAfter(context.Background(), cursor, 50)
+
+		require.NoError(t, err)
+		assert.Empty(t, transfers)
+		assert.Equal(t, cursor, next)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: DB Error", func(t *testing.T) {
+		db, mock, repo := setupTransferTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(query).WithArgs(int64(100), int64(10), 50).WillReturnError(errors.New("db down"))
+
+		_, next, err := repo.ListPostedAfter(context.Background(), cursor, 50)
+
+		assert.Error(t, err)
+		assert.Equal(t, cursor, next)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
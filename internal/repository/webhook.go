@@ -0,0 +1,289 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"go.uber.org/zap"
+)
+
+// webhookLockKey is the Postgres advisory lock held by the Core instance
+// currently delivering webhooks, so each delivery is attempted once per tick.
+const webhookLockKey int64 = 0x776562686f6f6b // "webhook"
+
+type WebhookRepository struct {
+	db  *sql.DB
+	log *zap.Logger
+}
+
+func NewWebhookRepository(db *sql.DB, log *zap.Logger) *WebhookRepository {
+	return &WebhookRepository{db: db, log: log}
+}
+
+// event_types is a TEXT[] read and written as a comma-separated string, which
+// event type names never contain.
+const webhookColumns = `subscription_id, url, array_to_string(event_types, ','), COALESCE(account_id, 0), status, created_at`
+
+func scanWebhook(row rowScanner) (*models.WebhookSubscription, error) {
+	var (
+		w          models.WebhookSubscription
+		eventTypes string
+	)
+	if err := row.Scan(&w.ID, &w.URL, &eventTypes, &w.AccountID, &w.Status, &w.CreatedAt); err != nil {
+		return nil, err
+	}
+	w.EventTypes = splitEventTypes(eventTypes)
+	return &w, nil
+}
+
+func joinEventTypes(types []models.EventType) string {
+	s := make([]string, len(types))
+	for i, t := range types {
+		s[i] = string(t)
+	}
+	return strings.Join(s, ",")
+}
+
+func splitEventTypes(s string) []models.EventType {
+	var types []models.EventType
+	for _, t := range strings.Split(s, ",") {
+		if t != "" {
+			types = append(types, models.EventType(t))
+		}
+	}
+	return types
+}
+
+func nullableAccountID(id int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: id, Valid: id != 0}
+}
+
+func (r *WebhookRepository) Create(ctx context.Context, w *models.WebhookSubscription) error {
+	err := r.db.QueryRowContext(ctx, `
+        INSERT INTO webhook_subscriptions (url, secret, event_types, account_id, status)
+        VALUES ($1, $2, string_to_array($3, ','), $4, $5)
+        RETURNING subscription_id, created_at`,
+		w.URL, w.Secret, joinEventTypes(w.EventTypes), nullableAccountID(w.AccountID), w.Status,
+	).Scan(&w.ID, &w.CreatedAt)
+	if err != nil {
+		r.log.Error("Failed to create webhook subscription", zap.String("url", w.URL), zap.Error(err))
+		return fmt.Errorf("create webhook subscription failed: %w", err)
+	}
+	return nil
+}
+
+func (r *WebhookRepository) Get(ctx context.Context, id int64) (*models.WebhookSubscription, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+webhookColumns+` FROM webhook_subscriptions WHERE subscription_id = $1`, id)
+
+	w, err := scanWebhook(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, constants.ErrWebhookNotFound
+		}
+		r.log.Error("Failed to get webhook subscription", zap.Int64("webhook_id", id), zap.Error(err))
+		return nil, fmt.Errorf("get webhook subscription failed: %w", err)
+	}
+	return w, nil
+}
+
+// List returns every subscription, deleted ones included, oldest first.
+func (r *WebhookRepository) List(ctx context.Context) ([]models.WebhookSubscription, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+webhookColumns+` FROM webhook_subscriptions ORDER BY subscription_id`)
+	if err != nil {
+		r.log.Error("Failed to list webhook subscriptions", zap.Error(err))
+		return nil, fmt.Errorf("list webhook subscriptions failed: %w", err)
+	}
+	defer rows.Close()
+
+	var subs []models.WebhookSubscription
+	for rows.Next() {
+		w, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("list webhook subscriptions failed: %w", err)
+		}
+		subs = append(subs, *w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list webhook subscriptions failed: %w", err)
+	}
+	return subs, nil
+}
+
+// Delete stops deliveries to an active subscription. Deliveries already queued
+// are left in place but never attempted.
+func (r *WebhookRepository) Delete(ctx context.Context, id int64) (*models.WebhookSubscription, error) {
+	row := r.db.QueryRowContext(ctx, `
+        UPDATE webhook_subscriptions SET status = $1, updated_at = now()
+        WHERE subscription_id = $2 AND status = $3
+        RETURNING `+webhookColumns,
+		constants.WebhookDeleted, id, constants.WebhookActive)
+
+	w, err := scanWebhook(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		var status constants.WebhookStatus
+		err = r.db.QueryRowContext(ctx, `SELECT status FROM webhook_subscriptions WHERE subscription_id = $1`, id).Scan(&status)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, constants.ErrWebhookNotFound
+		}
+		if err == nil {
+			return nil, constants.ErrWebhookNotActive
+		}
+	}
+	if err != nil {
+		r.log.Error("Failed to delete webhook subscription", zap.Int64("webhook_id", id), zap.Error(err))
+		return nil, fmt.Errorf("delete webhook subscription failed: %w", err)
+	}
+	return w, nil
+}
+
+// Due returns up to limit undelivered deliveries to active subscriptions whose
+// next attempt is at or before now, oldest first, with the event to send.
+func (r *WebhookRepository) Due(ctx context.Context, now time.Time, limit int) ([]models.WebhookDelivery, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT d.delivery_id, d.subscription_id, s.url, s.secret, d.attempts,
+               o.event_id, o.event_type, o.event_version, o.account_id, COALESCE(o.counterparty_id, 0), o.payload, o.created_at
+        FROM webhook_deliveries d
+        JOIN webhook_subscriptions s ON s.subscription_id = d.subscription_id
+        JOIN outbox o ON o.event_id = d.event_id
+        WHERE d.delivered_at IS NULL AND d.next_attempt_at <= $1 AND s.status = $2
+        ORDER BY d.next_attempt_at, d.delivery_id
+        LIMIT $3`,
+		now, constants.WebhookActive, limit)
+	if err != nil {
+		r.log.Error("Failed to query due webhook deliveries", zap.Error(err))
+		return nil, fmt.Errorf("list due webhook deliveries failed: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []models.WebhookDelivery
+	for rows.Next() {
+		var (
+			d       models.WebhookDelivery
+			payload []byte
+		)
+		if err := rows.Scan(&d.ID, &d.SubscriptionID, &d.URL, &d.Secret, &d.Attempts,
+			&d.Event.ID, &d.Event.Type, &d.Event.Version, &d.Event.AccountID, &d.Event.CounterpartyID, &payload, &d.Event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("list due webhook deliveries failed: %w", err)
+		}
+		d.Event.Payload = payload
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list due webhook deliveries failed: %w", err)
+	}
+	return deliveries, nil
+}
+
+func (r *WebhookRepository) MarkDelivered(ctx context.Context, id int64, attempts int) error {
+	_, err := r.db.ExecContext(ctx, `
+        UPDATE webhook_deliveries SET attempts = $1, last_error = NULL, delivered_at = now() WHERE delivery_id = $2`,
+		attempts, id)
+	if err != nil {
+		r.log.Error("Failed to mark webhook delivered", zap.Int64("delivery_id", id), zap.Error(err))
+		return fmt.Errorf("mark webhook delivered failed: %w", err)
+	}
+	return nil
+}
+
+// RetryLater records a failed attempt and when to try again.
+func (r *WebhookRepository) RetryLater(ctx context.Context, id int64, attempts int, next time.Time, lastErr string) error {
+	_, err := r.db.ExecContext(ctx, `
+        UPDATE webhook_deliveries SET attempts = $1, next_attempt_at = $2, last_error = $3 WHERE delivery_id = $4`,
+		attempts, next, lastErr, id)
+	if err != nil {
+		r.log.Error("Failed to reschedule webhook delivery", zap.Int64("delivery_id", id), zap.Error(err))
+		return fmt.Errorf("reschedule webhook delivery failed: %w", err)
+	}
+	return nil
+}
+
+// DeadLetter moves a delivery that used up its attempts to the dead-letter
+// table in one statement.
+func (r *WebhookRepository) DeadLetter(ctx context.Context, id int64, attempts int, lastErr string) error {
+	_, err := r.db.ExecContext(ctx, `
+        WITH dead AS (
+            DELETE FROM webhook_deliveries WHERE delivery_id = $1 RETURNING subscription_id, event_id
+        )
+        INSERT INTO webhook_dead_letters (subscription_id, event_id, attempts, last_error)
+        SELECT subscription_id, event_id, $2, $3 FROM dead`,
+		id, attempts, lastErr)
+	if err != nil {
+		r.log.Error("Failed to dead-letter webhook delivery", zap.Int64("delivery_id", id), zap.Error(err))
+		return fmt.Errorf("dead-letter webhook delivery failed: %w", err)
+	}
+	return nil
+}
+
+// ListDeadLetters returns a subscription's dead letters, newest first.
+func (r *WebhookRepository) ListDeadLetters(ctx context.Context, subscriptionID int64) ([]models.WebhookDeadLetter, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT l.dead_letter_id, l.subscription_id, l.event_id, o.event_type, l.attempts, l.last_error, l.failed_at
+        FROM webhook_dead_letters l
+        JOIN outbox o ON o.event_id = l.event_id
+        WHERE l.subscription_id = $1
+        ORDER BY l.dead_letter_id DESC`, subscriptionID)
+	if err != nil {
+		r.log.Error("Failed to list webhook dead letters", zap.Int64("webhook_id", subscriptionID), zap.Error(err))
+		return nil, fmt.Errorf("list webhook dead letters failed: %w", err)
+	}
+	defer rows.Close()
+
+	var letters []models.WebhookDeadLetter
+	for rows.Next() {
+		var l models.WebhookDeadLetter
+		if err := rows.Scan(&l.ID, &l.SubscriptionID, &l.EventID, &l.EventType, &l.Attempts, &l.LastError, &l.FailedAt); err != nil {
+			return nil, fmt.Errorf("list webhook dead letters failed: %w", err)
+		}
+		letters = append(letters, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list webhook dead letters failed: %w", err)
+	}
+	return letters, nil
+}
+
+// Redeliver moves a dead letter back to webhook_deliveries, due now with a
+// fresh set of attempts, and returns the new delivery's ID. The subscription
+// must still be active.
+func (r *WebhookRepository) Redeliver(ctx context.Context, deadLetterID int64) (int64, error) {
+	var deliveryID int64
+	err := r.db.QueryRowContext(ctx, `
+        WITH dead AS (
+            DELETE FROM webhook_dead_letters l USING webhook_subscriptions s
+            WHERE l.dead_letter_id = $1 AND s.subscription_id = l.subscription_id AND s.status = $2
+            RETURNING l.subscription_id, l.event_id
+        )
+        INSERT INTO webhook_deliveries (subscription_id, event_id)
+        SELECT subscription_id, event_id FROM dead
+        RETURNING delivery_id`,
+		deadLetterID, constants.WebhookActive).Scan(&deliveryID)
+	if errors.Is(err, sql.ErrNoRows) {
+		var subscriptionID int64
+		err = r.db.QueryRowContext(ctx,
+			`SELECT subscription_id FROM webhook_dead_letters WHERE dead_letter_id = $1`, deadLetterID).Scan(&subscriptionID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, constants.ErrDeadLetterNotFound
+		}
+		if err == nil {
+			return 0, constants.ErrWebhookNotActive
+		}
+	}
+	if err != nil {
+		r.log.Error("Failed to redeliver webhook dead letter", zap.Int64("dead_letter_id", deadLetterID), zap.Error(err))
+		return 0, fmt.Errorf("redeliver webhook dead letter failed: %w", err)
+	}
+	return deliveryID, nil
+}
+
+// WithLeaderLock runs fn only if this instance wins the webhook delivery
+// advisory lock, reporting whether it did.
+func (r *WebhookRepository) WithLeaderLock(ctx context.Context, fn func(ctx context.Context) error) (bool, error) {
+	return withAdvisoryLock(ctx, r.db, webhookLockKey, "webhook", r.log, fn)
+}
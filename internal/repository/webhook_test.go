@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+func setupWebhookTest(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *WebhookRepository) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	return db, mock, NewWebhookRepository(db, zap.NewNop())
+}
+
+func webhookRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"subscription_id", "url", "event_types", "account_id", "status", "created_at"})
+}
+
+func TestWebhookRepository_Create(t *testing.T) {
+	db, mock, repo := setupWebhookTest(t)
+	defer db.Close()
+
+	w := &models.WebhookSubscription{
+		URL:        "https://partner.example/hooks",
+		Secret:     "0123456789abcdef",
+		EventTypes: []models.EventType{models.EventTransferCompleted, models.EventTransferFailed},
+		Status:     constants.WebhookActive,
+	}
+	now := time.Now()
+
+	mock.ExpectQuery(`INSERT INTO webhook_subscriptions \(url, secret, event_types, account_id, status\)\s+VALUES \(\$1, \$2, string_to_array\(\$3, ','\), \$4, \$5\)`).
+		WithArgs(w.URL, w.Secret, "TransferCompleted,TransferFailed", nil, constants.WebhookActive).
+		WillReturnRows(sqlmock.NewRows([]string{"subscription_id", "created_at"}).AddRow(int64(3), now))
+
+	require.NoError(t, repo.Create(context.Background(), w))
+
+	assert.Equal(t, int64(3), w.ID)
+	assert.Equal(t, now, w.CreatedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWebhookRepository_Get(t *testing.T) {
+	t.Run("Success: Event Types Split", func(t *testing.T) {
+		db, mock, repo := setupWebhookTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT subscription_id, url, array_to_string\(event_types, ','\), .* FROM webhook_subscriptions WHERE subscription_id = \$1`).
+			WithArgs(int64(3)).
+			WillReturnRows(webhookRows().AddRow(int64(3), "https://partner.example/hooks", "AccountCreated,TransferCompleted",
+				int64(101), constants.WebhookActive, time.Now()))
+
+		w, err := repo.Get(context.Background(), 3)
+
+		require.NoError(t, err)
+		assert.Equal(t, []models.EventType{models.EventAccountCreated, models.EventTransferCompleted}, w.EventTypes)
+		assert.Equal(t, int64(101), w.AccountID)
+		assert.Empty(t, w.Secret)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Not Found", func(t *testing.T) {
+		db, mock, repo := setupWebhookTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM webhook_subscriptions WHERE subscription_id = \$1`).
+			WithArgs(int64(3)).
+			WillReturnRows(webhookRows())
+
+		_, err := repo.Get(context.Background(), 3)
+
+		assert.ErrorIs(t, err, constants.ErrWebhookNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestWebhookRepository_Delete(t *testing.T) {
+	const deleteQuery = `UPDATE webhook_subscriptions SET status = \$1, updated_at = now\(\)\s+WHERE subscription_id = \$2 AND status = \$3`
+
+	t.Run("Success: Active Subscription Deleted", func(t *testing.T) {
+		db, mock, repo := setupWebhookTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(deleteQuery).
+			WithArgs(constants.WebhookDeleted, int64(3), constants.WebhookActive).
+			WillReturnRows(webhookRows().AddRow(int64(3), "https://partner.example/hooks", "TransferCompleted",
+				int64(0), constants.WebhookDeleted, time.Now()))
+
+		w, err := repo.Delete(context.Background(), 3)
+
+		require.NoError(t, err)
+		assert.Equal(t, constants.WebhookDeleted, w.Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Already Deleted", func(t *testing.T) {
+		db, mock, repo := setupWebhookTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(deleteQuery).WillReturnRows(webhookRows())
+		mock.ExpectQuery(`SELECT status FROM webhook_subscriptions WHERE subscription_id = \$1`).
+			WithArgs(int64(3)).
+			WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow(constants.WebhookDeleted))
+
+		_, err := repo.Delete(context.Background(), 3)
+
+		assert.ErrorIs(t, err, constants.ErrWebhookNotActive)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Not Found", func(t *testing.T) {
+		db, mock, repo := setupWebhookTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(deleteQuery).WillReturnRows(webhookRows())
+		mock.ExpectQuery(`SELECT status FROM webhook_subscriptions`).
+			WithArgs(int64(3)).
+			WillReturnRows(sqlmock.NewRows([]string{"status"}))
+
+		_, err := repo.Delete(context.Background(), 3)
+
+		assert.ErrorIs(t, err, constants.ErrWebhookNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestWebhookRepository_Due(t *testing.T) {
+	db, mock, repo := setupWebhookTest(t)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery(`FROM webhook_deliveries d\s+JOIN webhook_subscriptions s .*\s+JOIN outbox o .*\s+WHERE d.delivered_at IS NULL AND d.next_attempt_at <= \$1 AND s.status = \$2`).
+		WithArgs(now, constants.WebhookActive, 50).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"delivery_id", "subscription_id", "url", "secret", "attempts",
+			"event_id", "event_type", "event_version", "account_id", "counterparty_id", "payload", "created_at",
+		}).AddRow(int64(9), int64(3), "https://partner.example/hooks", "0123456789abcdef", 2,
+			int64(42), models.EventTransferCompleted, 1, int64(101), int64(202), []byte(`{"transfer_id":7}`), now))
+
+	deliveries, err := repo.Due(context.Background(), now, 50)
+
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	d := deliveries[0]
+	assert.Equal(t, int64(9), d.ID)
+	assert.Equal(t, 2, d.Attempts)
+	assert.Equal(t, "0123456789abcdef", d.Secret)
+	assert.Equal(t, int64(42), d.Event.ID)
+	assert.Equal(t, models.EventTransferCompleted, d.Event.Type)
+	assert.Equal(t, int64(202), d.Event.CounterpartyID)
+	assert.JSONEq(t, `{"transfer_id":7}`, string(d.Event.Payload))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWebhookRepository_DeadLetter(t *testing.T) {
+	db, mock, repo := setupWebhookTest(t)
+	defer db.Close()
+
+	mock.ExpectExec(`DELETE FROM webhook_deliveries WHERE delivery_id = \$1 RETURNING subscription_id, event_id\s+\)\s+INSERT INTO webhook_dead_letters`).
+		WithArgs(int64(9), 8, "unexpected status 500").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, repo.DeadLetter(context.Background(), 9, 8, "unexpected status 500"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWebhookRepository_Redeliver(t *testing.T) {
+	const redeliverQuery = `DELETE FROM webhook_dead_letters l USING webhook_subscriptions s .*\s+INSERT INTO webhook_deliveries`
+
+	t.Run("Success: Moved Back To Deliveries", func(t *testing.T) {
+		db, mock, repo := setupWebhookTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(redeliverQuery).
+			WithArgs(int64(5), constants.WebhookActive).
+			WillReturnRows(sqlmock.NewRows([]string{"delivery_id"}).AddRow(int64(12)))
+
+		id, err := repo.Redeliver(context.Background(), 5)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(12), id)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Subscription Deleted", func(t *testing.T) {
+		db, mock, repo := setupWebhookTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(redeliverQuery).WillReturnRows(sqlmock.NewRows([]string{"delivery_id"}))
+		mock.ExpectQuery(`SELECT subscription_id FROM webhook_dead_letters WHERE dead_letter_id = \$1`).
+			WithArgs(int64(5)).
+			WillReturnRows(sqlmock.NewRows([]string{"subscription_id"}).AddRow(int64(3)))
+
+		_, err := repo.Redeliver(context.Background(), 5)
+
+		assert.ErrorIs(t, err, constants.ErrWebhookNotActive)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure: Not Found", func(t *testing.T) {
+		db, mock, repo := setupWebhookTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(redeliverQuery).WillReturnRows(sqlmock.NewRows([]string{"delivery_id"}))
+		mock.ExpectQuery(`SELECT subscription_id FROM webhook_dead_letters`).
+			WithArgs(int64(5)).
+			WillReturnRows(sqlmock.NewRows([]string{"subscription_id"}))
+
+		_, err := repo.Redeliver(context.Background(), 5)
+
+		assert.ErrorIs(t, err, constants.ErrDeadLetterNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
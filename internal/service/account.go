@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
 	"github.com/jhaprabhatt/account-transfer-project/internal/models"
@@ -13,9 +14,10 @@ import (
 )
 
 type AccountService struct {
-	accRepo repository.AccountRepo
-	cache   repository.Cache
-	log     *zap.Logger
+	accRepo  repository.AccountRepo
+	cache    repository.Cache
+	accounts *accountLookup
+	log      *zap.Logger
 }
 
 func NewAccountService(
@@ -24,9 +26,10 @@ func NewAccountService(
 	log *zap.Logger,
 ) *AccountService {
 	return &AccountService{
-		accRepo: accRepo,
-		cache:   cache,
-		log:     log,
+		accRepo:  accRepo,
+		cache:    cache,
+		accounts: newAccountLookup(cache, accRepo, log),
+		log:      log,
 	}
 }
 
@@ -58,6 +61,30 @@ func (s *AccountService) CreateAccount(ctx context.Context, acc *models.Account)
 		return constants.ErrAmountMustNotBeNegative
 	}
 
+	if acc.OverdraftLimit.IsNegative() {
+		return constants.ErrInvalidOverdraftLimit
+	}
+
+	currency, err := models.NormalizeCurrency(acc.Currency)
+	if err != nil {
+		return err
+	}
+	if err := models.CheckScale(acc.Balance, currency); err != nil {
+		return err
+	}
+	if err := models.CheckScale(acc.OverdraftLimit, currency); err != nil {
+		return err
+	}
+	acc.Currency = currency
+
+	class, err := models.NormalizeAccountClass(acc.Class)
+	if err != nil {
+		return err
+	}
+	acc.Class = class
+
+	acc.Status = constants.AccountActive
+
 	if err := s.accRepo.CreateAccount(ctx, acc); err != nil {
 		s.log.Error("Failed to create account in DB", zap.Error(err))
 		return err
@@ -75,12 +102,140 @@ func (s *AccountService) CreateAccount(ctx context.Context, acc *models.Account)
 	return nil
 }
 
+// GetAccount serves the account from the cache, which transfers refresh after
+// they commit, reading through to the database like the transfer pre-checks.
 func (s *AccountService) GetAccount(ctx context.Context, id int64) (*models.Account, error) {
-	acc, err := s.accRepo.GetAccount(ctx, id)
+	return s.accounts.get(ctx, id)
+}
+
+func (s *AccountService) FreezeAccount(ctx context.Context, id int64) (*models.Account, error) {
+	return s.updateStatus(ctx, id, constants.AccountFrozen)
+}
+
+func (s *AccountService) UnfreezeAccount(ctx context.Context, id int64) (*models.Account, error) {
+	return s.updateStatus(ctx, id, constants.AccountActive)
+}
+
+func (s *AccountService) CloseAccount(ctx context.Context, id int64) (*models.Account, error) {
+	return s.updateStatus(ctx, id, constants.AccountClosed)
+}
+
+func (s *AccountService) UpdateOverdraftLimit(ctx context.Context, id int64, limit decimal.Decimal) (*models.Account, error) {
+	if id <= 0 {
+		return nil, constants.ErrInvalidAccountID
+	}
+
+	acc, err := s.accRepo.UpdateOverdraftLimit(ctx, id, limit)
 	if err != nil {
 		return nil, err
 	}
-	_ = s.cache.SetAccount(ctx, acc)
+
+	if err := s.cache.SetAccount(ctx, acc); err != nil {
+		s.log.Error("Cache write-through failed after overdraft change (data is safe in DB)",
+			zap.Int64("account_id", id),
+			zap.Error(err),
+		)
+	}
+
+	s.log.Info("Account overdraft limit changed",
+		zap.Int64("account_id", id),
+		zap.String("overdraft_limit", acc.OverdraftLimit.String()),
+	)
+
+	return acc, nil
+}
+
+// GetBalanceAt returns an account's ledger balance as of at. Future times are
+// rejected, since transfers still to come would change the answer.
+func (s *AccountService) GetBalanceAt(ctx context.Context, id int64, at time.Time) (*models.BalanceAt, error) {
+	if id <= 0 {
+		return nil, constants.ErrInvalidAccountID
+	}
+	if at.After(time.Now()) {
+		return nil, constants.ErrBalanceTimeInFuture
+	}
+	return s.accRepo.GetBalanceAt(ctx, id, at)
+}
+
+// GetStatement returns the statement of an account over q's time range.
+func (s *AccountService) GetStatement(ctx context.Context, q models.StatementQuery) (*models.Statement, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	st, err := s.accRepo.GetStatement(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info("Statement generated",
+		zap.Int64("account_id", q.AccountID),
+		zap.Time("from", q.From),
+		zap.Time("to", q.To),
+		zap.Int("lines", len(st.Lines)),
+	)
+
+	return st, nil
+}
+
+// SetLimits replaces the transfer limits set for an account. The next transfer
+// out of it is checked against them; there is nothing cached to refresh.
+func (s *AccountService) SetLimits(ctx context.Context, id int64, limits models.TransferLimits) (*models.AccountLimits, error) {
+	if id <= 0 {
+		return nil, constants.ErrInvalidAccountID
+	}
+
+	set, err := s.accRepo.SetLimits(ctx, id, limits)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info("Account limits set", zap.Int64("account_id", id))
+	return set, nil
+}
+
+// GetLimits returns the transfer limits set for an account, without the
+// defaults it falls back to for the others.
+func (s *AccountService) GetLimits(ctx context.Context, id int64) (*models.AccountLimits, error) {
+	if id <= 0 {
+		return nil, constants.ErrInvalidAccountID
+	}
+	return s.accRepo.GetLimits(ctx, id)
+}
+
+// ClearLimits puts an account back on the default transfer limits.
+func (s *AccountService) ClearLimits(ctx context.Context, id int64) (*models.AccountLimits, error) {
+	if id <= 0 {
+		return nil, constants.ErrInvalidAccountID
+	}
+
+	cleared, err := s.accRepo.ClearLimits(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info("Account limits cleared", zap.Int64("account_id", id))
+	return cleared, nil
+}
+
+func (s *AccountService) updateStatus(ctx context.Context, id int64, to constants.AccountStatus) (*models.Account, error) {
+	acc, err := s.accRepo.UpdateStatus(ctx, id, to)
+	if err != nil {
+		return nil, err
+	}
+
+	// Transfer validation reads status from the cache, so refresh it right away.
+	if err := s.cache.SetAccount(ctx, acc); err != nil {
+		s.log.Error("Cache write-through failed after status change (data is safe in DB)",
+			zap.Int64("account_id", id),
+			zap.Error(err),
+		)
+	}
+
+	s.log.Info("Account status changed",
+		zap.Int64("account_id", id),
+		zap.String("status", acc.Status.String()),
+	)
 
 	return acc, nil
 }
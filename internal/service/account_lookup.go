@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	"github.com/jhaprabhatt/account-transfer-project/internal/repository"
+
+	"go.uber.org/zap"
+)
+
+// cacheRetryAfter is how long lookups bypass the cache after it fails.
+const cacheRetryAfter = 5 * time.Second
+
+// accountLookup reads accounts for the transfer pre-checks and for requests
+// that only need to know an account exists. The cache serves them; a miss is
+// read through to the database and written back, so a flushed or evicted
+// cache heals itself, and IDs the database does not know are cached as
+// missing for a short TTL. While the cache is unreachable, lookups go
+// straight to the database instead of failing.
+type accountLookup struct {
+	cache    repository.Cache
+	accounts repository.AccountRepo
+	log      *zap.Logger
+	// bypassUntil is when, in Unix nanoseconds, lookups try the cache again.
+	bypassUntil atomic.Int64
+}
+
+func newAccountLookup(cache repository.Cache, accounts repository.AccountRepo, log *zap.Logger) *accountLookup {
+	return &accountLookup{cache: cache, accounts: accounts, log: log}
+}
+
+// get returns the account, or ErrAccountNotFound if it does not exist.
+func (l *accountLookup) get(ctx context.Context, id int64) (*models.Account, error) {
+	if l.degraded() {
+		return l.accounts.GetAccount(ctx, id)
+	}
+
+	acc, err := l.cache.GetAccount(ctx, id)
+	switch {
+	case acc != nil:
+		return acc, nil
+	case errors.Is(err, constants.ErrAccountNotFound):
+		return nil, err
+	case err != nil:
+		l.degrade(err)
+		return l.accounts.GetAccount(ctx, id)
+	}
+
+	acc, err = l.accounts.GetAccount(ctx, id)
+	if errors.Is(err, constants.ErrAccountNotFound) {
+		if err := l.cache.SetMissing(ctx, id); err != nil {
+			l.log.Warn("Failed to cache missing account", zap.Int64("account_id", id), zap.Error(err))
+		}
+		return nil, constants.ErrAccountNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := l.cache.SetAccount(ctx, acc); err != nil {
+		l.log.Warn("Failed to repopulate account cache", zap.Int64("account_id", id), zap.Error(err))
+	} else {
+		l.log.Info("Account cache repopulated from database", zap.Int64("account_id", id))
+	}
+	return acc, nil
+}
+
+func (l *accountLookup) degraded() bool {
+	return time.Now().UnixNano() < l.bypassUntil.Load()
+}
+
+// degrade bypasses the cache for cacheRetryAfter, so requests do not each wait
+// on a cache that is down.
+func (l *accountLookup) degrade(err error) {
+	l.bypassUntil.Store(time.Now().Add(cacheRetryAfter).UnixNano())
+	l.log.Warn("Account cache unreachable, validating against database",
+		zap.Duration("retry_after", cacheRetryAfter),
+		zap.Error(err))
+}
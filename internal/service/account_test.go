@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
 
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
 	"github.com/jhaprabhatt/account-transfer-project/internal/models"
 	"github.com/jhaprabhatt/account-transfer-project/internal/service"
 	"github.com/jhaprabhatt/account-transfer-project/internal/service/mocks"
@@ -97,6 +99,84 @@ func TestAccountService_CreateAccount(t *testing.T) {
 	}
 }
 
+func TestAccountService_CreateAccount_Currency(t *testing.T) {
+	t.Run("Success: Currency Defaulted And Normalized", func(t *testing.T) {
+		mockRepo := new(mocks.MockAccountRepo)
+		mockCache := new(mocks.MockCache)
+		svc := service.NewAccountService(mockRepo, mockCache, zap.NewNop())
+
+		acc := &models.Account{ID: 7, Balance: decimal.NewFromInt(1000), Currency: "jpy"}
+		mockCache.On("Exists", mock.Anything, acc.ID).Return(false, nil)
+		mockRepo.On("CreateAccount", mock.Anything, acc).Return(nil)
+		mockCache.On("SetAccount", mock.Anything, acc).Return(nil)
+
+		err := svc.CreateAccount(context.Background(), acc)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "JPY", acc.Currency)
+	})
+
+	t.Run("Failure: Unsupported Currency", func(t *testing.T) {
+		mockRepo := new(mocks.MockAccountRepo)
+		mockCache := new(mocks.MockCache)
+		svc := service.NewAccountService(mockRepo, mockCache, zap.NewNop())
+
+		acc := &models.Account{ID: 7, Balance: decimal.NewFromInt(10), Currency: "XYZ"}
+		mockCache.On("Exists", mock.Anything, acc.ID).Return(false, nil)
+
+		err := svc.CreateAccount(context.Background(), acc)
+
+		assert.ErrorIs(t, err, constants.ErrUnsupportedCurrency)
+		mockRepo.AssertNotCalled(t, "CreateAccount")
+	})
+
+	t.Run("Failure: Balance Exceeds Currency Scale", func(t *testing.T) {
+		mockRepo := new(mocks.MockAccountRepo)
+		mockCache := new(mocks.MockCache)
+		svc := service.NewAccountService(mockRepo, mockCache, zap.NewNop())
+
+		acc := &models.Account{ID: 7, Balance: decimal.RequireFromString("10.001"), Currency: "USD"}
+		mockCache.On("Exists", mock.Anything, acc.ID).Return(false, nil)
+
+		err := svc.CreateAccount(context.Background(), acc)
+
+		assert.ErrorIs(t, err, constants.ErrInvalidAmountScale)
+		mockRepo.AssertNotCalled(t, "CreateAccount")
+	})
+}
+
+func TestAccountService_CreateAccount_Class(t *testing.T) {
+	t.Run("Success: Class Defaulted", func(t *testing.T) {
+		mockRepo := new(mocks.MockAccountRepo)
+		mockCache := new(mocks.MockCache)
+		svc := service.NewAccountService(mockRepo, mockCache, zap.NewNop())
+
+		acc := &models.Account{ID: 7, Balance: decimal.NewFromInt(10)}
+		mockCache.On("Exists", mock.Anything, acc.ID).Return(false, nil)
+		mockRepo.On("CreateAccount", mock.Anything, acc).Return(nil)
+		mockCache.On("SetAccount", mock.Anything, acc).Return(nil)
+
+		err := svc.CreateAccount(context.Background(), acc)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.DefaultAccountClass, acc.Class)
+	})
+
+	t.Run("Failure: Invalid Class", func(t *testing.T) {
+		mockRepo := new(mocks.MockAccountRepo)
+		mockCache := new(mocks.MockCache)
+		svc := service.NewAccountService(mockRepo, mockCache, zap.NewNop())
+
+		acc := &models.Account{ID: 7, Balance: decimal.NewFromInt(10), Class: "gold plus"}
+		mockCache.On("Exists", mock.Anything, acc.ID).Return(false, nil)
+
+		err := svc.CreateAccount(context.Background(), acc)
+
+		assert.ErrorIs(t, err, constants.ErrInvalidAccountClass)
+		mockRepo.AssertNotCalled(t, "CreateAccount")
+	})
+}
+
 func TestAccountService_GetAccount(t *testing.T) {
 	accountID := int64(101)
 	expectedAcc := &models.Account{
@@ -111,8 +191,17 @@ func TestAccountService_GetAccount(t *testing.T) {
 		expectedError string
 	}{
 		{
-			name: "Success: Account Found (Trigger Read-Repair)",
+			name: "Success: Served From Cache",
 			mockBehaviour: func(repo *mocks.MockAccountRepo, cache *mocks.MockCache) {
+				cache.On("GetAccount", mock.Anything, accountID).Return(expectedAcc, nil)
+			},
+			expectedAcc:   expectedAcc,
+			expectedError: "",
+		},
+		{
+			name: "Success: Cache Miss (Trigger Read-Repair)",
+			mockBehaviour: func(repo *mocks.MockAccountRepo, cache *mocks.MockCache) {
+				cache.On("GetAccount", mock.Anything, accountID).Return(nil, nil)
 				repo.On("GetAccount", mock.Anything, accountID).Return(expectedAcc, nil)
 				cache.On("SetAccount", mock.Anything, expectedAcc).Return(nil)
 			},
@@ -120,16 +209,46 @@ func TestAccountService_GetAccount(t *testing.T) {
 			expectedError: "",
 		},
 		{
-			name: "Failure: Account Not Found",
+			name: "Success: Cache Error Falls Back To Database",
+			mockBehaviour: func(repo *mocks.MockAccountRepo, cache *mocks.MockCache) {
+				cache.On("GetAccount", mock.Anything, accountID).Return(nil, errors.New("redis timeout"))
+				repo.On("GetAccount", mock.Anything, accountID).Return(expectedAcc, nil)
+			},
+			expectedAcc:   expectedAcc,
+			expectedError: "",
+		},
+		{
+			name: "Success: Cache Repopulate Failure Still Returns Account",
+			mockBehaviour: func(repo *mocks.MockAccountRepo, cache *mocks.MockCache) {
+				cache.On("GetAccount", mock.Anything, accountID).Return(nil, nil)
+				repo.On("GetAccount", mock.Anything, accountID).Return(expectedAcc, nil)
+				cache.On("SetAccount", mock.Anything, expectedAcc).Return(errors.New("redis down"))
+			},
+			expectedAcc:   expectedAcc,
+			expectedError: "",
+		},
+		{
+			name: "Failure: Account Not Found Cached As Missing",
+			mockBehaviour: func(repo *mocks.MockAccountRepo, cache *mocks.MockCache) {
+				cache.On("GetAccount", mock.Anything, accountID).Return(nil, nil)
+				repo.On("GetAccount", mock.Anything, accountID).Return(nil, constants.ErrAccountNotFound)
+				cache.On("SetMissing", mock.Anything, accountID).Return(nil)
+			},
+			expectedAcc:   nil,
+			expectedError: constants.ErrAccountNotFound.Error(),
+		},
+		{
+			name: "Failure: Cached As Missing",
 			mockBehaviour: func(repo *mocks.MockAccountRepo, cache *mocks.MockCache) {
-				repo.On("GetAccount", mock.Anything, accountID).Return(nil, errors.New("account not found"))
+				cache.On("GetAccount", mock.Anything, accountID).Return(nil, constants.ErrAccountNotFound)
 			},
 			expectedAcc:   nil,
-			expectedError: "account not found",
+			expectedError: constants.ErrAccountNotFound.Error(),
 		},
 		{
 			name: "Failure: Database Error",
 			mockBehaviour: func(repo *mocks.MockAccountRepo, cache *mocks.MockCache) {
+				cache.On("GetAccount", mock.Anything, accountID).Return(nil, nil)
 				repo.On("GetAccount", mock.Anything, accountID).Return(nil, errors.New("db connection error"))
 			},
 			expectedAcc:   nil,
@@ -163,6 +282,26 @@ func TestAccountService_GetAccount(t *testing.T) {
 	}
 }
 
+func TestAccountService_GetAccount_UnreachableCacheBypassed(t *testing.T) {
+	repo := new(mocks.MockAccountRepo)
+	cache := new(mocks.MockCache)
+	svc := service.NewAccountService(repo, cache, zap.NewNop())
+
+	acc := &models.Account{ID: 101, Balance: decimal.NewFromFloat(1000.00)}
+	cache.On("GetAccount", mock.Anything, int64(101)).Return(nil, errors.New("redis timeout")).Once()
+	repo.On("GetAccount", mock.Anything, int64(101)).Return(acc, nil)
+
+	for range 2 {
+		got, err := svc.GetAccount(context.Background(), 101)
+		assert.NoError(t, err)
+		assert.Equal(t, acc, got)
+	}
+
+	cache.AssertNumberOfCalls(t, "GetAccount", 1)
+	cache.AssertNotCalled(t, "SetAccount", mock.Anything, mock.Anything)
+	repo.AssertNumberOfCalls(t, "GetAccount", 2)
+}
+
 func TestAccountService_LoadAllAccountsToCache(t *testing.T) {
 	acc1 := models.Account{ID: 1, Balance: decimal.NewFromFloat(100.0)}
 	acc2 := models.Account{ID: 2, Balance: decimal.NewFromFloat(200.0)}
@@ -223,3 +362,223 @@ func TestAccountService_LoadAllAccountsToCache(t *testing.T) {
 		})
 	}
 }
+
+func TestAccountService_StatusChanges(t *testing.T) {
+	accountID := int64(101)
+
+	t.Run("Success: Freeze Refreshes Cache", func(t *testing.T) {
+		mockRepo := new(mocks.MockAccountRepo)
+		mockCache := new(mocks.MockCache)
+		svc := service.NewAccountService(mockRepo, mockCache, zap.NewNop())
+
+		frozen := &models.Account{ID: accountID, Balance: decimal.NewFromInt(10), Status: constants.AccountFrozen}
+		mockRepo.On("UpdateStatus", mock.Anything, accountID, constants.AccountFrozen).Return(frozen, nil)
+		mockCache.On("SetAccount", mock.Anything, frozen).Return(nil)
+
+		acc, err := svc.FreezeAccount(context.Background(), accountID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, constants.AccountFrozen, acc.Status)
+		mockRepo.AssertExpectations(t)
+		mockCache.AssertExpectations(t)
+	})
+
+	t.Run("Success: Unfreeze Survives Cache Failure", func(t *testing.T) {
+		mockRepo := new(mocks.MockAccountRepo)
+		mockCache := new(mocks.MockCache)
+		svc := service.NewAccountService(mockRepo, mockCache, zap.NewNop())
+
+		active := &models.Account{ID: accountID, Status: constants.AccountActive}
+		mockRepo.On("UpdateStatus", mock.Anything, accountID, constants.AccountActive).Return(active, nil)
+		mockCache.On("SetAccount", mock.Anything, active).Return(errors.New("redis timeout"))
+
+		acc, err := svc.UnfreezeAccount(context.Background(), accountID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, constants.AccountActive, acc.Status)
+	})
+
+	t.Run("Failure: Close Rejected", func(t *testing.T) {
+		mockRepo := new(mocks.MockAccountRepo)
+		mockCache := new(mocks.MockCache)
+		svc := service.NewAccountService(mockRepo, mockCache, zap.NewNop())
+
+		mockRepo.On("UpdateStatus", mock.Anything, accountID, constants.AccountClosed).
+			Return(nil, constants.ErrAccountBalanceNotZero)
+
+		acc, err := svc.CloseAccount(context.Background(), accountID)
+
+		assert.ErrorIs(t, err, constants.ErrAccountBalanceNotZero)
+		assert.Nil(t, acc)
+		mockCache.AssertNotCalled(t, "SetAccount")
+	})
+}
+
+func TestAccountService_UpdateOverdraftLimit(t *testing.T) {
+	accountID := int64(101)
+
+	t.Run("Success: Refreshes Cache", func(t *testing.T) {
+		mockRepo := new(mocks.MockAccountRepo)
+		mockCache := new(mocks.MockCache)
+		svc := service.NewAccountService(mockRepo, mockCache, zap.NewNop())
+
+		limit := decimal.NewFromInt(500)
+		updated := &models.Account{ID: accountID, Status: constants.AccountActive, OverdraftLimit: limit}
+		mockRepo.On("UpdateOverdraftLimit", mock.Anything, accountID, limit).Return(updated, nil)
+		mockCache.On("SetAccount", mock.Anything, updated).Return(nil)
+
+		acc, err := svc.UpdateOverdraftLimit(context.Background(), accountID, limit)
+
+		assert.NoError(t, err)
+		assert.True(t, limit.Equal(acc.OverdraftLimit))
+		mockRepo.AssertExpectations(t)
+		mockCache.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Limit Too Low", func(t *testing.T) {
+		mockRepo := new(mocks.MockAccountRepo)
+		mockCache := new(mocks.MockCache)
+		svc := service.NewAccountService(mockRepo, mockCache, zap.NewNop())
+
+		mockRepo.On("UpdateOverdraftLimit", mock.Anything, accountID, decimal.Zero).Return(nil, constants.ErrOverdraftLimitTooLow)
+
+		_, err := svc.UpdateOverdraftLimit(context.Background(), accountID, decimal.Zero)
+
+		assert.ErrorIs(t, err, constants.ErrOverdraftLimitTooLow)
+		mockCache.AssertNotCalled(t, "SetAccount")
+	})
+
+	t.Run("Failure: Negative Limit On Create", func(t *testing.T) {
+		mockRepo := new(mocks.MockAccountRepo)
+		mockCache := new(mocks.MockCache)
+		svc := service.NewAccountService(mockRepo, mockCache, zap.NewNop())
+
+		acc := &models.Account{ID: 7, Balance: decimal.NewFromInt(10), OverdraftLimit: decimal.NewFromInt(-1)}
+		mockCache.On("Exists", mock.Anything, acc.ID).Return(false, nil)
+
+		err := svc.CreateAccount(context.Background(), acc)
+
+		assert.ErrorIs(t, err, constants.ErrInvalidOverdraftLimit)
+		mockRepo.AssertNotCalled(t, "CreateAccount")
+	})
+}
+
+func TestAccountService_Limits(t *testing.T) {
+	accountID := int64(101)
+
+	t.Run("Success: Limits Set", func(t *testing.T) {
+		mockRepo := new(mocks.MockAccountRepo)
+		svc := service.NewAccountService(mockRepo, new(mocks.MockCache), zap.NewNop())
+
+		limits := models.TransferLimits{DailyCount: 20}
+		set := &models.AccountLimits{AccountID: accountID, Limits: limits}
+		mockRepo.On("SetLimits", mock.Anything, accountID, limits).Return(set, nil)
+
+		got, err := svc.SetLimits(context.Background(), accountID, limits)
+
+		assert.NoError(t, err)
+		assert.Equal(t, set, got)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Success: Limits Cleared", func(t *testing.T) {
+		mockRepo := new(mocks.MockAccountRepo)
+		svc := service.NewAccountService(mockRepo, new(mocks.MockCache), zap.NewNop())
+
+		mockRepo.On("ClearLimits", mock.Anything, accountID).Return(&models.AccountLimits{AccountID: accountID}, nil)
+
+		got, err := svc.ClearLimits(context.Background(), accountID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &models.AccountLimits{AccountID: accountID}, got)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Invalid Account ID", func(t *testing.T) {
+		mockRepo := new(mocks.MockAccountRepo)
+		svc := service.NewAccountService(mockRepo, new(mocks.MockCache), zap.NewNop())
+
+		_, err := svc.GetLimits(context.Background(), 0)
+
+		assert.ErrorIs(t, err, constants.ErrInvalidAccountID)
+		mockRepo.AssertNotCalled(t, "GetLimits")
+	})
+}
+
+func TestAccountService_GetBalanceAt(t *testing.T) {
+	accountID := int64(101)
+
+	t.Run("Success: Delegates To Repository", func(t *testing.T) {
+		mockRepo := new(mocks.MockAccountRepo)
+		svc := service.NewAccountService(mockRepo, new(mocks.MockCache), zap.NewNop())
+
+		at := time.Date(2026, 3, 1, 14, 0, 0, 0, time.UTC)
+		want := &models.BalanceAt{AccountID: accountID, Balance: decimal.NewFromInt(450), Currency: "USD", At: at}
+		mockRepo.On("GetBalanceAt", mock.Anything, accountID, at).Return(want, nil)
+
+		got, err := svc.GetBalanceAt(context.Background(), accountID, at)
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Failure: Time In Future", func(t *testing.T) {
+		mockRepo := new(mocks.MockAccountRepo)
+		svc := service.NewAccountService(mockRepo, new(mocks.MockCache), zap.NewNop())
+
+		_, err := svc.GetBalanceAt(context.Background(), accountID, time.Now().Add(time.Hour))
+
+		assert.ErrorIs(t, err, constants.ErrBalanceTimeInFuture)
+		mockRepo.AssertNotCalled(t, "GetBalanceAt", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure: Invalid Account ID", func(t *testing.T) {
+		svc := service.NewAccountService(new(mocks.MockAccountRepo), new(mocks.MockCache), zap.NewNop())
+
+		_, err := svc.GetBalanceAt(context.Background(), 0, time.Now())
+
+		assert.ErrorIs(t, err, constants.ErrInvalidAccountID)
+	})
+}
+
+func TestAccountService_GetStatement(t *testing.T) {
+	q := models.StatementQuery{
+		AccountID: 101,
+		From:      time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		To:        time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("Success: Delegates To Repository", func(t *testing.T) {
+		mockRepo := new(mocks.MockAccountRepo)
+		svc := service.NewAccountService(mockRepo, new(mocks.MockCache), zap.NewNop())
+
+		want := &models.Statement{AccountID: q.AccountID, Currency: "USD", From: q.From, To: q.To}
+		mockRepo.On("GetStatement", mock.Anything, q).Return(want, nil)
+
+		got, err := svc.GetStatement(context.Background(), q)
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Failure: Range Too Long", func(t *testing.T) {
+		mockRepo := new(mocks.MockAccountRepo)
+		svc := service.NewAccountService(mockRepo, new(mocks.MockCache), zap.NewNop())
+
+		long := q
+		long.To = q.From.AddDate(0, 0, models.MaxStatementDays+1)
+		_, err := svc.GetStatement(context.Background(), long)
+
+		assert.ErrorIs(t, err, constants.ErrStatementRangeTooLong)
+		mockRepo.AssertNotCalled(t, "GetStatement", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure: From After To", func(t *testing.T) {
+		svc := service.NewAccountService(new(mocks.MockAccountRepo), new(mocks.MockCache), zap.NewNop())
+
+		reversed := models.StatementQuery{AccountID: q.AccountID, From: q.To, To: q.From}
+		_, err := svc.GetStatement(context.Background(), reversed)
+
+		assert.ErrorIs(t, err, constants.ErrInvalidTimeRange)
+	})
+}
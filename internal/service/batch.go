@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"go.uber.org/zap"
+)
+
+// MakeBatchTransfer runs the cached pre-checks on every leg and fixes its fee,
+// then posts the batch in one transaction. A leg failing its pre-checks fails
+// an all-or-nothing batch outright and is skipped by a best-effort one.
+func (s *TransferService) MakeBatchTransfer(ctx context.Context, batch *models.BatchTransferRequest) error {
+	if err := batch.Validate(); err != nil {
+		return err
+	}
+
+	for i := range batch.Legs {
+		leg := &batch.Legs[i]
+		err := leg.Validate()
+		var src *models.Account
+		if err == nil {
+			src, err = s.prepareTransfer(ctx, &leg.TransferRequest)
+		}
+		if err == nil {
+			err = s.applyFee(&leg.TransferRequest, src)
+		}
+		if err == nil {
+			continue
+		}
+		if !batch.BestEffort {
+			return &models.LegError{Index: i, Err: err}
+		}
+		leg.Err = err
+	}
+
+	if err := s.transferRepo.TransferBatch(ctx, batch); err != nil {
+		return err
+	}
+
+	s.refreshAccounts(ctx, batch.AccountIDs()...)
+
+	s.log.Info("Batch transfer posted",
+		zap.Int("legs", len(batch.Legs)),
+		zap.Int("failed", batch.Failed()),
+		zap.Bool("best_effort", batch.BestEffort))
+
+	return nil
+}
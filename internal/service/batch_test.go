@@ -0,0 +1,74 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+func TestTransferService_MakeBatchTransfer(t *testing.T) {
+	newBatch := func(bestEffort bool) *models.BatchTransferRequest {
+		return &models.BatchTransferRequest{
+			Legs: []models.BatchLeg{
+				{TransferRequest: models.TransferRequest{SourceID: 1, DestinationID: 2, Amount: decimal.NewFromInt(10)}},
+				{TransferRequest: models.TransferRequest{SourceID: 1, DestinationID: 3, Amount: decimal.NewFromInt(20)}},
+			},
+			BestEffort: bestEffort,
+		}
+	}
+
+	t.Run("Success: Delegates To Repository", func(t *testing.T) {
+		mockRepo, mockCache, svc := newTestSetup(t)
+		mockCache.On("GetAccount", mock.Anything, mock.Anything).Return(activeAccount(1), nil)
+		mockRepo.On("TransferBatch", mock.Anything, mock.Anything).Return(nil)
+		mockRepo.On("GetAccounts", mock.Anything, []int64{1, 2, 3}).Return(nil, nil)
+
+		require.NoError(t, svc.MakeBatchTransfer(context.Background(), newBatch(false)))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Empty Batch", func(t *testing.T) {
+		mockRepo, _, svc := newTestSetup(t)
+
+		err := svc.MakeBatchTransfer(context.Background(), &models.BatchTransferRequest{})
+		assert.ErrorIs(t, err, constants.ErrEmptyBatch)
+		mockRepo.AssertNotCalled(t, "TransferBatch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure: Atomic Batch Stops At Invalid Leg", func(t *testing.T) {
+		mockRepo, mockCache, svc := newTestSetup(t)
+		mockCache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		mockCache.On("GetAccount", mock.Anything, int64(2)).Return(activeAccount(2), nil)
+		mockCache.On("GetAccount", mock.Anything, int64(3)).Return(nil, constants.ErrAccountNotFound)
+
+		err := svc.MakeBatchTransfer(context.Background(), newBatch(false))
+		var legErr *models.LegError
+		require.ErrorAs(t, err, &legErr)
+		assert.Equal(t, 1, legErr.Index)
+		assert.ErrorIs(t, err, constants.ErrAccountNotFound)
+		mockRepo.AssertNotCalled(t, "TransferBatch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success: Best Effort Marks Invalid Legs", func(t *testing.T) {
+		mockRepo, mockCache, svc := newTestSetup(t)
+		mockCache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		mockCache.On("GetAccount", mock.Anything, int64(2)).Return(activeAccount(2), nil)
+
+		batch := newBatch(true)
+		batch.Legs[1].Amount = decimal.Zero
+		mockRepo.On("TransferBatch", mock.Anything, batch).Return(nil)
+		// Only the legs that were posted are refreshed.
+		mockRepo.On("GetAccounts", mock.Anything, []int64{1, 2}).Return(nil, nil)
+
+		require.NoError(t, svc.MakeBatchTransfer(context.Background(), batch))
+		assert.NoError(t, batch.Legs[0].Err)
+		assert.ErrorIs(t, batch.Legs[1].Err, constants.ErrAmountMustBePositive)
+	})
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"go.uber.org/zap"
+)
+
+// Deposit credits an account with money entering the system, posted as a
+// transfer from the settlement account of the account's currency.
+func (s *TransferService) Deposit(ctx context.Context, req *models.CashRequest) (*models.CashResult, error) {
+	return s.moveCash(ctx, req, constants.KindDeposit)
+}
+
+// Withdraw debits an account for money leaving the system, posted as a
+// transfer to the settlement account of the account's currency.
+func (s *TransferService) Withdraw(ctx context.Context, req *models.CashRequest) (*models.CashResult, error) {
+	return s.moveCash(ctx, req, constants.KindWithdrawal)
+}
+
+// moveCash runs a deposit or withdrawal through the transfer path, so it is
+// locked, limit-checked, idempotent and audited like any other transfer. Fees
+// do not apply.
+func (s *TransferService) moveCash(ctx context.Context, req *models.CashRequest, kind constants.TransferKind) (*models.CashResult, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	acc, err := s.accounts.get(ctx, req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	settlementID, ok := s.settlement[acc.Currency]
+	if !ok {
+		s.log.Warn("No settlement account for currency",
+			zap.String("currency", acc.Currency),
+			zap.String("kind", kind.String()))
+		return nil, constants.ErrSettlementMissing
+	}
+
+	transfer := req.Transfer(kind, settlementID)
+	if _, _, err := s.validateTransfer(ctx, transfer); err != nil {
+		return nil, err
+	}
+
+	result, err := s.transferRepo.Transfer(ctx, transfer)
+	if err != nil {
+		return nil, err
+	}
+	s.refreshAccounts(ctx, transfer.AccountIDs()...)
+
+	newBalance := result.DestinationPostBalance
+	if kind == constants.KindWithdrawal {
+		newBalance = result.SourcePostBalance
+	}
+
+	s.log.Info("Cash movement posted",
+		zap.String("kind", kind.String()),
+		zap.Int64("account_id", req.AccountID),
+		zap.Int64("settlement_account_id", settlementID),
+		zap.Int64("audit_id", result.AuditID))
+
+	return &models.CashResult{
+		AuditID:       result.AuditID,
+		CorrelationID: result.CorrelationID,
+		AccountID:     req.AccountID,
+		Kind:          kind,
+		Amount:        result.SourceAmount,
+		Currency:      result.SourceCurrency,
+		NewBalance:    newBalance,
+	}, nil
+}
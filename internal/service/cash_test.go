@@ -0,0 +1,94 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+func TestTransferService_Cash(t *testing.T) {
+	settlement := models.SettlementAccounts{"USD": 900}
+
+	t.Run("Success: Deposit Moves Money From Settlement", func(t *testing.T) {
+		repo, cache, svc := newCashTestSetup(t, settlement)
+
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		cache.On("GetAccount", mock.Anything, int64(900)).Return(activeAccount(900), nil)
+		repo.On("GetAccounts", mock.Anything, []int64{1, 900}).Return(nil, nil)
+		repo.On("Transfer", mock.Anything, mock.MatchedBy(func(req *models.TransferRequest) bool {
+			return req.SourceID == 900 && req.DestinationID == 1 && req.Kind == constants.KindDeposit && req.Fee == nil
+		})).Return(&models.TransferResult{
+			AuditID: 10, SourcePostBalance: "-50", DestinationPostBalance: "1050", SourceAmount: "50", SourceCurrency: "USD",
+		}, nil)
+
+		result, err := svc.Deposit(context.Background(), &models.CashRequest{AccountID: 1, Amount: decimal.NewFromInt(50)})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), result.AuditID)
+		assert.Equal(t, constants.KindDeposit, result.Kind)
+		assert.Equal(t, "1050", result.NewBalance)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Success: Withdrawal Moves Money To Settlement", func(t *testing.T) {
+		repo, cache, svc := newCashTestSetup(t, settlement)
+
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		cache.On("GetAccount", mock.Anything, int64(900)).Return(activeAccount(900), nil)
+		repo.On("GetAccounts", mock.Anything, []int64{1, 900}).Return(nil, nil)
+		repo.On("Transfer", mock.Anything, mock.MatchedBy(func(req *models.TransferRequest) bool {
+			return req.SourceID == 1 && req.DestinationID == 900 && req.Kind == constants.KindWithdrawal
+		})).Return(&models.TransferResult{
+			AuditID: 11, SourcePostBalance: "970", DestinationPostBalance: "30", SourceAmount: "30", SourceCurrency: "USD",
+		}, nil)
+
+		result, err := svc.Withdraw(context.Background(), &models.CashRequest{AccountID: 1, Amount: decimal.NewFromInt(30)})
+
+		require.NoError(t, err)
+		assert.Equal(t, "970", result.NewBalance)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Failure: No Settlement Account For Currency", func(t *testing.T) {
+		repo, cache, svc := newCashTestSetup(t, settlement)
+		acc := activeAccount(1)
+		acc.Currency = "EUR"
+
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(acc, nil)
+
+		_, err := svc.Deposit(context.Background(), &models.CashRequest{AccountID: 1, Amount: decimal.NewFromInt(50)})
+
+		assert.ErrorIs(t, err, constants.ErrSettlementMissing)
+		repo.AssertNotCalled(t, "Transfer", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure: Withdrawal From Frozen Account", func(t *testing.T) {
+		repo, cache, svc := newCashTestSetup(t, settlement)
+		acc := activeAccount(1)
+		acc.Status = constants.AccountFrozen
+
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(acc, nil)
+		cache.On("GetAccount", mock.Anything, int64(900)).Return(activeAccount(900), nil)
+
+		_, err := svc.Withdraw(context.Background(), &models.CashRequest{AccountID: 1, Amount: decimal.NewFromInt(30)})
+
+		assert.ErrorIs(t, err, constants.ErrAccountFrozen)
+		repo.AssertNotCalled(t, "Transfer", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure: Non-Positive Amount", func(t *testing.T) {
+		_, cache, svc := newCashTestSetup(t, settlement)
+
+		_, err := svc.Deposit(context.Background(), &models.CashRequest{AccountID: 1, Amount: decimal.Zero})
+
+		assert.ErrorIs(t, err, constants.ErrAmountMustBePositive)
+		cache.AssertNotCalled(t, "GetAccount", mock.Anything, mock.Anything)
+	})
+}
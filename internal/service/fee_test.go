@@ -0,0 +1,143 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+func TestTransferService_MakeTransfer_Fees(t *testing.T) {
+	fees, err := models.ParseFeeSchedule(`{
+		"standard": {"type": "percentage", "rate": "0.015", "min": "0.50", "max": "10"},
+		"business": {"type": "tiered", "tiers": [
+			{"up_to": "100", "fee": {"type": "flat", "amount": "1"}},
+			{"fee": {"type": "flat", "amount": "3"}}]},
+		"premium": {"type": "flat", "amount": "0"}
+	}`, "USD:900")
+	require.NoError(t, err)
+
+	classAccount := func(id int64, class string) *models.Account {
+		acc := activeAccount(id)
+		acc.Class = class
+		return acc
+	}
+
+	feeOf := func(amount string) any {
+		return mock.MatchedBy(func(req *models.TransferRequest) bool {
+			if amount == "" {
+				return req.Fee == nil
+			}
+			return req.Fee != nil && req.Fee.AccountID == 900 && req.Fee.Amount.Equal(decimal.RequireFromString(amount))
+		})
+	}
+
+	tests := []struct {
+		name    string
+		src     *models.Account
+		amount  string
+		wantFee string
+	}{
+		{name: "Percentage Rounded To Minor Units", src: classAccount(1, "standard"), amount: "123.45", wantFee: "1.85"},
+		{name: "Percentage Raised To Minimum", src: classAccount(1, "standard"), amount: "10", wantFee: "0.5"},
+		{name: "Percentage Capped At Maximum", src: classAccount(1, "standard"), amount: "5000", wantFee: "10"},
+		{name: "Cached Account Without Class Is Standard", src: activeAccount(1), amount: "100", wantFee: "1.5"},
+		{name: "Lower Tier", src: classAccount(1, "business"), amount: "100", wantFee: "1"},
+		{name: "Upper Tier", src: classAccount(1, "business"), amount: "100.01", wantFee: "3"},
+		{name: "Zero Fee Is Not Charged", src: classAccount(1, "premium"), amount: "100"},
+		{name: "Class Without Policy Pays No Fee", src: classAccount(1, "internal"), amount: "100"},
+		{name: "Fee Account Pays No Fee", src: classAccount(900, "standard"), amount: "100"},
+	}
+
+	for _, tt := range tests {
+		t.Run("Success: "+tt.name, func(t *testing.T) {
+			repo, cache, svc := newFeeTestSetup(t, fees)
+			req := &models.TransferRequest{SourceID: tt.src.ID, DestinationID: 2, Amount: decimal.RequireFromString(tt.amount)}
+
+			cache.On("GetAccount", mock.Anything, tt.src.ID).Return(tt.src, nil)
+			cache.On("GetAccount", mock.Anything, int64(2)).Return(activeAccount(2), nil)
+			repo.On("Transfer", mock.Anything, feeOf(tt.wantFee)).Return(&models.TransferResult{Fee: tt.wantFee}, nil)
+			repo.On("GetAccounts", mock.Anything, mock.Anything).Return(nil, nil)
+
+			result, err := svc.MakeTransfer(context.Background(), req)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantFee, result.Fee)
+			repo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Failure: No Fee Account For Currency", func(t *testing.T) {
+		repo, cache, svc := newFeeTestSetup(t, fees)
+		src := classAccount(1, "standard")
+		src.Currency = "EUR"
+		dest := activeAccount(2)
+		dest.Currency = "EUR"
+
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(src, nil)
+		cache.On("GetAccount", mock.Anything, int64(2)).Return(dest, nil)
+
+		_, err := svc.MakeTransfer(context.Background(), &models.TransferRequest{
+			SourceID: 1, DestinationID: 2, Amount: decimal.NewFromInt(100),
+		})
+
+		assert.ErrorIs(t, err, constants.ErrFeeAccountMissing)
+		repo.AssertNotCalled(t, "Transfer", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTransferService_Fees_OtherPaths(t *testing.T) {
+	fees, err := models.ParseFeeSchedule(`{"standard": {"type": "flat", "amount": "2"}}`, "USD:900")
+	require.NoError(t, err)
+
+	t.Run("Success: Batch Leg Pays Its Fee", func(t *testing.T) {
+		repo, cache, svc := newFeeTestSetup(t, fees)
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		cache.On("GetAccount", mock.Anything, int64(2)).Return(activeAccount(2), nil)
+		repo.On("TransferBatch", mock.Anything, mock.MatchedBy(func(b *models.BatchTransferRequest) bool {
+			fee := b.Legs[0].Fee
+			return fee != nil && fee.AccountID == 900 && fee.Amount.Equal(decimal.NewFromInt(2))
+		})).Return(nil)
+		repo.On("GetAccounts", mock.Anything, []int64{1, 2, 900}).Return(nil, nil)
+
+		err := svc.MakeBatchTransfer(context.Background(), &models.BatchTransferRequest{Legs: []models.BatchLeg{
+			{TransferRequest: models.TransferRequest{SourceID: 1, DestinationID: 2, Amount: decimal.NewFromInt(50)}},
+		}})
+
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Hold On Fee-Paying Source", func(t *testing.T) {
+		repo, cache, svc := newFeeTestSetup(t, fees)
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		cache.On("GetAccount", mock.Anything, int64(2)).Return(activeAccount(2), nil)
+
+		_, err := svc.AuthorizeTransfer(context.Background(), &models.AuthorizeRequest{
+			TransferRequest: models.TransferRequest{SourceID: 1, DestinationID: 2, Amount: decimal.NewFromInt(50)},
+		})
+
+		assert.ErrorIs(t, err, constants.ErrFeeNotSupported)
+		repo.AssertNotCalled(t, "Authorize", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure: Multi-Leg Debit From Fee-Paying Source", func(t *testing.T) {
+		repo, cache, svc := newFeeTestSetup(t, fees)
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		cache.On("GetAccount", mock.Anything, int64(2)).Return(activeAccount(2), nil)
+
+		_, err := svc.MakeMultiLegTransfer(context.Background(), &models.MultiLegTransferRequest{
+			Debits:  []models.Leg{{AccountID: 1, Amount: decimal.NewFromInt(50)}},
+			Credits: []models.Leg{{AccountID: 2, Amount: decimal.NewFromInt(50)}},
+		})
+
+		assert.ErrorIs(t, err, constants.ErrFeeNotSupported)
+		repo.AssertNotCalled(t, "TransferMultiLeg", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	"github.com/jhaprabhatt/account-transfer-project/internal/repository"
+
+	"go.uber.org/zap"
+)
+
+type FxRateService struct {
+	repo repository.FxRateRepo
+	log  *zap.Logger
+}
+
+func NewFxRateService(repo repository.FxRateRepo, log *zap.Logger) *FxRateService {
+	return &FxRateService{repo: repo, log: log}
+}
+
+// LoadRates validates every rate before appending any, so a bad row rejects
+// the whole load. Rates without an effective time take effect immediately.
+func (s *FxRateService) LoadRates(ctx context.Context, rates []models.FxRate) error {
+	now := time.Now().UTC()
+	for i := range rates {
+		if err := rates[i].Validate(); err != nil {
+			return err
+		}
+		if rates[i].EffectiveAt.IsZero() {
+			rates[i].EffectiveAt = now
+		}
+	}
+
+	if err := s.repo.SaveRates(ctx, rates); err != nil {
+		return err
+	}
+
+	s.log.Info("FX rates loaded", zap.Int("count", len(rates)))
+	return nil
+}
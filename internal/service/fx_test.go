@@ -0,0 +1,59 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	"github.com/jhaprabhatt/account-transfer-project/internal/service"
+	"github.com/jhaprabhatt/account-transfer-project/internal/service/mocks"
+)
+
+func TestFxRateService_LoadRates(t *testing.T) {
+	t.Run("Success: Missing Effective Time Defaults To Now", func(t *testing.T) {
+		repo := new(mocks.MockFxRateRepo)
+		svc := service.NewFxRateService(repo, zap.NewNop())
+
+		repo.On("SaveRates", mock.Anything, mock.MatchedBy(func(rates []models.FxRate) bool {
+			return len(rates) == 1 && !rates[0].EffectiveAt.IsZero()
+		})).Return(nil)
+
+		err := svc.LoadRates(context.Background(), []models.FxRate{
+			{Base: "USD", Quote: "JPY", Rate: decimal.RequireFromString("151.2")},
+		})
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Failure: One Bad Rate Rejects The Load", func(t *testing.T) {
+		repo := new(mocks.MockFxRateRepo)
+		svc := service.NewFxRateService(repo, zap.NewNop())
+
+		err := svc.LoadRates(context.Background(), []models.FxRate{
+			{Base: "USD", Quote: "JPY", Rate: decimal.RequireFromString("151.2")},
+			{Base: "USD", Quote: "EUR", Rate: decimal.Zero},
+		})
+
+		assert.ErrorIs(t, err, constants.ErrInvalidFxRate)
+		repo.AssertNotCalled(t, "SaveRates")
+	})
+
+	t.Run("Failure: Unsupported Currency", func(t *testing.T) {
+		repo := new(mocks.MockFxRateRepo)
+		svc := service.NewFxRateService(repo, zap.NewNop())
+
+		err := svc.LoadRates(context.Background(), []models.FxRate{
+			{Base: "USD", Quote: "XYZ", Rate: decimal.NewFromInt(2)},
+		})
+
+		assert.ErrorIs(t, err, constants.ErrUnsupportedCurrency)
+		repo.AssertNotCalled(t, "SaveRates")
+	})
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"go.uber.org/zap"
+)
+
+// AuthorizeTransfer places a hold for a transfer to be captured later. It runs
+// the same cached pre-checks as MakeTransfer before reserving the funds. A
+// capture cannot post a fee, so sources whose class pays one are turned away.
+func (s *TransferService) AuthorizeTransfer(ctx context.Context, req *models.AuthorizeRequest) (*models.Hold, error) {
+	now := time.Now()
+	if err := req.Validate(now); err != nil {
+		return nil, err
+	}
+
+	src, _, err := s.validateTransfer(ctx, &req.TransferRequest)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.rejectFee(src, req.Amount); err != nil {
+		return nil, err
+	}
+
+	if req.ExpiresAt.IsZero() {
+		req.ExpiresAt = now.Add(models.DefaultHoldTTL)
+	}
+
+	hold, err := s.transferRepo.Authorize(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	s.refreshAccounts(ctx, hold.SourceID)
+
+	s.log.Info("Hold placed",
+		zap.Int64("hold_id", hold.ID),
+		zap.Int64("account_id", hold.SourceID),
+		zap.String("amount", hold.Amount.String()),
+		zap.Time("expires_at", hold.ExpiresAt))
+
+	return hold, nil
+}
+
+func (s *TransferService) CaptureTransfer(ctx context.Context, req *models.CaptureRequest) (*models.TransferResult, error) {
+	if req.HoldID <= 0 {
+		return nil, constants.ErrInvalidHoldID
+	}
+	result, err := s.transferRepo.Capture(ctx, req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	s.refreshAccounts(ctx, result.SourceID, result.DestinationID)
+
+	return result, nil
+}
+
+func (s *TransferService) VoidTransfer(ctx context.Context, id int64) (*models.Hold, error) {
+	if id <= 0 {
+		return nil, constants.ErrInvalidHoldID
+	}
+	hold, err := s.transferRepo.Void(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.refreshAccounts(ctx, hold.SourceID)
+
+	return hold, nil
+}
+
+// ExpireHolds releases the funds of every hold that has passed its expiry.
+func (s *TransferService) ExpireHolds(ctx context.Context) (int, error) {
+	holds, err := s.transferRepo.ExpireHolds(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	if len(holds) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]int64, 0, len(holds))
+	for _, hold := range holds {
+		ids = append(ids, hold.SourceID)
+	}
+	s.refreshAccounts(ctx, ids...)
+
+	s.log.Info("Expired holds released", zap.Int("count", len(holds)))
+	return len(holds), nil
+}
+
+// RunHoldExpiry calls ExpireHolds every interval until ctx is cancelled.
+func (s *TransferService) RunHoldExpiry(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.ExpireHolds(ctx); err != nil {
+				s.log.Error("Hold expiry sweep failed", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+func TestTransferService_AuthorizeTransfer(t *testing.T) {
+	newReq := func() *models.AuthorizeRequest {
+		return &models.AuthorizeRequest{
+			TransferRequest: models.TransferRequest{SourceID: 1, DestinationID: 2, Amount: decimal.NewFromInt(80)},
+		}
+	}
+
+	t.Run("Success: Default Expiry Applied", func(t *testing.T) {
+		mockRepo, mockCache, svc := newTestSetup(t)
+		mockCache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		mockCache.On("GetAccount", mock.Anything, int64(2)).Return(activeAccount(2), nil)
+
+		before := time.Now()
+		mockRepo.On("Authorize", mock.Anything, mock.MatchedBy(func(r *models.AuthorizeRequest) bool {
+			return !r.ExpiresAt.Before(before.Add(models.DefaultHoldTTL))
+		})).Return(&models.Hold{ID: 7, SourceID: 1, Status: constants.HoldActive}, nil)
+		mockRepo.On("GetAccounts", mock.Anything, []int64{1}).Return(nil, nil)
+
+		hold, err := svc.AuthorizeTransfer(context.Background(), newReq())
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), hold.ID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Expiry In The Past", func(t *testing.T) {
+		mockRepo, _, svc := newTestSetup(t)
+
+		req := newReq()
+		req.ExpiresAt = time.Now().Add(-time.Minute)
+
+		_, err := svc.AuthorizeTransfer(context.Background(), req)
+		assert.ErrorIs(t, err, constants.ErrInvalidHoldExpiry)
+		mockRepo.AssertNotCalled(t, "Authorize", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure: Conversion Requested", func(t *testing.T) {
+		_, _, svc := newTestSetup(t)
+
+		req := newReq()
+		req.Convert = true
+
+		_, err := svc.AuthorizeTransfer(context.Background(), req)
+		assert.ErrorIs(t, err, constants.ErrHoldCurrencyMismatch)
+	})
+
+	t.Run("Failure: Frozen Source", func(t *testing.T) {
+		mockRepo, mockCache, svc := newTestSetup(t)
+		frozen := activeAccount(1)
+		frozen.Status = constants.AccountFrozen
+		mockCache.On("GetAccount", mock.Anything, int64(1)).Return(frozen, nil)
+		mockCache.On("GetAccount", mock.Anything, int64(2)).Return(activeAccount(2), nil)
+
+		_, err := svc.AuthorizeTransfer(context.Background(), newReq())
+		assert.ErrorIs(t, err, constants.ErrAccountFrozen)
+		mockRepo.AssertNotCalled(t, "Authorize", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTransferService_CaptureTransfer(t *testing.T) {
+	t.Run("Success: Delegates To Repository", func(t *testing.T) {
+		mockRepo, _, svc := newTestSetup(t)
+		req := &models.CaptureRequest{HoldID: 7, Amount: decimal.NewFromInt(50)}
+		mockRepo.On("Capture", mock.Anything, req, mock.AnythingOfType("time.Time")).
+			Return(&models.TransferResult{AuditID: 7, SourceID: 1, DestinationID: 2}, nil)
+		mockRepo.On("GetAccounts", mock.Anything, []int64{1, 2}).Return(nil, nil)
+
+		result, err := svc.CaptureTransfer(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), result.AuditID)
+	})
+
+	t.Run("Failure: Invalid Hold ID", func(t *testing.T) {
+		_, _, svc := newTestSetup(t)
+
+		_, err := svc.CaptureTransfer(context.Background(), &models.CaptureRequest{})
+		assert.ErrorIs(t, err, constants.ErrInvalidHoldID)
+	})
+}
+
+func TestTransferService_VoidTransfer(t *testing.T) {
+	t.Run("Success: Delegates To Repository", func(t *testing.T) {
+		mockRepo, _, svc := newTestSetup(t)
+		mockRepo.On("Void", mock.Anything, int64(7)).Return(&models.Hold{ID: 7, SourceID: 1, Status: constants.HoldVoided}, nil)
+		mockRepo.On("GetAccounts", mock.Anything, []int64{1}).Return(nil, nil)
+
+		hold, err := svc.VoidTransfer(context.Background(), 7)
+		require.NoError(t, err)
+		assert.Equal(t, constants.HoldVoided, hold.Status)
+	})
+
+	t.Run("Failure: Invalid Hold ID", func(t *testing.T) {
+		_, _, svc := newTestSetup(t)
+
+		_, err := svc.VoidTransfer(context.Background(), 0)
+		assert.ErrorIs(t, err, constants.ErrInvalidHoldID)
+	})
+}
+
+func TestTransferService_ExpireHolds(t *testing.T) {
+	t.Run("Success: Returns Released Count", func(t *testing.T) {
+		mockRepo, _, svc := newTestSetup(t)
+		mockRepo.On("ExpireHolds", mock.Anything, mock.AnythingOfType("time.Time")).
+			Return([]models.Hold{{ID: 7, SourceID: 2}, {ID: 8, SourceID: 1}, {ID: 9, SourceID: 2}}, nil)
+		mockRepo.On("GetAccounts", mock.Anything, []int64{1, 2}).Return(nil, nil)
+
+		n, err := svc.ExpireHolds(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+	})
+
+	t.Run("Failure: Repository Error", func(t *testing.T) {
+		mockRepo, _, svc := newTestSetup(t)
+		mockRepo.On("ExpireHolds", mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := svc.ExpireHolds(context.Background())
+		assert.Error(t, err)
+	})
+}
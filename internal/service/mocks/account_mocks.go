@@ -2,8 +2,12 @@ package mocks
 
 import (
 	"context"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
 	"github.com/jhaprabhatt/account-transfer-project/internal/models"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -34,6 +38,62 @@ func (m *MockAccountRepo) GetAccount(ctx context.Context, id int64) (*models.Acc
 	return args.Get(0).(*models.Account), args.Error(1)
 }
 
+func (m *MockAccountRepo) UpdateStatus(ctx context.Context, id int64, to constants.AccountStatus) (*models.Account, error) {
+	args := m.Called(ctx, id, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Account), args.Error(1)
+}
+
+func (m *MockAccountRepo) UpdateOverdraftLimit(ctx context.Context, id int64, limit decimal.Decimal) (*models.Account, error) {
+	args := m.Called(ctx, id, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Account), args.Error(1)
+}
+
+func (m *MockAccountRepo) GetBalanceAt(ctx context.Context, id int64, at time.Time) (*models.BalanceAt, error) {
+	args := m.Called(ctx, id, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.BalanceAt), args.Error(1)
+}
+
+func (m *MockAccountRepo) GetStatement(ctx context.Context, q models.StatementQuery) (*models.Statement, error) {
+	args := m.Called(ctx, q)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Statement), args.Error(1)
+}
+
+func (m *MockAccountRepo) SetLimits(ctx context.Context, id int64, limits models.TransferLimits) (*models.AccountLimits, error) {
+	args := m.Called(ctx, id, limits)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.AccountLimits), args.Error(1)
+}
+
+func (m *MockAccountRepo) GetLimits(ctx context.Context, id int64) (*models.AccountLimits, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.AccountLimits), args.Error(1)
+}
+
+func (m *MockAccountRepo) ClearLimits(ctx context.Context, id int64) (*models.AccountLimits, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.AccountLimits), args.Error(1)
+}
+
 type MockCache struct {
 	mock.Mock
 }
@@ -43,7 +103,20 @@ func (m *MockCache) Exists(ctx context.Context, id int64) (bool, error) {
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockCache) GetAccount(ctx context.Context, id int64) (*models.Account, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Account), args.Error(1)
+}
+
 func (m *MockCache) SetAccount(ctx context.Context, acc *models.Account) error {
 	args := m.Called(ctx, acc)
 	return args.Error(0)
 }
+
+func (m *MockCache) SetMissing(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
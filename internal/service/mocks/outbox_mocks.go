@@ -0,0 +1,44 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockOutboxRepo struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepo) Pending(ctx context.Context, limit int) ([]models.Event, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Event), args.Error(1)
+}
+
+func (m *MockOutboxRepo) MarkPublished(ctx context.Context, ids []int64) error {
+	args := m.Called(ctx, ids)
+	return args.Error(0)
+}
+
+// WithLeaderLock runs fn when the mocked lock is acquired.
+func (m *MockOutboxRepo) WithLeaderLock(ctx context.Context, fn func(ctx context.Context) error) (bool, error) {
+	args := m.Called(ctx)
+	if !args.Bool(0) {
+		return false, args.Error(1)
+	}
+	return true, fn(ctx)
+}
+
+type MockEventPublisher struct {
+	mock.Mock
+}
+
+func (m *MockEventPublisher) Publish(ctx context.Context, event *models.Event) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
@@ -0,0 +1,48 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	"github.com/jhaprabhatt/account-transfer-project/internal/repository"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockReconcileRepo struct {
+	mock.Mock
+	Snap *MockReconcileSnapshot
+}
+
+func (m *MockReconcileRepo) Snapshot(ctx context.Context, fn func(repository.ReconcileSnapshot) error) error {
+	if err := m.Called(ctx).Error(0); err != nil {
+		return err
+	}
+	return fn(m.Snap)
+}
+
+// MockReconcileSnapshot replays Transfers through ForEachPostedTransfer.
+type MockReconcileSnapshot struct {
+	mock.Mock
+	Transfers []models.Transfer
+}
+
+func (m *MockReconcileSnapshot) ListAccountBalances(ctx context.Context) ([]models.AccountBalance, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.AccountBalance), args.Error(1)
+}
+
+func (m *MockReconcileSnapshot) ForEachPostedTransfer(ctx context.Context, fn func(*models.Transfer) error) error {
+	if err := m.Called(ctx).Error(0); err != nil {
+		return err
+	}
+	for i := range m.Transfers {
+		if err := fn(&m.Transfers[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
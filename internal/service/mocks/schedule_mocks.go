@@ -0,0 +1,62 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockScheduleRepo struct {
+	mock.Mock
+}
+
+func (m *MockScheduleRepo) Create(ctx context.Context, s *models.ScheduledTransfer) error {
+	args := m.Called(ctx, s)
+	return args.Error(0)
+}
+
+func (m *MockScheduleRepo) Cancel(ctx context.Context, id int64) (*models.ScheduledTransfer, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ScheduledTransfer), args.Error(1)
+}
+
+func (m *MockScheduleRepo) ListByAccount(ctx context.Context, accountID int64) ([]models.ScheduledTransfer, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ScheduledTransfer), args.Error(1)
+}
+
+func (m *MockScheduleRepo) Due(ctx context.Context, now time.Time, limit int) ([]models.ScheduledTransfer, error) {
+	args := m.Called(ctx, now, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ScheduledTransfer), args.Error(1)
+}
+
+func (m *MockScheduleRepo) RecordRun(ctx context.Context, run *models.ScheduledRun, next time.Time) error {
+	args := m.Called(ctx, run, next)
+	return args.Error(0)
+}
+
+func (m *MockScheduleRepo) RetryLater(ctx context.Context, id int64, scheduledFor time.Time, attempts int) error {
+	args := m.Called(ctx, id, scheduledFor, attempts)
+	return args.Error(0)
+}
+
+// WithLeaderLock runs fn when the mocked lock is acquired.
+func (m *MockScheduleRepo) WithLeaderLock(ctx context.Context, fn func(ctx context.Context) error) (bool, error) {
+	args := m.Called(ctx)
+	if !args.Bool(0) {
+		return false, args.Error(1)
+	}
+	return true, fn(ctx)
+}
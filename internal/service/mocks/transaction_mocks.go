@@ -3,6 +3,7 @@ package mocks
 import (
 	"context"
 	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -20,3 +21,107 @@ func (m *MockTransactionRepo) Transfer(ctx context.Context, req *models.Transfer
 
 	return res, args.Error(1)
 }
+
+func (m *MockTransactionRepo) GetTransfer(ctx context.Context, id int64) (*models.Transfer, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Transfer), args.Error(1)
+}
+
+func (m *MockTransactionRepo) ListTransfers(ctx context.Context, q models.TransferQuery) (*models.TransferPage, error) {
+	args := m.Called(ctx, q)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.TransferPage), args.Error(1)
+}
+
+func (m *MockTransactionRepo) Authorize(ctx context.Context, req *models.AuthorizeRequest) (*models.Hold, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Hold), args.Error(1)
+}
+
+func (m *MockTransactionRepo) Capture(ctx context.Context, req *models.CaptureRequest, now time.Time) (*models.TransferResult, error) {
+	args := m.Called(ctx, req, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.TransferResult), args.Error(1)
+}
+
+func (m *MockTransactionRepo) Void(ctx context.Context, id int64) (*models.Hold, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Hold), args.Error(1)
+}
+
+func (m *MockTransactionRepo) ExpireHolds(ctx context.Context, now time.Time) ([]models.Hold, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Hold), args.Error(1)
+}
+
+type MockFxRateRepo struct {
+	mock.Mock
+}
+
+func (m *MockFxRateRepo) LatestRate(ctx context.Context, base, quote string) (*models.FxRate, error) {
+	args := m.Called(ctx, base, quote)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.FxRate), args.Error(1)
+}
+
+func (m *MockFxRateRepo) SaveRates(ctx context.Context, rates []models.FxRate) error {
+	return m.Called(ctx, rates).Error(0)
+}
+
+func (m *MockTransactionRepo) Reverse(ctx context.Context, req *models.ReversalRequest) (*models.Reversal, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Reversal), args.Error(1)
+}
+
+func (m *MockTransactionRepo) TransferBatch(ctx context.Context, batch *models.BatchTransferRequest) error {
+	args := m.Called(ctx, batch)
+	return args.Error(0)
+}
+
+func (m *MockTransactionRepo) TransferMultiLeg(ctx context.Context, req *models.MultiLegTransferRequest) (*models.MultiLegResult, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.MultiLegResult), args.Error(1)
+}
+
+func (m *MockTransactionRepo) WatchCursor(ctx context.Context, accountID, sinceTransferID int64) (models.TransferCursor, error) {
+	args := m.Called(ctx, accountID, sinceTransferID)
+	return args.Get(0).(models.TransferCursor), args.Error(1)
+}
+
+func (m *MockTransactionRepo) ListPostedAfter(ctx context.Context, cursor models.TransferCursor, limit int) ([]models.Transfer, models.TransferCursor, error) {
+	args := m.Called(ctx, cursor, limit)
+	transfers, _ := args.Get(0).([]models.Transfer)
+	return transfers, args.Get(1).(models.TransferCursor), args.Error(2)
+}
+
+func (m *MockTransactionRepo) GetAccounts(ctx context.Context, ids []int64) ([]models.Account, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Account), args.Error(1)
+}
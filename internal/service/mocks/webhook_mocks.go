@@ -0,0 +1,88 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockWebhookRepo struct {
+	mock.Mock
+}
+
+func (m *MockWebhookRepo) Create(ctx context.Context, w *models.WebhookSubscription) error {
+	args := m.Called(ctx, w)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepo) Get(ctx context.Context, id int64) (*models.WebhookSubscription, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockWebhookRepo) List(ctx context.Context) ([]models.WebhookSubscription, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockWebhookRepo) Delete(ctx context.Context, id int64) (*models.WebhookSubscription, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockWebhookRepo) Due(ctx context.Context, now time.Time, limit int) ([]models.WebhookDelivery, error) {
+	args := m.Called(ctx, now, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookRepo) MarkDelivered(ctx context.Context, id int64, attempts int) error {
+	args := m.Called(ctx, id, attempts)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepo) RetryLater(ctx context.Context, id int64, attempts int, next time.Time, lastErr string) error {
+	args := m.Called(ctx, id, attempts, next, lastErr)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepo) DeadLetter(ctx context.Context, id int64, attempts int, lastErr string) error {
+	args := m.Called(ctx, id, attempts, lastErr)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepo) ListDeadLetters(ctx context.Context, subscriptionID int64) ([]models.WebhookDeadLetter, error) {
+	args := m.Called(ctx, subscriptionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.WebhookDeadLetter), args.Error(1)
+}
+
+func (m *MockWebhookRepo) Redeliver(ctx context.Context, deadLetterID int64) (int64, error) {
+	args := m.Called(ctx, deadLetterID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// WithLeaderLock runs fn when the mocked lock is acquired.
+func (m *MockWebhookRepo) WithLeaderLock(ctx context.Context, fn func(ctx context.Context) error) (bool, error) {
+	args := m.Called(ctx)
+	if !args.Bool(0) {
+		return false, args.Error(1)
+	}
+	return true, fn(ctx)
+}
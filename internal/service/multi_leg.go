@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"go.uber.org/zap"
+)
+
+// MakeMultiLegTransfer debits and credits several accounts as one business
+// transaction. The cached pre-checks mirror MakeTransfer; conversion is not
+// supported, so every account must hold the same currency. Any fee is meant
+// to be a credit leg of its own, so debits from a class that pays fees are
+// turned away.
+func (s *TransferService) MakeMultiLegTransfer(ctx context.Context, req *models.MultiLegTransferRequest) (*models.MultiLegResult, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	currency := req.Currency
+	for i, id := range req.AccountIDs() {
+		acc, err := s.accounts.get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if i < len(req.Debits) {
+			if err = acc.CheckDebit(); err == nil {
+				err = s.rejectFee(acc, req.Debits[i].Amount)
+			}
+		} else {
+			err = acc.CheckCredit()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if currency == "" {
+			currency = acc.Currency
+		}
+		if acc.Currency != currency {
+			return nil, constants.ErrCurrencyMismatch
+		}
+	}
+
+	result, err := s.transferRepo.TransferMultiLeg(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	s.refreshAccounts(ctx, req.AccountIDs()...)
+
+	s.log.Info("Multi-leg transfer posted",
+		zap.Int64("group_id", result.GroupID),
+		zap.Int("debits", len(req.Debits)),
+		zap.Int("credits", len(req.Credits)),
+		zap.Int("transfers", len(result.Transfers)))
+
+	return result, nil
+}
@@ -0,0 +1,75 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+func TestTransferService_MakeMultiLegTransfer(t *testing.T) {
+	newReq := func() *models.MultiLegTransferRequest {
+		return &models.MultiLegTransferRequest{
+			Debits: []models.Leg{{AccountID: 1, Amount: decimal.NewFromInt(100)}},
+			Credits: []models.Leg{
+				{AccountID: 2, Amount: decimal.NewFromInt(95)},
+				{AccountID: 3, Amount: decimal.NewFromInt(5)},
+			},
+		}
+	}
+
+	t.Run("Success: Delegates To Repository", func(t *testing.T) {
+		mockRepo, mockCache, svc := newTestSetup(t)
+		for _, id := range []int64{1, 2, 3} {
+			mockCache.On("GetAccount", mock.Anything, id).Return(activeAccount(id), nil)
+		}
+		mockRepo.On("TransferMultiLeg", mock.Anything, mock.Anything).
+			Return(&models.MultiLegResult{GroupID: 5, Transfers: make([]models.TransferResult, 2)}, nil)
+		mockRepo.On("GetAccounts", mock.Anything, []int64{1, 2, 3}).Return(nil, nil)
+
+		result, err := svc.MakeMultiLegTransfer(context.Background(), newReq())
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), result.GroupID)
+	})
+
+	t.Run("Failure: Unbalanced Legs", func(t *testing.T) {
+		mockRepo, _, svc := newTestSetup(t)
+
+		req := newReq()
+		req.Credits[1].Amount = decimal.NewFromInt(6)
+
+		_, err := svc.MakeMultiLegTransfer(context.Background(), req)
+		assert.ErrorIs(t, err, constants.ErrUnbalancedLegs)
+		mockRepo.AssertNotCalled(t, "TransferMultiLeg", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure: Closed Credit Account", func(t *testing.T) {
+		mockRepo, mockCache, svc := newTestSetup(t)
+		closed := activeAccount(3)
+		closed.Status = constants.AccountClosed
+		mockCache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		mockCache.On("GetAccount", mock.Anything, int64(2)).Return(activeAccount(2), nil)
+		mockCache.On("GetAccount", mock.Anything, int64(3)).Return(closed, nil)
+
+		_, err := svc.MakeMultiLegTransfer(context.Background(), newReq())
+		assert.ErrorIs(t, err, constants.ErrAccountClosed)
+		mockRepo.AssertNotCalled(t, "TransferMultiLeg", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure: Mixed Currencies", func(t *testing.T) {
+		_, mockCache, svc := newTestSetup(t)
+		eur := activeAccount(2)
+		eur.Currency = "EUR"
+		mockCache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		mockCache.On("GetAccount", mock.Anything, int64(2)).Return(eur, nil)
+
+		_, err := svc.MakeMultiLegTransfer(context.Background(), newReq())
+		assert.ErrorIs(t, err, constants.ErrCurrencyMismatch)
+	})
+}
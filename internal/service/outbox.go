@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	"github.com/jhaprabhatt/account-transfer-project/internal/repository"
+
+	"go.uber.org/zap"
+)
+
+// EventPublisher delivers outbox events downstream. Publish returns only once
+// the event is accepted; an error leaves it in the outbox for the next tick.
+type EventPublisher interface {
+	Publish(ctx context.Context, event *models.Event) error
+}
+
+// OutboxRelay publishes the events written to the outbox alongside the
+// changes they describe. Delivery is at least once: an event published just
+// before a crash is published again, so consumers dedupe on event_id.
+type OutboxRelay struct {
+	outbox    repository.OutboxRepo
+	publisher EventPublisher
+	batchSize int
+	log       *zap.Logger
+}
+
+func NewOutboxRelay(outbox repository.OutboxRepo, publisher EventPublisher, batchSize int, log *zap.Logger) *OutboxRelay {
+	return &OutboxRelay{
+		outbox:    outbox,
+		publisher: publisher,
+		batchSize: batchSize,
+		log:       log,
+	}
+}
+
+// RelayPending publishes one batch of pending events in event_id order, if
+// this instance holds the relay lock, and returns how many it published. It
+// stops at the first event the publisher rejects so no later event of the
+// same account overtakes it.
+func (s *OutboxRelay) RelayPending(ctx context.Context) (int, error) {
+	var published []int64
+	_, err := s.outbox.WithLeaderLock(ctx, func(ctx context.Context) error {
+		events, err := s.outbox.Pending(ctx, s.batchSize)
+		if err != nil {
+			return err
+		}
+
+		var publishErr error
+		for i := range events {
+			if err := s.publisher.Publish(ctx, &events[i]); err != nil {
+				publishErr = fmt.Errorf("publish event %d: %w", events[i].ID, err)
+				break
+			}
+			published = append(published, events[i].ID)
+		}
+
+		if len(published) > 0 {
+			if err := s.outbox.MarkPublished(ctx, published); err != nil {
+				return err
+			}
+		}
+		return publishErr
+	})
+	return len(published), err
+}
+
+// RunRelay calls RelayPending every interval until ctx is cancelled, draining
+// full batches back to back. Every Core instance runs the loop; the advisory
+// lock lets one relay at a time.
+func (s *OutboxRelay) RunRelay(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for {
+				n, err := s.RelayPending(ctx)
+				if err != nil {
+					s.log.Error("Outbox relay tick failed", zap.Int("published", n), zap.Error(err))
+					break
+				}
+				if n < s.batchSize {
+					break
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	"github.com/jhaprabhatt/account-transfer-project/internal/service"
+	"github.com/jhaprabhatt/account-transfer-project/internal/service/mocks"
+)
+
+func TestOutboxRelay_RelayPending(t *testing.T) {
+	events := []models.Event{
+		{ID: 1, Type: models.EventAccountCreated, Version: 1, AccountID: 101},
+		{ID: 2, Type: models.EventTransferCompleted, Version: 1, AccountID: 101},
+		{ID: 3, Type: models.EventTransferFailed, Version: 1, AccountID: 102},
+	}
+	eventID := func(id int64) any {
+		return mock.MatchedBy(func(e *models.Event) bool { return e.ID == id })
+	}
+
+	t.Run("Success: Batch Published In Order", func(t *testing.T) {
+		outbox, publisher := new(mocks.MockOutboxRepo), new(mocks.MockEventPublisher)
+		relay := service.NewOutboxRelay(outbox, publisher, 10, zap.NewNop())
+
+		outbox.On("WithLeaderLock", mock.Anything).Return(true, nil)
+		outbox.On("Pending", mock.Anything, 10).Return(events, nil)
+		var order []int64
+		publisher.On("Publish", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			order = append(order, args.Get(1).(*models.Event).ID)
+		})
+		outbox.On("MarkPublished", mock.Anything, []int64{1, 2, 3}).Return(nil)
+
+		n, err := relay.RelayPending(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+		assert.Equal(t, []int64{1, 2, 3}, order)
+		outbox.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Stops At Rejected Event", func(t *testing.T) {
+		outbox, publisher := new(mocks.MockOutboxRepo), new(mocks.MockEventPublisher)
+		relay := service.NewOutboxRelay(outbox, publisher, 10, zap.NewNop())
+
+		outbox.On("WithLeaderLock", mock.Anything).Return(true, nil)
+		outbox.On("Pending", mock.Anything, 10).Return(events, nil)
+		publisher.On("Publish", mock.Anything, eventID(1)).Return(nil)
+		publisher.On("Publish", mock.Anything, eventID(2)).Return(errors.New("broker unavailable"))
+		outbox.On("MarkPublished", mock.Anything, []int64{1}).Return(nil)
+
+		n, err := relay.RelayPending(context.Background())
+
+		assert.ErrorContains(t, err, "broker unavailable")
+		assert.Equal(t, 1, n)
+		publisher.AssertNotCalled(t, "Publish", mock.Anything, eventID(3))
+		outbox.AssertExpectations(t)
+	})
+
+	t.Run("Success: Another Instance Relays", func(t *testing.T) {
+		outbox, publisher := new(mocks.MockOutboxRepo), new(mocks.MockEventPublisher)
+		relay := service.NewOutboxRelay(outbox, publisher, 10, zap.NewNop())
+
+		outbox.On("WithLeaderLock", mock.Anything).Return(false, nil)
+
+		n, err := relay.RelayPending(context.Background())
+
+		require.NoError(t, err)
+		assert.Zero(t, n)
+		outbox.AssertNotCalled(t, "Pending", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success: Nothing Pending", func(t *testing.T) {
+		outbox, publisher := new(mocks.MockOutboxRepo), new(mocks.MockEventPublisher)
+		relay := service.NewOutboxRelay(outbox, publisher, 10, zap.NewNop())
+
+		outbox.On("WithLeaderLock", mock.Anything).Return(true, nil)
+		outbox.On("Pending", mock.Anything, 10).Return([]models.Event{}, nil)
+
+		n, err := relay.RelayPending(context.Background())
+
+		require.NoError(t, err)
+		assert.Zero(t, n)
+		outbox.AssertNotCalled(t, "MarkPublished", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	"github.com/jhaprabhatt/account-transfer-project/internal/repository"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// Reconciler replays the transfers history on top of each account's opening
+// balance and compares the result with accounts.balance.
+type Reconciler struct {
+	repo repository.ReconcileRepo
+	log  *zap.Logger
+}
+
+func NewReconciler(repo repository.ReconcileRepo, log *zap.Logger) *Reconciler {
+	return &Reconciler{repo: repo, log: log}
+}
+
+// accountTrail tracks one account while replaying history: derived is the
+// balance recomputed purely from transfer amounts, lastPost is the post
+// balance recorded on the account's previous transfer.
+type accountTrail struct {
+	derived  decimal.Decimal
+	lastPost decimal.Decimal
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context) (*models.ReconciliationReport, error) {
+	report := &models.ReconciliationReport{StartedAt: time.Now().UTC()}
+
+	err := r.repo.Snapshot(ctx, func(snap repository.ReconcileSnapshot) error {
+		accounts, err := snap.ListAccountBalances(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load accounts for reconciliation: %w", err)
+		}
+
+		trails := make(map[int64]*accountTrail, len(accounts))
+		for _, acc := range accounts {
+			trails[acc.AccountID] = &accountTrail{derived: acc.OpeningBalance, lastPost: acc.OpeningBalance}
+		}
+
+		err = snap.ForEachPostedTransfer(ctx, func(t *models.Transfer) error {
+			report.TransfersChecked++
+			checkLeg(report, trails, t, t.SourceID, t.SourcePrevBalance, t.SourcePostBalance, t.Amount.Neg())
+			checkLeg(report, trails, t, t.DestinationID, t.DestinationPrevBalance, t.DestinationPostBalance, t.DestinationAmount)
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to replay transfers for reconciliation: %w", err)
+		}
+
+		for _, acc := range accounts {
+			report.AccountsChecked++
+			if expected := trails[acc.AccountID].derived; !expected.Equal(acc.Balance) {
+				report.Mismatches = append(report.Mismatches, models.BalanceMismatch{
+					AccountID: acc.AccountID,
+					Expected:  expected,
+					Actual:    acc.Balance,
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report.FinishedAt = time.Now().UTC()
+
+	r.log.Info("Reconciliation complete",
+		zap.Int("accounts_checked", report.AccountsChecked),
+		zap.Int("transfers_checked", report.TransfersChecked),
+		zap.Int("mismatches", len(report.Mismatches)),
+		zap.Int("broken_chains", len(report.BrokenChains)),
+	)
+
+	return report, nil
+}
+
+// checkLeg verifies one side of a transfer: its prev balance must continue the
+// account's recorded chain and its post balance must equal prev plus delta.
+func checkLeg(
+	report *models.ReconciliationReport,
+	trails map[int64]*accountTrail,
+	t *models.Transfer,
+	accountID int64,
+	prev, post, delta decimal.Decimal,
+) {
+	trail := trails[accountID]
+
+	if !trail.lastPost.Equal(prev) {
+		report.BrokenChains = append(report.BrokenChains, models.ChainBreak{
+			AccountID:  accountID,
+			TransferID: t.ID,
+			Reason:     models.ChainBreakPrevBalance,
+			Expected:   trail.lastPost,
+			Actual:     prev,
+		})
+	}
+
+	if expected := prev.Add(delta); !expected.Equal(post) {
+		report.BrokenChains = append(report.BrokenChains, models.ChainBreak{
+			AccountID:  accountID,
+			TransferID: t.ID,
+			Reason:     models.ChainBreakPostBalance,
+			Expected:   expected,
+			Actual:     post,
+		})
+	}
+
+	trail.derived = trail.derived.Add(delta)
+	trail.lastPost = post
+}
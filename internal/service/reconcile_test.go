@@ -0,0 +1,153 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	"github.com/jhaprabhatt/account-transfer-project/internal/service"
+	"github.com/jhaprabhatt/account-transfer-project/internal/service/mocks"
+)
+
+func d(v float64) decimal.Decimal {
+	return decimal.NewFromFloat(v)
+}
+
+func transferRow(id, src, dest int64, amount, srcPrev, srcPost, destPrev, destPost float64) models.Transfer {
+	return models.Transfer{
+		ID: id, SourceID: src, DestinationID: dest, Amount: d(amount), DestinationAmount: d(amount),
+		SourcePrevBalance: d(srcPrev), SourcePostBalance: d(srcPost),
+		DestinationPrevBalance: d(destPrev), DestinationPostBalance: d(destPost),
+	}
+}
+
+func newReconciler(accounts []models.AccountBalance, transfers []models.Transfer) *service.Reconciler {
+	snap := &mocks.MockReconcileSnapshot{Transfers: transfers}
+	snap.On("ListAccountBalances", mock.Anything).Return(accounts, nil)
+	snap.On("ForEachPostedTransfer", mock.Anything).Return(nil)
+
+	repo := &mocks.MockReconcileRepo{Snap: snap}
+	repo.On("Snapshot", mock.Anything).Return(nil)
+
+	return service.NewReconciler(repo, zap.NewNop())
+}
+
+func TestReconciler_Reconcile(t *testing.T) {
+	t.Run("Success: Consistent History", func(t *testing.T) {
+		r := newReconciler(
+			[]models.AccountBalance{
+				{AccountID: 1, OpeningBalance: d(100), Balance: d(70)},
+				{AccountID: 2, OpeningBalance: d(0), Balance: d(30)},
+			},
+			[]models.Transfer{
+				transferRow(1, 1, 2, 50, 100, 50, 0, 50),
+				transferRow(2, 2, 1, 20, 50, 30, 50, 70),
+			},
+		)
+
+		report, err := r.Reconcile(context.Background())
+
+		require.NoError(t, err)
+		assert.True(t, report.Consistent())
+		assert.Equal(t, 2, report.AccountsChecked)
+		assert.Equal(t, 2, report.TransfersChecked)
+	})
+
+	t.Run("Success: Converted Transfer Credits Destination Amount", func(t *testing.T) {
+		fx := transferRow(1, 1, 2, 100, 100, 0, 0, 92)
+		fx.DestinationAmount = d(92)
+
+		r := newReconciler(
+			[]models.AccountBalance{
+				{AccountID: 1, OpeningBalance: d(100), Balance: d(0)},
+				{AccountID: 2, OpeningBalance: d(0), Balance: d(92)},
+			},
+			[]models.Transfer{fx},
+		)
+
+		report, err := r.Reconcile(context.Background())
+
+		require.NoError(t, err)
+		assert.True(t, report.Consistent())
+	})
+
+	t.Run("Failure: Balance Edited By Hand", func(t *testing.T) {
+		r := newReconciler(
+			[]models.AccountBalance{
+				{AccountID: 1, OpeningBalance: d(100), Balance: d(999)},
+				{AccountID: 2, OpeningBalance: d(0), Balance: d(50)},
+			},
+			[]models.Transfer{transferRow(1, 1, 2, 50, 100, 50, 0, 50)},
+		)
+
+		report, err := r.Reconcile(context.Background())
+
+		require.NoError(t, err)
+		assert.False(t, report.Consistent())
+		require.Len(t, report.Mismatches, 1)
+		assert.Equal(t, int64(1), report.Mismatches[0].AccountID)
+		assert.True(t, d(50).Equal(report.Mismatches[0].Expected))
+		assert.Empty(t, report.BrokenChains)
+	})
+
+	t.Run("Failure: Broken Chain Reported Once", func(t *testing.T) {
+		r := newReconciler(
+			[]models.AccountBalance{
+				{AccountID: 1, OpeningBalance: d(100), Balance: d(30)},
+				{AccountID: 2, OpeningBalance: d(0), Balance: d(80)},
+			},
+			[]models.Transfer{
+				transferRow(1, 1, 2, 50, 100, 50, 0, 50),
+				// source_prev should be 50: the row was written from a stale balance.
+				transferRow(2, 1, 2, 20, 60, 40, 50, 70),
+				transferRow(3, 1, 2, 10, 40, 30, 70, 80),
+			},
+		)
+
+		report, err := r.Reconcile(context.Background())
+
+		require.NoError(t, err)
+		require.Len(t, report.BrokenChains, 1)
+		assert.Equal(t, models.ChainBreakPrevBalance, report.BrokenChains[0].Reason)
+		assert.Equal(t, int64(2), report.BrokenChains[0].TransferID)
+		assert.Equal(t, int64(1), report.BrokenChains[0].AccountID)
+
+		require.Len(t, report.Mismatches, 1)
+		assert.Equal(t, int64(1), report.Mismatches[0].AccountID)
+		assert.True(t, d(20).Equal(report.Mismatches[0].Expected))
+		assert.True(t, d(30).Equal(report.Mismatches[0].Actual))
+	})
+
+	t.Run("Failure: Post Balance Arithmetic", func(t *testing.T) {
+		r := newReconciler(
+			[]models.AccountBalance{
+				{AccountID: 1, OpeningBalance: d(100), Balance: d(40)},
+				{AccountID: 2, OpeningBalance: d(0), Balance: d(50)},
+			},
+			[]models.Transfer{transferRow(1, 1, 2, 50, 100, 40, 0, 50)},
+		)
+
+		report, err := r.Reconcile(context.Background())
+
+		require.NoError(t, err)
+		require.Len(t, report.BrokenChains, 1)
+		assert.Equal(t, models.ChainBreakPostBalance, report.BrokenChains[0].Reason)
+		assert.True(t, d(50).Equal(report.BrokenChains[0].Expected))
+	})
+
+	t.Run("Failure: Snapshot Error", func(t *testing.T) {
+		repo := &mocks.MockReconcileRepo{}
+		repo.On("Snapshot", mock.Anything).Return(errors.New("internal system error"))
+
+		_, err := service.NewReconciler(repo, zap.NewNop()).Reconcile(context.Background())
+
+		assert.ErrorContains(t, err, "internal system error")
+	})
+}
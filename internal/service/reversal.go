@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"go.uber.org/zap"
+)
+
+// ReverseTransfer refunds some or all of a posted transfer with a compensating
+// transfer. A partial reversal of a converted transfer rounds the debited
+// destination amount with the configured FX rounding mode.
+func (s *TransferService) ReverseTransfer(ctx context.Context, req *models.ReversalRequest) (*models.Reversal, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	req.Rounding = s.rounding
+
+	reversal, err := s.transferRepo.Reverse(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	s.refreshAccounts(ctx, reversal.Reversal.SourceID, reversal.Reversal.DestinationID)
+
+	s.log.Info("Transfer reversed",
+		zap.Int64("transfer_id", reversal.Original.ID),
+		zap.Int64("reversal_id", reversal.Reversal.ID),
+		zap.String("refund", reversal.Reversal.DestinationAmount.String()),
+		zap.String("status", reversal.Original.Status.String()),
+		zap.String("reason", req.Reason))
+
+	return reversal, nil
+}
@@ -0,0 +1,62 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+func TestTransferService_ReverseTransfer(t *testing.T) {
+	t.Run("Success: Rounding Mode Passed To Repository", func(t *testing.T) {
+		mockRepo, _, _, svc := newFxTestSetup(t, models.RoundDown)
+		mockRepo.On("Reverse", mock.Anything, mock.MatchedBy(func(r *models.ReversalRequest) bool {
+			return r.TransferID == 7 && r.Rounding == models.RoundDown
+		})).Return(&models.Reversal{
+			Reversal: models.Transfer{ID: 8, ReversalOf: 7, SourceID: 2, DestinationID: 1, Status: constants.StatusCompleted},
+			Original: models.Transfer{ID: 7, SourceID: 1, DestinationID: 2, Status: constants.StatusReversed},
+		}, nil)
+		mockRepo.On("GetAccounts", mock.Anything, []int64{1, 2}).Return(nil, nil)
+
+		result, err := svc.ReverseTransfer(context.Background(), &models.ReversalRequest{TransferID: 7, Reason: "duplicate"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(8), result.Reversal.ID)
+		assert.Equal(t, constants.StatusReversed, result.Original.Status)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Repository Error Propagated", func(t *testing.T) {
+		mockRepo, _, svc := newTestSetup(t)
+		mockRepo.On("Reverse", mock.Anything, mock.Anything).Return(nil, constants.ErrInsufficientFunds)
+
+		_, err := svc.ReverseTransfer(context.Background(), &models.ReversalRequest{TransferID: 7, Reason: "duplicate"})
+		assert.ErrorIs(t, err, constants.ErrInsufficientFunds)
+	})
+
+	invalid := []struct {
+		name string
+		req  models.ReversalRequest
+		want error
+	}{
+		{"Invalid Transfer ID", models.ReversalRequest{Reason: "duplicate"}, constants.ErrInvalidTransferID},
+		{"Negative Amount", models.ReversalRequest{TransferID: 7, Amount: decimal.NewFromInt(-1), Reason: "duplicate"}, constants.ErrAmountMustBePositive},
+		{"Missing Reason", models.ReversalRequest{TransferID: 7}, constants.ErrInvalidReversalReason},
+		{"Reason Too Long", models.ReversalRequest{TransferID: 7, Reason: strings.Repeat("x", 256)}, constants.ErrInvalidReversalReason},
+	}
+	for _, tc := range invalid {
+		t.Run("Failure: "+tc.name, func(t *testing.T) {
+			mockRepo, _, svc := newTestSetup(t)
+
+			_, err := svc.ReverseTransfer(context.Background(), &tc.req)
+			assert.ErrorIs(t, err, tc.want)
+			mockRepo.AssertNotCalled(t, "Reverse", mock.Anything, mock.Anything)
+		})
+	}
+}
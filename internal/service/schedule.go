@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	"github.com/jhaprabhatt/account-transfer-project/internal/repository"
+
+	"go.uber.org/zap"
+)
+
+// dueBatchSize caps how many occurrences one scheduler tick runs.
+const dueBatchSize = 100
+
+// maxRunAttempts caps the ticks an occurrence is retried for when the
+// transfer fails without an answer, so a persistent fault cannot hold the
+// front of the due batch forever.
+const maxRunAttempts = 5
+
+// ScheduleService stores future and recurring transfers and executes them as
+// they fall due, through TransferService.MakeTransfer.
+type ScheduleService struct {
+	schedules repository.ScheduleRepo
+	transfers *TransferService
+	log       *zap.Logger
+}
+
+func NewScheduleService(schedules repository.ScheduleRepo, transfers *TransferService, log *zap.Logger) *ScheduleService {
+	return &ScheduleService{
+		schedules: schedules,
+		transfers: transfers,
+		log:       log,
+	}
+}
+
+// ScheduleTransfer runs the same cached pre-checks as MakeTransfer; each run
+// checks again when it executes.
+func (s *ScheduleService) ScheduleTransfer(ctx context.Context, req *models.ScheduleRequest) (*models.ScheduledTransfer, error) {
+	first, err := req.FirstRun(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := s.transfers.validateTransfer(ctx, &req.TransferRequest); err != nil {
+		return nil, err
+	}
+
+	sched := &models.ScheduledTransfer{
+		SourceID:      req.SourceID,
+		DestinationID: req.DestinationID,
+		Amount:        req.Amount,
+		Currency:      req.Currency,
+		Convert:       req.Convert,
+		Schedule:      req.Schedule,
+		Status:        constants.ScheduleActive,
+		NextRunAt:     first,
+	}
+	if err := s.schedules.Create(ctx, sched); err != nil {
+		return nil, err
+	}
+
+	s.log.Info("Transfer scheduled",
+		zap.Int64("schedule_id", sched.ID),
+		zap.Int64("from", sched.SourceID),
+		zap.Int64("to", sched.DestinationID),
+		zap.String("schedule", sched.Schedule),
+		zap.Time("next_run_at", sched.NextRunAt))
+
+	return sched, nil
+}
+
+func (s *ScheduleService) CancelScheduledTransfer(ctx context.Context, id int64) (*models.ScheduledTransfer, error) {
+	if id <= 0 {
+		return nil, constants.ErrInvalidScheduleID
+	}
+	return s.schedules.Cancel(ctx, id)
+}
+
+func (s *ScheduleService) ListScheduledTransfers(ctx context.Context, accountID int64) ([]models.ScheduledTransfer, error) {
+	if accountID <= 0 {
+		return nil, constants.ErrInvalidAccountID
+	}
+	return s.schedules.ListByAccount(ctx, accountID)
+}
+
+// RunDueTransfers executes the occurrences that have fallen due, if this
+// instance holds the scheduler lock, and returns how many it ran.
+func (s *ScheduleService) RunDueTransfers(ctx context.Context) (int, error) {
+	var ran int
+	_, err := s.schedules.WithLeaderLock(ctx, func(ctx context.Context) error {
+		due, err := s.schedules.Due(ctx, time.Now(), dueBatchSize)
+		if err != nil {
+			return err
+		}
+		for i := range due {
+			if err := s.runOccurrence(ctx, &due[i]); err != nil {
+				s.log.Error("Scheduled transfer run failed",
+					zap.Int64("schedule_id", due[i].ID),
+					zap.Time("scheduled_for", due[i].NextRunAt),
+					zap.Error(err))
+				continue
+			}
+			ran++
+		}
+		return nil
+	})
+	return ran, err
+}
+
+// runOccurrence makes the transfer due at sched.NextRunAt and records the
+// outcome. A transfer the ledger rejected, such as for insufficient funds, is
+// recorded as failed and the schedule moves on. Any other error leaves the
+// occurrence due so the next tick retries it under the same idempotency key,
+// until maxRunAttempts is reached and it is recorded as failed too.
+func (s *ScheduleService) runOccurrence(ctx context.Context, sched *models.ScheduledTransfer) error {
+	runAt := sched.NextRunAt
+	result, err := s.transfers.MakeTransfer(ctx, sched.TransferFor(runAt))
+	if err != nil && !constants.IsDomainError(err) {
+		attempts := sched.Attempts + 1
+		if attempts < maxRunAttempts {
+			if err := s.schedules.RetryLater(ctx, sched.ID, runAt, attempts); err != nil {
+				s.log.Warn("Failed to record scheduled attempt", zap.Int64("schedule_id", sched.ID), zap.Error(err))
+			}
+			return err
+		}
+		s.log.Warn("Scheduled transfer out of attempts",
+			zap.Int64("schedule_id", sched.ID),
+			zap.Time("scheduled_for", runAt),
+			zap.Int("attempts", attempts))
+	}
+
+	run := &models.ScheduledRun{ScheduleID: sched.ID, ScheduledFor: runAt, Status: constants.RunSucceeded}
+	if err != nil {
+		run.Status = constants.RunFailed
+		run.Error = err.Error()
+	} else {
+		run.TransferID = result.AuditID
+	}
+
+	next, err := sched.NextRunAfter(runAt, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := s.schedules.RecordRun(ctx, run, next); err != nil {
+		return err
+	}
+
+	s.log.Info("Scheduled transfer ran",
+		zap.Int64("schedule_id", sched.ID),
+		zap.Time("scheduled_for", runAt),
+		zap.String("status", run.Status.String()),
+		zap.Int64("transfer_id", run.TransferID),
+		zap.Time("next_run_at", next))
+	return nil
+}
+
+// RunScheduler calls RunDueTransfers every interval until ctx is cancelled.
+// Every Core instance runs the loop; the advisory lock lets one act per tick.
+func (s *ScheduleService) RunScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.RunDueTransfers(ctx); err != nil {
+				s.log.Error("Scheduler tick failed", zap.Error(err))
+			}
+		}
+	}
+}
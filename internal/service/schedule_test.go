@@ -0,0 +1,234 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	"github.com/jhaprabhatt/account-transfer-project/internal/service"
+	"github.com/jhaprabhatt/account-transfer-project/internal/service/mocks"
+)
+
+func newScheduleTestSetup(t *testing.T) (*mocks.MockScheduleRepo, *mocks.MockTransactionRepo, *mocks.MockCache, *service.ScheduleService) {
+	mockRepo, mockCache, txSvc := newTestSetup(t)
+	mockSchedules := new(mocks.MockScheduleRepo)
+	return mockSchedules, mockRepo, mockCache, service.NewScheduleService(mockSchedules, txSvc, zap.NewNop())
+}
+
+func TestScheduleService_ScheduleTransfer(t *testing.T) {
+	t.Run("Success: Recurring Transfer Stored", func(t *testing.T) {
+		mockSchedules, _, mockCache, svc := newScheduleTestSetup(t)
+		mockCache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		mockCache.On("GetAccount", mock.Anything, int64(2)).Return(activeAccount(2), nil)
+		mockSchedules.On("Create", mock.Anything, mock.MatchedBy(func(s *models.ScheduledTransfer) bool {
+			return s.Status == constants.ScheduleActive && s.NextRunAt.After(time.Now())
+		})).Return(nil).Run(func(args mock.Arguments) {
+			args.Get(1).(*models.ScheduledTransfer).ID = 7
+		})
+
+		sched, err := svc.ScheduleTransfer(context.Background(), &models.ScheduleRequest{
+			TransferRequest: models.TransferRequest{SourceID: 1, DestinationID: 2, Amount: decimal.NewFromInt(25)},
+			Schedule:        "@every 1h",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), sched.ID)
+		mockSchedules.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Invalid Rule", func(t *testing.T) {
+		mockSchedules, _, _, svc := newScheduleTestSetup(t)
+
+		_, err := svc.ScheduleTransfer(context.Background(), &models.ScheduleRequest{
+			TransferRequest: models.TransferRequest{SourceID: 1, DestinationID: 2, Amount: decimal.NewFromInt(25)},
+			Schedule:        "61 * * * *",
+		})
+		assert.ErrorIs(t, err, constants.ErrInvalidSchedule)
+		mockSchedules.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure: Frozen Source", func(t *testing.T) {
+		mockSchedules, _, mockCache, svc := newScheduleTestSetup(t)
+		frozen := activeAccount(1)
+		frozen.Status = constants.AccountFrozen
+		mockCache.On("GetAccount", mock.Anything, int64(1)).Return(frozen, nil)
+		mockCache.On("GetAccount", mock.Anything, int64(2)).Return(activeAccount(2), nil)
+
+		_, err := svc.ScheduleTransfer(context.Background(), &models.ScheduleRequest{
+			TransferRequest: models.TransferRequest{SourceID: 1, DestinationID: 2, Amount: decimal.NewFromInt(25)},
+			StartAt:         time.Now().Add(time.Hour),
+		})
+		assert.ErrorIs(t, err, constants.ErrAccountFrozen)
+		mockSchedules.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestScheduleService_CancelScheduledTransfer(t *testing.T) {
+	t.Run("Failure: Invalid ID", func(t *testing.T) {
+		mockSchedules, _, _, svc := newScheduleTestSetup(t)
+
+		_, err := svc.CancelScheduledTransfer(context.Background(), 0)
+		assert.ErrorIs(t, err, constants.ErrInvalidScheduleID)
+		mockSchedules.AssertNotCalled(t, "Cancel", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success: Delegates To Repository", func(t *testing.T) {
+		mockSchedules, _, _, svc := newScheduleTestSetup(t)
+		mockSchedules.On("Cancel", mock.Anything, int64(7)).
+			Return(&models.ScheduledTransfer{ID: 7, Status: constants.ScheduleCancelled}, nil)
+
+		sched, err := svc.CancelScheduledTransfer(context.Background(), 7)
+		require.NoError(t, err)
+		assert.Equal(t, constants.ScheduleCancelled, sched.Status)
+	})
+}
+
+func TestScheduleService_RunDueTransfers(t *testing.T) {
+	dueSchedule := func(schedule string) models.ScheduledTransfer {
+		return models.ScheduledTransfer{
+			ID: 7, SourceID: 1, DestinationID: 2, Amount: decimal.NewFromInt(25),
+			Schedule: schedule, Status: constants.ScheduleActive,
+			NextRunAt: time.Now().Add(-time.Minute).Truncate(time.Minute),
+		}
+	}
+	stubAccounts := func(mockCache *mocks.MockCache) {
+		mockCache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		mockCache.On("GetAccount", mock.Anything, int64(2)).Return(activeAccount(2), nil)
+	}
+
+	t.Run("Success: Occurrence Paid With Its Own Idempotency Key", func(t *testing.T) {
+		mockSchedules, mockRepo, mockCache, svc := newScheduleTestSetup(t)
+		stubAccounts(mockCache)
+		sched := dueSchedule("@every 1h")
+		key := fmt.Sprintf("schedule-7-%d", sched.NextRunAt.Unix())
+
+		mockSchedules.On("WithLeaderLock", mock.Anything).Return(true, nil)
+		mockSchedules.On("Due", mock.Anything, mock.Anything, 100).Return([]models.ScheduledTransfer{sched}, nil)
+		mockRepo.On("Transfer", mock.Anything, mock.MatchedBy(func(req *models.TransferRequest) bool {
+			return req.IdempotencyKey == key
+		})).Return(&models.TransferResult{AuditID: 42}, nil)
+		mockRepo.On("GetAccounts", mock.Anything, []int64{1, 2}).Return(nil, nil)
+		mockSchedules.On("RecordRun", mock.Anything, mock.MatchedBy(func(run *models.ScheduledRun) bool {
+			return run.Status == constants.RunSucceeded && run.TransferID == 42 && run.ScheduledFor.Equal(sched.NextRunAt)
+		}), mock.MatchedBy(func(next time.Time) bool {
+			return next.After(time.Now())
+		})).Return(nil)
+
+		ran, err := svc.RunDueTransfers(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, ran)
+		mockSchedules.AssertExpectations(t)
+	})
+
+	t.Run("Success: Failed Transfer Recorded And One-Off Completed", func(t *testing.T) {
+		mockSchedules, mockRepo, mockCache, svc := newScheduleTestSetup(t)
+		stubAccounts(mockCache)
+
+		mockSchedules.On("WithLeaderLock", mock.Anything).Return(true, nil)
+		mockSchedules.On("Due", mock.Anything, mock.Anything, 100).Return([]models.ScheduledTransfer{dueSchedule("")}, nil)
+		mockRepo.On("Transfer", mock.Anything, mock.Anything).Return(nil, constants.ErrInsufficientFunds)
+		mockSchedules.On("RecordRun", mock.Anything, mock.MatchedBy(func(run *models.ScheduledRun) bool {
+			return run.Status == constants.RunFailed && run.Error == constants.ErrInsufficientFunds.Error()
+		}), time.Time{}).Return(nil)
+
+		ran, err := svc.RunDueTransfers(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, ran)
+		mockSchedules.AssertExpectations(t)
+	})
+
+	t.Run("Success: Wrapped Rejection Recorded As Failed", func(t *testing.T) {
+		mockSchedules, mockRepo, mockCache, svc := newScheduleTestSetup(t)
+		stubAccounts(mockCache)
+
+		mockSchedules.On("WithLeaderLock", mock.Anything).Return(true, nil)
+		mockSchedules.On("Due", mock.Anything, mock.Anything, 100).Return([]models.ScheduledTransfer{dueSchedule("")}, nil)
+		mockRepo.On("Transfer", mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("check fee account: %w", constants.ErrFeeAccountMissing))
+		mockSchedules.On("RecordRun", mock.Anything, mock.MatchedBy(func(run *models.ScheduledRun) bool {
+			return run.Status == constants.RunFailed
+		}), time.Time{}).Return(nil)
+
+		ran, err := svc.RunDueTransfers(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, ran)
+		mockSchedules.AssertExpectations(t)
+		mockSchedules.AssertNotCalled(t, "RetryLater", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success: System Error Leaves Occurrence Due", func(t *testing.T) {
+		mockSchedules, mockRepo, mockCache, svc := newScheduleTestSetup(t)
+		stubAccounts(mockCache)
+
+		mockSchedules.On("WithLeaderLock", mock.Anything).Return(true, nil)
+		mockSchedules.On("Due", mock.Anything, mock.Anything, 100).Return([]models.ScheduledTransfer{dueSchedule("@daily")}, nil)
+		mockRepo.On("Transfer", mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("%w: connection reset", constants.ErrSystem))
+		mockSchedules.On("RetryLater", mock.Anything, int64(7), mock.Anything, 1).Return(nil)
+
+		ran, err := svc.RunDueTransfers(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, ran)
+		mockSchedules.AssertExpectations(t)
+		mockSchedules.AssertNotCalled(t, "RecordRun", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success: Wrapped Driver Error Leaves Occurrence Due", func(t *testing.T) {
+		mockSchedules, mockRepo, mockCache, svc := newScheduleTestSetup(t)
+		stubAccounts(mockCache)
+
+		mockSchedules.On("WithLeaderLock", mock.Anything).Return(true, nil)
+		mockSchedules.On("Due", mock.Anything, mock.Anything, 100).Return([]models.ScheduledTransfer{dueSchedule("@monthly")}, nil)
+		mockRepo.On("Transfer", mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("get fx rate failed: %w", errors.New("driver: bad connection")))
+		mockSchedules.On("RetryLater", mock.Anything, int64(7), mock.Anything, 1).Return(nil)
+
+		ran, err := svc.RunDueTransfers(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, ran)
+		mockSchedules.AssertExpectations(t)
+		mockSchedules.AssertNotCalled(t, "RecordRun", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success: Occurrence Recorded As Failed Once Out Of Attempts", func(t *testing.T) {
+		mockSchedules, mockRepo, mockCache, svc := newScheduleTestSetup(t)
+		stubAccounts(mockCache)
+		sched := dueSchedule("@daily")
+		sched.Attempts = 4
+
+		mockSchedules.On("WithLeaderLock", mock.Anything).Return(true, nil)
+		mockSchedules.On("Due", mock.Anything, mock.Anything, 100).Return([]models.ScheduledTransfer{sched}, nil)
+		mockRepo.On("Transfer", mock.Anything, mock.Anything).
+			Return(nil, errors.New("driver: bad connection"))
+		mockSchedules.On("RecordRun", mock.Anything, mock.MatchedBy(func(run *models.ScheduledRun) bool {
+			return run.Status == constants.RunFailed && run.Error == "driver: bad connection"
+		}), mock.MatchedBy(func(next time.Time) bool {
+			return next.After(time.Now())
+		})).Return(nil)
+
+		ran, err := svc.RunDueTransfers(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, ran)
+		mockSchedules.AssertExpectations(t)
+		mockSchedules.AssertNotCalled(t, "RetryLater", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success: Skipped Without The Lock", func(t *testing.T) {
+		mockSchedules, _, _, svc := newScheduleTestSetup(t)
+		mockSchedules.On("WithLeaderLock", mock.Anything).Return(false, nil)
+
+		ran, err := svc.RunDueTransfers(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, ran)
+		mockSchedules.AssertNotCalled(t, "Due", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
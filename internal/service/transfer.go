@@ -2,35 +2,61 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"slices"
+	"time"
+
 	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
 	"github.com/jhaprabhatt/account-transfer-project/internal/models"
 	"github.com/jhaprabhatt/account-transfer-project/internal/repository"
 
+	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
 )
 
 type TransferService struct {
-	transferRepo repository.TransferRepo
-	cache        repository.Cache
-	log          *zap.Logger
+	transferRepo  repository.TransferRepo
+	cache         repository.Cache
+	accounts      *accountLookup
+	fxRates       repository.FxRateRepo
+	rounding      models.RoundingMode
+	fees          models.FeeSchedule
+	settlement    models.SettlementAccounts
+	watchInterval time.Duration
+	log           *zap.Logger
 }
 
 func NewTransferService(
 	transferRepo repository.TransferRepo,
+	accountRepo repository.AccountRepo,
 	cache repository.Cache,
+	fxRates repository.FxRateRepo,
+	rounding models.RoundingMode,
+	fees models.FeeSchedule,
+	settlement models.SettlementAccounts,
+	watchInterval time.Duration,
 	log *zap.Logger,
 ) *TransferService {
 	return &TransferService{
-		transferRepo: transferRepo,
-		cache:        cache,
-		log:          log,
+		transferRepo:  transferRepo,
+		cache:         cache,
+		accounts:      newAccountLookup(cache, accountRepo, log),
+		fxRates:       fxRates,
+		rounding:      rounding,
+		fees:          fees,
+		settlement:    settlement,
+		watchInterval: watchInterval,
+		log:           log,
 	}
 }
 
 func (s *TransferService) MakeTransfer(ctx context.Context, req *models.TransferRequest) (*models.TransferResult, error) {
 
-	if err := s.ValidateTransfer(ctx, req.SourceID, req.DestinationID); err != nil {
+	src, err := s.prepareTransfer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.applyFee(req, src); err != nil {
 		return nil, err
 	}
 
@@ -42,29 +68,183 @@ func (s *TransferService) MakeTransfer(ctx context.Context, req *models.Transfer
 	if err != nil {
 		return nil, err
 	}
+	s.refreshAccounts(ctx, req.AccountIDs()...)
 
 	return result, nil
 }
 
-func (s *TransferService) ValidateTransfer(ctx context.Context, sourceID, destID int64) error {
-	if sourceID == destID {
-		return constants.ErrSameAccount
-	}
-	srcExists, err := s.cache.Exists(ctx, sourceID)
+// refreshAccounts re-reads the given accounts once a change to them has been
+// committed and writes them to the cache. The cache keeps the highest version
+// it has seen, so refreshes finishing out of order cannot regress a balance.
+// Failures are only logged: the change is safe in the database, and the next
+// refresh of the account repairs the cache.
+func (s *TransferService) refreshAccounts(ctx context.Context, ids ...int64) {
+	// The change is committed even if the caller has gone away.
+	ctx = context.WithoutCancel(ctx)
+
+	ids = slices.Compact(slices.Sorted(slices.Values(ids)))
+	accounts, err := s.transferRepo.GetAccounts(ctx, ids)
 	if err != nil {
-		return fmt.Errorf("failed to check source account cache: %w", err)
+		s.log.Error("Cache refresh failed after commit (data is safe in DB)",
+			zap.Int64s("account_ids", ids),
+			zap.Error(err))
+		return
 	}
-	if !srcExists {
-		return constants.ErrAccountNotFound
+
+	for i := range accounts {
+		if err := s.cache.SetAccount(ctx, &accounts[i]); err != nil {
+			s.log.Error("Cache refresh failed after commit (data is safe in DB)",
+				zap.Int64("account_id", accounts[i].ID),
+				zap.Error(err))
+		}
 	}
+}
 
-	destExists, err := s.cache.Exists(ctx, destID)
+// prepareTransfer runs the cached pre-checks and, for accounts in different
+// currencies, fixes the FX quote on req. It returns the cached source account.
+func (s *TransferService) prepareTransfer(ctx context.Context, req *models.TransferRequest) (*models.Account, error) {
+	src, dest, err := s.validateTransfer(ctx, req)
 	if err != nil {
-		return fmt.Errorf("failed to check destination account cache: %w", err)
+		return nil, err
+	}
+
+	if src.Currency != dest.Currency {
+		quote, err := s.quote(ctx, req.Amount, src.Currency, dest.Currency)
+		if err != nil {
+			return nil, err
+		}
+		req.Quote = quote
 	}
-	if !destExists {
-		return constants.ErrAccountNotFound
+	return src, nil
+}
+
+// applyFee fixes on req the fee the source account's class pays, in the source
+// currency and rounded to its minor units. The fee account itself pays none.
+func (s *TransferService) applyFee(req *models.TransferRequest, src *models.Account) error {
+	fee, err := s.feeFor(src, req.Amount)
+	if err != nil || fee == nil {
+		return err
 	}
 
+	req.Fee = fee
+
+	s.log.Info("Transfer fee applied",
+		zap.Int64("from", req.SourceID),
+		zap.String("account_class", src.Class),
+		zap.String("fee", fee.Amount.String()))
 	return nil
 }
+
+// rejectFee fails a debit of amount from src on a path that cannot post a fee,
+// if src's class would pay one, rather than letting it move money for free.
+func (s *TransferService) rejectFee(src *models.Account, amount decimal.Decimal) error {
+	fee, err := s.feeFor(src, amount)
+	if err != nil {
+		return err
+	}
+	if fee != nil {
+		return constants.ErrFeeNotSupported
+	}
+	return nil
+}
+
+// feeFor returns the fee src pays on a debit of amount, or nil if none is due.
+func (s *TransferService) feeFor(src *models.Account, amount decimal.Decimal) (*models.TransferFee, error) {
+	policy, ok := s.fees.For(src.Class)
+	if !ok {
+		return nil, nil
+	}
+
+	fee := s.rounding.Round(policy.Fee(amount), src.Currency)
+	if !fee.IsPositive() {
+		return nil, nil
+	}
+
+	feeAccountID, ok := s.fees.Accounts[src.Currency]
+	if !ok {
+		s.log.Error("No fee account for currency", zap.String("currency", src.Currency))
+		return nil, constants.ErrFeeAccountMissing
+	}
+	if feeAccountID == src.ID {
+		return nil, nil
+	}
+	return &models.TransferFee{Amount: fee, AccountID: feeAccountID}, nil
+}
+
+// validateTransfer pre-checks the request against the cached accounts and
+// returns them; the repository re-checks everything under row locks.
+func (s *TransferService) validateTransfer(ctx context.Context, req *models.TransferRequest) (*models.Account, *models.Account, error) {
+	if req.SourceID == req.DestinationID {
+		return nil, nil, constants.ErrSameAccount
+	}
+	src, err := s.accounts.get(ctx, req.SourceID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dest, err := s.accounts.get(ctx, req.DestinationID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := src.CheckDebit(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := dest.CheckCredit(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := req.CheckCurrency(src, dest); err != nil {
+		return nil, nil, err
+	}
+
+	return src, dest, nil
+}
+
+// quote fixes the rate and destination amount for a cross-currency transfer.
+// The rate row is immutable, so the stored rate ID reproduces the conversion.
+func (s *TransferService) quote(ctx context.Context, amount decimal.Decimal, from, to string) (*models.FxQuote, error) {
+	rate, err := s.fxRates.LatestRate(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	converted := rate.Convert(amount, s.rounding)
+	if !converted.IsPositive() {
+		return nil, constants.ErrConvertedAmountTooSmall
+	}
+
+	s.log.Info("FX rate applied",
+		zap.Int64("rate_id", rate.ID),
+		zap.String("rate", rate.Rate.String()),
+		zap.String("source_amount", amount.String()),
+		zap.String("destination_amount", converted.String()))
+
+	return &models.FxQuote{
+		RateID:              rate.ID,
+		Rate:                rate.Rate,
+		SourceCurrency:      from,
+		DestinationCurrency: to,
+		DestinationAmount:   converted,
+	}, nil
+}
+
+func (s *TransferService) GetTransfer(ctx context.Context, id int64) (*models.Transfer, error) {
+	if id <= 0 {
+		return nil, constants.ErrInvalidTransferID
+	}
+	return s.transferRepo.GetTransfer(ctx, id)
+}
+
+func (s *TransferService) ListTransfers(ctx context.Context, q models.TransferQuery) (*models.TransferPage, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.accounts.get(ctx, q.AccountID); err != nil {
+		return nil, err
+	}
+
+	return s.transferRepo.ListTransfers(ctx, q)
+}
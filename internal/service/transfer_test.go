@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
 
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
 	"github.com/jhaprabhatt/account-transfer-project/internal/models"
 	"github.com/jhaprabhatt/account-transfer-project/internal/service"
 	"github.com/jhaprabhatt/account-transfer-project/internal/service/mocks"
@@ -30,18 +32,26 @@ func TestTransferService_MakeTransfer(t *testing.T) {
 		{
 			name: "Success",
 			mockBehavior: func(repo *mocks.MockTransactionRepo, cache *mocks.MockCache) {
-				cache.On("Exists", mock.Anything, int64(1)).Return(true, nil)
-				cache.On("Exists", mock.Anything, int64(2)).Return(true, nil)
+				cache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+				cache.On("GetAccount", mock.Anything, int64(2)).Return(activeAccount(2), nil)
 				res := &models.TransferResult{Status: "SUCCESS", CorrelationID: 12345}
 				repo.On("Transfer", mock.Anything, req).Return(res, nil)
+
+				// Both accounts are re-read after commit and written to the cache with their new versions.
+				src, dest := *activeAccount(1), *activeAccount(2)
+				src.Balance, src.Version = decimal.NewFromInt(900), 5
+				dest.Balance, dest.Version = decimal.NewFromInt(100), 3
+				repo.On("GetAccounts", mock.Anything, []int64{1, 2}).Return([]models.Account{src, dest}, nil)
+				cache.On("SetAccount", mock.Anything, &src).Return(nil)
+				cache.On("SetAccount", mock.Anything, &dest).Return(nil)
 			},
 			expectedError: "",
 		},
 		{
 			name: "Failure: DB Error",
 			mockBehavior: func(repo *mocks.MockTransactionRepo, cache *mocks.MockCache) {
-				cache.On("Exists", mock.Anything, int64(1)).Return(true, nil)
-				cache.On("Exists", mock.Anything, int64(2)).Return(true, nil)
+				cache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+				cache.On("GetAccount", mock.Anything, int64(2)).Return(activeAccount(2), nil)
 				repo.On("Transfer", mock.Anything, req).Return(nil, errors.New("db error"))
 			},
 			expectedError: "db error",
@@ -65,11 +75,44 @@ func TestTransferService_MakeTransfer(t *testing.T) {
 		})
 	}
 
+	t.Run("Success: Cache Refresh Failure Does Not Fail Committed Transfer", func(t *testing.T) {
+		repo, cache, svc := newTestSetup(t)
+
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		cache.On("GetAccount", mock.Anything, int64(2)).Return(activeAccount(2), nil)
+		repo.On("Transfer", mock.Anything, req).Return(&models.TransferResult{Status: "SUCCESS"}, nil)
+		repo.On("GetAccounts", mock.Anything, []int64{1, 2}).Return([]models.Account{*activeAccount(1), *activeAccount(2)}, nil)
+		cache.On("SetAccount", mock.Anything, mock.Anything).Return(errors.New("redis down"))
+
+		result, err := svc.MakeTransfer(context.Background(), req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SUCCESS", result.Status)
+		cache.AssertNumberOfCalls(t, "SetAccount", 2)
+	})
+
+	t.Run("Success: Cancelled Caller Still Refreshes Cache", func(t *testing.T) {
+		repo, cache, svc := newTestSetup(t)
+		ctx, cancel := context.WithCancel(context.Background())
+
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		cache.On("GetAccount", mock.Anything, int64(2)).Return(activeAccount(2), nil)
+		repo.On("Transfer", mock.Anything, req).Run(func(mock.Arguments) { cancel() }).
+			Return(&models.TransferResult{Status: "SUCCESS"}, nil)
+		repo.On("GetAccounts", mock.MatchedBy(func(ctx context.Context) bool { return ctx.Err() == nil }), []int64{1, 2}).
+			Return(nil, nil)
+
+		_, err := svc.MakeTransfer(ctx, req)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
 	t.Run("Failure: Repo Transfer Fails", func(t *testing.T) {
 		repo, cache, svc := newTestSetup(t)
 
-		cache.On("Exists", mock.Anything, int64(1)).Return(true, nil)
-		cache.On("Exists", mock.Anything, int64(2)).Return(true, nil)
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		cache.On("GetAccount", mock.Anything, int64(2)).Return(activeAccount(2), nil)
 
 		repo.On("Transfer", mock.Anything, req).Return(nil, errors.New("db connection lost"))
 
@@ -77,12 +120,370 @@ func TestTransferService_MakeTransfer(t *testing.T) {
 
 		assert.ErrorContains(t, err, "db connection lost")
 	})
+
+	t.Run("Failure: Source Frozen", func(t *testing.T) {
+		repo, cache, svc := newTestSetup(t)
+
+		frozen := activeAccount(1)
+		frozen.Status = constants.AccountFrozen
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(frozen, nil)
+		cache.On("GetAccount", mock.Anything, int64(2)).Return(activeAccount(2), nil)
+
+		_, err := svc.MakeTransfer(context.Background(), req)
+
+		assert.ErrorIs(t, err, constants.ErrAccountFrozen)
+		repo.AssertNotCalled(t, "Transfer")
+	})
+
+	t.Run("Failure: Destination Closed", func(t *testing.T) {
+		repo, cache, svc := newTestSetup(t)
+
+		closed := activeAccount(2)
+		closed.Status = constants.AccountClosed
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		cache.On("GetAccount", mock.Anything, int64(2)).Return(closed, nil)
+
+		_, err := svc.MakeTransfer(context.Background(), req)
+
+		assert.ErrorIs(t, err, constants.ErrAccountClosed)
+		repo.AssertNotCalled(t, "Transfer")
+	})
+
+	t.Run("Failure: Currency Mismatch", func(t *testing.T) {
+		repo, cache, svc := newTestSetup(t)
+
+		eur := activeAccount(2)
+		eur.Currency = "EUR"
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		cache.On("GetAccount", mock.Anything, int64(2)).Return(eur, nil)
+
+		_, err := svc.MakeTransfer(context.Background(), req)
+
+		assert.ErrorIs(t, err, constants.ErrCurrencyMismatch)
+		repo.AssertNotCalled(t, "Transfer")
+	})
+
+	t.Run("Success: Lower-Case Currency Normalized", func(t *testing.T) {
+		repo, cache, svc := newTestSetup(t)
+
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		cache.On("GetAccount", mock.Anything, int64(2)).Return(activeAccount(2), nil)
+		repo.On("Transfer", mock.Anything, mock.MatchedBy(func(r *models.TransferRequest) bool {
+			return r.Currency == "USD"
+		})).Return(&models.TransferResult{Status: "SUCCESS"}, nil)
+		repo.On("GetAccounts", mock.Anything, []int64{1, 2}).Return(nil, nil)
+
+		lower := &models.TransferRequest{SourceID: 1, DestinationID: 2, Amount: decimal.NewFromInt(10), Currency: "usd"}
+		_, err := svc.MakeTransfer(context.Background(), lower)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Amount Exceeds Currency Scale", func(t *testing.T) {
+		repo, cache, svc := newTestSetup(t)
+
+		jpySrc, jpyDest := activeAccount(1), activeAccount(2)
+		jpySrc.Currency, jpyDest.Currency = "JPY", "JPY"
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(jpySrc, nil)
+		cache.On("GetAccount", mock.Anything, int64(2)).Return(jpyDest, nil)
+
+		fractional := &models.TransferRequest{SourceID: 1, DestinationID: 2, Amount: decimal.RequireFromString("10.5")}
+		_, err := svc.MakeTransfer(context.Background(), fractional)
+
+		assert.ErrorIs(t, err, constants.ErrInvalidAmountScale)
+		repo.AssertNotCalled(t, "Transfer")
+	})
+
+	t.Run("Failure: Destination Cached As Missing", func(t *testing.T) {
+		repo, cache, svc := newTestSetup(t)
+
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		cache.On("GetAccount", mock.Anything, int64(2)).Return(nil, constants.ErrAccountNotFound)
+
+		_, err := svc.MakeTransfer(context.Background(), req)
+
+		assert.ErrorIs(t, err, constants.ErrAccountNotFound)
+		repo.AssertNotCalled(t, "Transfer")
+	})
+}
+
+func TestTransferService_MakeTransfer_AccountLookup(t *testing.T) {
+	req := &models.TransferRequest{SourceID: 1, DestinationID: 2, Amount: decimal.NewFromInt(100)}
+
+	t.Run("Success: Cache Miss Read Through And Repopulated", func(t *testing.T) {
+		repo, accounts, cache, svc := newLookupTestSetup(t)
+
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		cache.On("GetAccount", mock.Anything, int64(2)).Return(nil, nil)
+		accounts.On("GetAccount", mock.Anything, int64(2)).Return(activeAccount(2), nil)
+		cache.On("SetAccount", mock.Anything, activeAccount(2)).Return(nil)
+		repo.On("Transfer", mock.Anything, req).Return(&models.TransferResult{Status: "SUCCESS"}, nil)
+		repo.On("GetAccounts", mock.Anything, []int64{1, 2}).Return(nil, nil)
+
+		_, err := svc.MakeTransfer(context.Background(), req)
+
+		assert.NoError(t, err)
+		accounts.AssertExpectations(t)
+		cache.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Absent Account Cached As Missing", func(t *testing.T) {
+		repo, accounts, cache, svc := newLookupTestSetup(t)
+
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(nil, nil)
+		accounts.On("GetAccount", mock.Anything, int64(1)).Return(nil, constants.ErrAccountNotFound)
+		cache.On("SetMissing", mock.Anything, int64(1)).Return(nil)
+
+		_, err := svc.MakeTransfer(context.Background(), req)
+
+		assert.ErrorIs(t, err, constants.ErrAccountNotFound)
+		cache.AssertExpectations(t)
+		repo.AssertNotCalled(t, "Transfer")
+	})
+
+	t.Run("Failure: Database Error On Read Through", func(t *testing.T) {
+		repo, accounts, cache, svc := newLookupTestSetup(t)
+
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(nil, nil)
+		accounts.On("GetAccount", mock.Anything, int64(1)).Return(nil, errors.New("db down"))
+
+		_, err := svc.MakeTransfer(context.Background(), req)
+
+		assert.ErrorContains(t, err, "db down")
+		cache.AssertNotCalled(t, "SetMissing", mock.Anything, mock.Anything)
+		repo.AssertNotCalled(t, "Transfer")
+	})
+
+	t.Run("Success: Unreachable Cache Bypassed", func(t *testing.T) {
+		repo, accounts, cache, svc := newLookupTestSetup(t)
+
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(nil, errors.New("connection refused")).Once()
+		accounts.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		accounts.On("GetAccount", mock.Anything, int64(2)).Return(activeAccount(2), nil)
+		repo.On("Transfer", mock.Anything, req).Return(&models.TransferResult{Status: "SUCCESS"}, nil)
+		repo.On("GetAccounts", mock.Anything, []int64{1, 2}).Return(nil, nil)
+
+		_, err := svc.MakeTransfer(context.Background(), req)
+		assert.NoError(t, err)
+
+		// The cache is skipped for the rest of the retry window.
+		_, err = svc.MakeTransfer(context.Background(), req)
+		assert.NoError(t, err)
+
+		cache.AssertNumberOfCalls(t, "GetAccount", 1)
+		accounts.AssertNumberOfCalls(t, "GetAccount", 4)
+		cache.AssertNotCalled(t, "SetAccount", mock.Anything, mock.Anything)
+	})
+}
+
+func activeAccount(id int64) *models.Account {
+	return &models.Account{ID: id, Balance: decimal.NewFromInt(1000), Currency: "USD", Status: constants.AccountActive}
+}
+
+func TestTransferService_MakeTransfer_FX(t *testing.T) {
+	usdAccount := func(id int64) *models.Account {
+		return activeAccount(id)
+	}
+	eurAccount := func(id int64) *models.Account {
+		acc := activeAccount(id)
+		acc.Currency = "EUR"
+		return acc
+	}
+	usdToEur := &models.FxRate{ID: 3, Base: "USD", Quote: "EUR", Rate: decimal.RequireFromString("0.92345")}
+
+	roundingCases := []struct {
+		mode     models.RoundingMode
+		expected string
+	}{
+		{models.RoundHalfEven, "92.34"},
+		{models.RoundHalfUp, "92.35"},
+		{models.RoundDown, "92.34"},
+	}
+
+	for _, tc := range roundingCases {
+		t.Run("Success: Quote Rounded "+string(tc.mode), func(t *testing.T) {
+			repo, cache, fx, svc := newFxTestSetup(t, tc.mode)
+
+			cache.On("GetAccount", mock.Anything, int64(1)).Return(usdAccount(1), nil)
+			cache.On("GetAccount", mock.Anything, int64(2)).Return(eurAccount(2), nil)
+			fx.On("LatestRate", mock.Anything, "USD", "EUR").Return(usdToEur, nil)
+			repo.On("Transfer", mock.Anything, mock.MatchedBy(func(r *models.TransferRequest) bool {
+				return r.Quote != nil &&
+					r.Quote.RateID == 3 &&
+					r.Quote.SourceCurrency == "USD" &&
+					r.Quote.DestinationCurrency == "EUR" &&
+					r.Quote.DestinationAmount.String() == tc.expected
+			})).Return(&models.TransferResult{Status: "SUCCESS"}, nil)
+			repo.On("GetAccounts", mock.Anything, []int64{1, 2}).Return(nil, nil)
+
+			req := &models.TransferRequest{SourceID: 1, DestinationID: 2, Amount: decimal.NewFromInt(100), Convert: true}
+			_, err := svc.MakeTransfer(context.Background(), req)
+
+			assert.NoError(t, err)
+			repo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Success: Same Currency Skips Quote", func(t *testing.T) {
+		repo, cache, fx, svc := newFxTestSetup(t, models.RoundHalfEven)
+
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(usdAccount(1), nil)
+		cache.On("GetAccount", mock.Anything, int64(2)).Return(usdAccount(2), nil)
+		repo.On("Transfer", mock.Anything, mock.MatchedBy(func(r *models.TransferRequest) bool {
+			return r.Quote == nil
+		})).Return(&models.TransferResult{Status: "SUCCESS"}, nil)
+		repo.On("GetAccounts", mock.Anything, []int64{1, 2}).Return(nil, nil)
+
+		req := &models.TransferRequest{SourceID: 1, DestinationID: 2, Amount: decimal.NewFromInt(100), Convert: true}
+		_, err := svc.MakeTransfer(context.Background(), req)
+
+		assert.NoError(t, err)
+		fx.AssertNotCalled(t, "LatestRate")
+	})
+
+	t.Run("Failure: No Rate For Pair", func(t *testing.T) {
+		repo, cache, fx, svc := newFxTestSetup(t, models.RoundHalfEven)
+
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(usdAccount(1), nil)
+		cache.On("GetAccount", mock.Anything, int64(2)).Return(eurAccount(2), nil)
+		fx.On("LatestRate", mock.Anything, "USD", "EUR").Return(nil, constants.ErrFxRateNotFound)
+
+		req := &models.TransferRequest{SourceID: 1, DestinationID: 2, Amount: decimal.NewFromInt(100), Convert: true}
+		_, err := svc.MakeTransfer(context.Background(), req)
+
+		assert.ErrorIs(t, err, constants.ErrFxRateNotFound)
+		repo.AssertNotCalled(t, "Transfer")
+	})
+
+	t.Run("Failure: Converted Amount Rounds To Zero", func(t *testing.T) {
+		repo, cache, fx, svc := newFxTestSetup(t, models.RoundHalfEven)
+
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(usdAccount(1), nil)
+		cache.On("GetAccount", mock.Anything, int64(2)).Return(eurAccount(2), nil)
+		fx.On("LatestRate", mock.Anything, "USD", "EUR").
+			Return(&models.FxRate{ID: 4, Base: "USD", Quote: "EUR", Rate: decimal.RequireFromString("0.4")}, nil)
+
+		req := &models.TransferRequest{SourceID: 1, DestinationID: 2, Amount: decimal.RequireFromString("0.01"), Convert: true}
+		_, err := svc.MakeTransfer(context.Background(), req)
+
+		assert.ErrorIs(t, err, constants.ErrConvertedAmountTooSmall)
+		repo.AssertNotCalled(t, "Transfer")
+	})
+}
+
+func TestTransferService_GetTransfer(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		repo, _, svc := newTestSetup(t)
+		repo.On("GetTransfer", mock.Anything, int64(7)).Return(&models.Transfer{ID: 7}, nil)
+
+		tr, err := svc.GetTransfer(context.Background(), 7)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), tr.ID)
+	})
+
+	t.Run("Failure: Invalid ID", func(t *testing.T) {
+		repo, _, svc := newTestSetup(t)
+
+		_, err := svc.GetTransfer(context.Background(), 0)
+
+		assert.ErrorIs(t, err, constants.ErrInvalidTransferID)
+		repo.AssertNotCalled(t, "GetTransfer")
+	})
+}
+
+func TestTransferService_ListTransfers(t *testing.T) {
+	t.Run("Success: Default Page Size Applied", func(t *testing.T) {
+		repo, cache, svc := newTestSetup(t)
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		repo.On("ListTransfers", mock.Anything, models.TransferQuery{AccountID: 1, Limit: models.DefaultTransferPageSize}).
+			Return(&models.TransferPage{NextCursor: 5}, nil)
+
+		page, err := svc.ListTransfers(context.Background(), models.TransferQuery{AccountID: 1})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), page.NextCursor)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Invalid Time Range", func(t *testing.T) {
+		repo, _, svc := newTestSetup(t)
+		now := time.Now()
+
+		_, err := svc.ListTransfers(context.Background(), models.TransferQuery{AccountID: 1, From: now, To: now.Add(-time.Hour)})
+
+		assert.ErrorIs(t, err, constants.ErrInvalidTimeRange)
+		repo.AssertNotCalled(t, "ListTransfers")
+	})
+
+	t.Run("Success: Flushed Cache Read Through", func(t *testing.T) {
+		repo, accounts, cache, svc := newLookupTestSetup(t)
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(nil, nil)
+		accounts.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		cache.On("SetAccount", mock.Anything, activeAccount(1)).Return(nil)
+		repo.On("ListTransfers", mock.Anything, mock.Anything).Return(&models.TransferPage{}, nil)
+
+		_, err := svc.ListTransfers(context.Background(), models.TransferQuery{AccountID: 1})
+
+		assert.NoError(t, err)
+		cache.AssertExpectations(t)
+	})
+
+	t.Run("Success: Unreachable Cache Bypassed", func(t *testing.T) {
+		repo, accounts, cache, svc := newLookupTestSetup(t)
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(nil, errors.New("connection refused"))
+		accounts.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		repo.On("ListTransfers", mock.Anything, mock.Anything).Return(&models.TransferPage{}, nil)
+
+		_, err := svc.ListTransfers(context.Background(), models.TransferQuery{AccountID: 1})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Failure: Account Not Found", func(t *testing.T) {
+		repo, cache, svc := newTestSetup(t)
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(nil, constants.ErrAccountNotFound)
+
+		_, err := svc.ListTransfers(context.Background(), models.TransferQuery{AccountID: 1})
+
+		assert.ErrorIs(t, err, constants.ErrAccountNotFound)
+		repo.AssertNotCalled(t, "ListTransfers")
+	})
 }
 
 func newTestSetup(t *testing.T) (*mocks.MockTransactionRepo, *mocks.MockCache, *service.TransferService) {
+	mockRepo, mockCache, _, svc := newFxTestSetup(t, models.RoundHalfEven)
+	return mockRepo, mockCache, svc
+}
+
+func newLookupTestSetup(t *testing.T) (*mocks.MockTransactionRepo, *mocks.MockAccountRepo, *mocks.MockCache, *service.TransferService) {
+	mockRepo := new(mocks.MockTransactionRepo)
+	mockAccounts := new(mocks.MockAccountRepo)
+	mockCache := new(mocks.MockCache)
+	svc := service.NewTransferService(mockRepo, mockAccounts, mockCache, new(mocks.MockFxRateRepo), models.RoundHalfEven, models.FeeSchedule{}, nil, time.Millisecond, zap.NewNop())
+	return mockRepo, mockAccounts, mockCache, svc
+}
+
+func newFxTestSetup(t *testing.T, rounding models.RoundingMode) (*mocks.MockTransactionRepo, *mocks.MockCache, *mocks.MockFxRateRepo, *service.TransferService) {
 	mockRepo := new(mocks.MockTransactionRepo)
 	mockCache := new(mocks.MockCache)
+	mockFx := new(mocks.MockFxRateRepo)
 	logger := zap.NewNop()
-	svc := service.NewTransferService(mockRepo, mockCache, logger)
+	svc := service.NewTransferService(mockRepo, new(mocks.MockAccountRepo), mockCache, mockFx, rounding, models.FeeSchedule{}, nil, time.Millisecond, logger)
+	return mockRepo, mockCache, mockFx, svc
+}
+
+func newFeeTestSetup(t *testing.T, fees models.FeeSchedule) (*mocks.MockTransactionRepo, *mocks.MockCache, *service.TransferService) {
+	mockRepo := new(mocks.MockTransactionRepo)
+	mockCache := new(mocks.MockCache)
+	svc := service.NewTransferService(mockRepo, new(mocks.MockAccountRepo), mockCache, new(mocks.MockFxRateRepo), models.RoundHalfEven, fees, nil, time.Millisecond, zap.NewNop())
+	return mockRepo, mockCache, svc
+}
+
+func newCashTestSetup(t *testing.T, settlement models.SettlementAccounts) (*mocks.MockTransactionRepo, *mocks.MockCache, *service.TransferService) {
+	mockRepo := new(mocks.MockTransactionRepo)
+	mockCache := new(mocks.MockCache)
+	svc := service.NewTransferService(mockRepo, new(mocks.MockAccountRepo), mockCache, new(mocks.MockFxRateRepo), models.RoundHalfEven, models.FeeSchedule{}, settlement, time.Millisecond, zap.NewNop())
 	return mockRepo, mockCache, svc
 }
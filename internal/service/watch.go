@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+
+	"go.uber.org/zap"
+)
+
+// watchBatchSize bounds how many postings one poll of WatchTransfers reads.
+const watchBatchSize = 100
+
+// WatchCursor validates a watch request and resolves where it starts: after
+// transfer sinceTransferID, or with 0 after the account's latest posting.
+func (s *TransferService) WatchCursor(ctx context.Context, accountID, sinceTransferID int64) (models.TransferCursor, error) {
+	if accountID <= 0 {
+		return models.TransferCursor{}, constants.ErrInvalidAccountID
+	}
+	if sinceTransferID < 0 {
+		return models.TransferCursor{}, constants.ErrInvalidTransferID
+	}
+
+	if _, err := s.accounts.get(ctx, accountID); err != nil {
+		return models.TransferCursor{}, err
+	}
+
+	return s.transferRepo.WatchCursor(ctx, accountID, sinceTransferID)
+}
+
+// WatchTransfers hands every transfer posted to the account after cursor to
+// send, in posting order, polling for new ones every watchInterval. It returns
+// when ctx is done or send or a poll fails.
+func (s *TransferService) WatchTransfers(ctx context.Context, cursor models.TransferCursor, send func(*models.Transfer) error) error {
+	s.log.Debug("Watching transfers",
+		zap.Int64("account_id", cursor.AccountID), zap.Int64("posting_seq", cursor.PostingSeq))
+
+	ticker := time.NewTicker(s.watchInterval)
+	defer ticker.Stop()
+
+	for {
+		// Drain full batches before waiting so a replay does not crawl.
+		for {
+			transfers, next, err := s.transferRepo.ListPostedAfter(ctx, cursor, watchBatchSize)
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				return err
+			}
+			for i := range transfers {
+				if err := send(&transfers[i]); err != nil {
+					return err
+				}
+			}
+			cursor = next
+			if len(transfers) < watchBatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+)
+
+func TestTransferService_WatchCursor(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		repo, cache, svc := newTestSetup(t)
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		repo.On("WatchCursor", mock.Anything, int64(1), int64(7)).
+			Return(models.TransferCursor{AccountID: 1, PostingSeq: 12}, nil)
+
+		cursor, err := svc.WatchCursor(context.Background(), 1, 7)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(12), cursor.PostingSeq)
+	})
+
+	t.Run("Failure: Invalid Since Transfer", func(t *testing.T) {
+		repo, _, svc := newTestSetup(t)
+
+		_, err := svc.WatchCursor(context.Background(), 1, -1)
+
+		assert.ErrorIs(t, err, constants.ErrInvalidTransferID)
+		repo.AssertNotCalled(t, "WatchCursor")
+	})
+
+	t.Run("Success: Flushed Cache Read Through", func(t *testing.T) {
+		repo, accounts, cache, svc := newLookupTestSetup(t)
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(nil, nil)
+		accounts.On("GetAccount", mock.Anything, int64(1)).Return(activeAccount(1), nil)
+		cache.On("SetAccount", mock.Anything, activeAccount(1)).Return(nil)
+		repo.On("WatchCursor", mock.Anything, int64(1), int64(0)).Return(models.TransferCursor{AccountID: 1}, nil)
+
+		_, err := svc.WatchCursor(context.Background(), 1, 0)
+
+		assert.NoError(t, err)
+		cache.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Account Not Found", func(t *testing.T) {
+		repo, cache, svc := newTestSetup(t)
+		cache.On("GetAccount", mock.Anything, int64(1)).Return(nil, constants.ErrAccountNotFound)
+
+		_, err := svc.WatchCursor(context.Background(), 1, 0)
+
+		assert.ErrorIs(t, err, constants.ErrAccountNotFound)
+		repo.AssertNotCalled(t, "WatchCursor")
+	})
+}
+
+func TestTransferService_WatchTransfers(t *testing.T) {
+	start := models.TransferCursor{AccountID: 1, PostingSeq: 10}
+	afterFirst := models.TransferCursor{AccountID: 1, PostingSeq: 11}
+	afterSecond := models.TransferCursor{AccountID: 1, PostingSeq: 13}
+
+	t.Run("Success: Replays Then Streams New Postings", func(t *testing.T) {
+		repo, _, svc := newTestSetup(t)
+		repo.On("ListPostedAfter", mock.Anything, start, 100).
+			Return([]models.Transfer{{ID: 20}}, afterFirst, nil).Once()
+		repo.On("ListPostedAfter", mock.Anything, afterFirst, 100).
+			Return(nil, afterFirst, nil).Once()
+		repo.On("ListPostedAfter", mock.Anything, afterFirst, 100).
+			Return([]models.Transfer{{ID: 18}}, afterSecond, nil).Once()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var got []int64
+		err := svc.WatchTransfers(ctx, start, func(tr *models.Transfer) error {
+			got = append(got, tr.ID)
+			if len(got) == 2 {
+				cancel()
+			}
+			return nil
+		})
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, []int64{20, 18}, got)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Failure: Send Error Stops Watch", func(t *testing.T) {
+		repo, _, svc := newTestSetup(t)
+		repo.On("ListPostedAfter", mock.Anything, start, 100).
+			Return([]models.Transfer{{ID: 20}, {ID: 21}}, afterSecond, nil).Once()
+		sendErr := errors.New("client gone")
+
+		calls := 0
+		err := svc.WatchTransfers(context.Background(), start, func(*models.Transfer) error {
+			calls++
+			return sendErr
+		})
+
+		require.ErrorIs(t, err, sendErr)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Failure: Poll Error", func(t *testing.T) {
+		repo, _, svc := newTestSetup(t)
+		repo.On("ListPostedAfter", mock.Anything, start, 100).
+			Return(nil, start, errors.New("db down")).Once()
+
+		err := svc.WatchTransfers(context.Background(), start, func(*models.Transfer) error { return nil })
+
+		assert.EqualError(t, err, "db down")
+	})
+}
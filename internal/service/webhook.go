@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	"github.com/jhaprabhatt/account-transfer-project/internal/repository"
+
+	"go.uber.org/zap"
+)
+
+// webhookBatchSize caps how many deliveries one delivery tick attempts.
+const webhookBatchSize = 100
+
+// WebhookSender posts a delivery to its subscription's URL. A nil error means
+// the receiver accepted it. CheckURL reports whether Send may post to a URL at
+// all, so unreachable or disallowed receivers are refused at subscription.
+type WebhookSender interface {
+	Send(ctx context.Context, d *models.WebhookDelivery) error
+	CheckURL(ctx context.Context, rawURL string) error
+}
+
+// WebhookService manages webhook subscriptions and delivers the events queued
+// for them, retrying failures with exponential backoff until they are
+// delivered or dead-lettered.
+type WebhookService struct {
+	webhooks repository.WebhookRepo
+	accounts *accountLookup
+	sender   WebhookSender
+	retry    models.WebhookRetryPolicy
+	workers  int
+	log      *zap.Logger
+}
+
+func NewWebhookService(
+	webhooks repository.WebhookRepo,
+	accountRepo repository.AccountRepo,
+	cache repository.Cache,
+	sender WebhookSender,
+	retry models.WebhookRetryPolicy,
+	workers int,
+	log *zap.Logger,
+) *WebhookService {
+	return &WebhookService{
+		webhooks: webhooks,
+		accounts: newAccountLookup(cache, accountRepo, log),
+		sender:   sender,
+		retry:    retry,
+		workers:  max(workers, 1),
+		log:      log,
+	}
+}
+
+// CreateWebhook subscribes a URL to events, generating a secret if none was
+// given. The returned subscription is the only one that carries the secret.
+func (s *WebhookService) CreateWebhook(ctx context.Context, req *models.WebhookRequest) (*models.WebhookSubscription, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.sender.CheckURL(ctx, req.URL); err != nil {
+		return nil, err
+	}
+
+	if req.AccountID != 0 {
+		if _, err := s.accounts.get(ctx, req.AccountID); err != nil {
+			return nil, err
+		}
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		var err error
+		if secret, err = newWebhookSecret(); err != nil {
+			return nil, err
+		}
+	}
+
+	eventTypes := slices.Clone(req.EventTypes)
+	slices.Sort(eventTypes)
+
+	w := &models.WebhookSubscription{
+		URL:        req.URL,
+		Secret:     secret,
+		EventTypes: slices.Compact(eventTypes),
+		AccountID:  req.AccountID,
+		Status:     constants.WebhookActive,
+	}
+	if err := s.webhooks.Create(ctx, w); err != nil {
+		return nil, err
+	}
+
+	s.log.Info("Webhook subscribed",
+		zap.Int64("webhook_id", w.ID),
+		zap.String("url", w.URL),
+		zap.Int64("account_id", w.AccountID))
+
+	return w, nil
+}
+
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate webhook secret: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func (s *WebhookService) GetWebhook(ctx context.Context, id int64) (*models.WebhookSubscription, error) {
+	if id <= 0 {
+		return nil, constants.ErrInvalidWebhookID
+	}
+	return s.webhooks.Get(ctx, id)
+}
+
+func (s *WebhookService) ListWebhooks(ctx context.Context) ([]models.WebhookSubscription, error) {
+	return s.webhooks.List(ctx)
+}
+
+func (s *WebhookService) DeleteWebhook(ctx context.Context, id int64) (*models.WebhookSubscription, error) {
+	if id <= 0 {
+		return nil, constants.ErrInvalidWebhookID
+	}
+	return s.webhooks.Delete(ctx, id)
+}
+
+func (s *WebhookService) ListDeadLetters(ctx context.Context, webhookID int64) ([]models.WebhookDeadLetter, error) {
+	if _, err := s.GetWebhook(ctx, webhookID); err != nil {
+		return nil, err
+	}
+	return s.webhooks.ListDeadLetters(ctx, webhookID)
+}
+
+// Redeliver queues a dead letter for delivery again, with a fresh set of
+// attempts, and returns the new delivery's ID.
+func (s *WebhookService) Redeliver(ctx context.Context, deadLetterID int64) (int64, error) {
+	if deadLetterID <= 0 {
+		return 0, constants.ErrInvalidDeadLetterID
+	}
+
+	deliveryID, err := s.webhooks.Redeliver(ctx, deadLetterID)
+	if err != nil {
+		return 0, err
+	}
+
+	s.log.Info("Webhook dead letter requeued",
+		zap.Int64("dead_letter_id", deadLetterID),
+		zap.Int64("delivery_id", deliveryID))
+
+	return deliveryID, nil
+}
+
+// DeliverDue attempts the deliveries that have fallen due, if this instance
+// holds the webhook lock, and returns how many were delivered. Subscriptions
+// are worked through concurrently, up to the configured number of workers,
+// so a slow or dead receiver only holds up its own deliveries.
+func (s *WebhookService) DeliverDue(ctx context.Context) (int, error) {
+	var delivered atomic.Int64
+	_, err := s.webhooks.WithLeaderLock(ctx, func(ctx context.Context) error {
+		due, err := s.webhooks.Due(ctx, time.Now(), webhookBatchSize)
+		if err != nil {
+			return err
+		}
+
+		queues := make(chan []models.WebhookDelivery)
+		var wg sync.WaitGroup
+		for range s.workers {
+			wg.Go(func() {
+				for q := range queues {
+					delivered.Add(int64(s.deliverQueue(ctx, q)))
+				}
+			})
+		}
+		for _, q := range bySubscription(due) {
+			queues <- q
+		}
+		close(queues)
+		wg.Wait()
+		return nil
+	})
+	return int(delivered.Load()), err
+}
+
+// bySubscription splits due deliveries into one queue per subscription,
+// keeping each queue in due order.
+func bySubscription(due []models.WebhookDelivery) [][]models.WebhookDelivery {
+	index := make(map[int64]int)
+	var queues [][]models.WebhookDelivery
+	for _, d := range due {
+		i, ok := index[d.SubscriptionID]
+		if !ok {
+			i = len(queues)
+			index[d.SubscriptionID] = i
+			queues = append(queues, nil)
+		}
+		queues[i] = append(queues[i], d)
+	}
+	return queues
+}
+
+// deliverQueue sends one subscription's due deliveries in order and returns
+// how many were delivered. It stops at the first failed send: the rest stay
+// due, without using up an attempt, and are tried again next tick.
+func (s *WebhookService) deliverQueue(ctx context.Context, queue []models.WebhookDelivery) int {
+	var delivered int
+	for i := range queue {
+		ok, err := s.deliver(ctx, &queue[i])
+		if err != nil {
+			s.log.Error("Recording webhook attempt failed", zap.Int64("delivery_id", queue[i].ID), zap.Error(err))
+		}
+		if !ok {
+			if rest := len(queue) - i - 1; rest > 0 {
+				s.log.Info("Deferring webhook deliveries after a failed send",
+					zap.Int64("webhook_id", queue[i].SubscriptionID),
+					zap.Int("deferred", rest))
+			}
+			return delivered
+		}
+		if err == nil {
+			delivered++
+		}
+	}
+	return delivered
+}
+
+// deliver makes one attempt at d and records the outcome: delivered, due
+// again after a backoff, or dead-lettered once MaxAttempts have failed.
+func (s *WebhookService) deliver(ctx context.Context, d *models.WebhookDelivery) (bool, error) {
+	attempts := d.Attempts + 1
+
+	sendErr := s.sender.Send(ctx, d)
+	if sendErr == nil {
+		return true, s.webhooks.MarkDelivered(ctx, d.ID, attempts)
+	}
+
+	if attempts >= s.retry.MaxAttempts {
+		s.log.Warn("Webhook dead-lettered",
+			zap.Int64("delivery_id", d.ID),
+			zap.Int64("webhook_id", d.SubscriptionID),
+			zap.Int64("event_id", d.Event.ID),
+			zap.Int("attempts", attempts),
+			zap.Error(sendErr))
+		return false, s.webhooks.DeadLetter(ctx, d.ID, attempts, sendErr.Error())
+	}
+
+	next := time.Now().Add(s.retry.Backoff(attempts))
+	s.log.Info("Webhook delivery failed, will retry",
+		zap.Int64("delivery_id", d.ID),
+		zap.Int64("webhook_id", d.SubscriptionID),
+		zap.Int("attempts", attempts),
+		zap.Time("next_attempt_at", next),
+		zap.Error(sendErr))
+	return false, s.webhooks.RetryLater(ctx, d.ID, attempts, next, sendErr.Error())
+}
+
+// RunDelivery calls DeliverDue every interval until ctx is cancelled. Every
+// Core instance runs the loop; the advisory lock lets one deliver per tick.
+func (s *WebhookService) RunDelivery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.DeliverDue(ctx); err != nil {
+				s.log.Error("Webhook delivery tick failed", zap.Error(err))
+			}
+		}
+	}
+}
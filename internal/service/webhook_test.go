@@ -0,0 +1,303 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/jhaprabhatt/account-transfer-project/internal/constants"
+	"github.com/jhaprabhatt/account-transfer-project/internal/models"
+	"github.com/jhaprabhatt/account-transfer-project/internal/publisher"
+	"github.com/jhaprabhatt/account-transfer-project/internal/service"
+	"github.com/jhaprabhatt/account-transfer-project/internal/service/mocks"
+)
+
+// newWebhookTestSetup allows private addresses so deliveries can reach
+// httptest receivers on loopback.
+func newWebhookTestSetup(t *testing.T) (*mocks.MockWebhookRepo, *mocks.MockCache, *service.WebhookService) {
+	return newWebhookTestSetupWithClient(t, publisher.NewWebhookClient(time.Second, true))
+}
+
+func newWebhookTestSetupWithClient(t *testing.T, client *publisher.WebhookClient) (*mocks.MockWebhookRepo, *mocks.MockCache, *service.WebhookService) {
+	repo := new(mocks.MockWebhookRepo)
+	cache := new(mocks.MockCache)
+	retry := models.WebhookRetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute}
+	svc := service.NewWebhookService(repo, new(mocks.MockAccountRepo), cache, client, retry, 4, zap.NewNop())
+	return repo, cache, svc
+}
+
+func TestWebhookService_CreateWebhook(t *testing.T) {
+	t.Run("Success: Secret Generated And Event Types Deduplicated", func(t *testing.T) {
+		repo, cache, svc := newWebhookTestSetup(t)
+		cache.On("GetAccount", mock.Anything, int64(101)).Return(activeAccount(101), nil)
+		repo.On("Create", mock.Anything, mock.AnythingOfType("*models.WebhookSubscription")).
+			Run(func(args mock.Arguments) { args.Get(1).(*models.WebhookSubscription).ID = 3 }).
+			Return(nil)
+
+		w, err := svc.CreateWebhook(context.Background(), &models.WebhookRequest{
+			URL:        "https://partner.example/hooks",
+			EventTypes: []models.EventType{models.EventTransferFailed, models.EventTransferCompleted, models.EventTransferFailed},
+			AccountID:  101,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), w.ID)
+		assert.Len(t, w.Secret, 64)
+		assert.Equal(t, []models.EventType{models.EventTransferCompleted, models.EventTransferFailed}, w.EventTypes)
+		assert.Equal(t, constants.WebhookActive, w.Status)
+	})
+
+	t.Run("Failure: Invalid URL", func(t *testing.T) {
+		repo, _, svc := newWebhookTestSetup(t)
+
+		_, err := svc.CreateWebhook(context.Background(), &models.WebhookRequest{
+			URL:        "ftp://partner.example",
+			EventTypes: []models.EventType{models.EventTransferCompleted},
+		})
+
+		assert.ErrorIs(t, err, constants.ErrInvalidWebhookURL)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure: Internal Address Rejected", func(t *testing.T) {
+		for _, url := range []string{
+			"http://127.0.0.1:8080/hooks",
+			"http://169.254.169.254/latest/meta-data",
+			"https://10.0.0.7/hooks",
+			"http://[::1]/hooks",
+			"http://localhost/hooks",
+		} {
+			repo, _, svc := newWebhookTestSetupWithClient(t, publisher.NewWebhookClient(time.Second, false))
+
+			_, err := svc.CreateWebhook(context.Background(), &models.WebhookRequest{
+				URL:        url,
+				EventTypes: []models.EventType{models.EventTransferCompleted},
+			})
+
+			assert.ErrorIs(t, err, constants.ErrWebhookURLNotAllowed, url)
+			repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("Failure: Unknown Event Type", func(t *testing.T) {
+		_, _, svc := newWebhookTestSetup(t)
+
+		_, err := svc.CreateWebhook(context.Background(), &models.WebhookRequest{
+			URL:        "https://partner.example/hooks",
+			EventTypes: []models.EventType{"TransferPending"},
+		})
+
+		assert.ErrorIs(t, err, constants.ErrInvalidEventTypes)
+	})
+
+	t.Run("Failure: Account Not Found", func(t *testing.T) {
+		repo, cache, svc := newWebhookTestSetup(t)
+		cache.On("GetAccount", mock.Anything, int64(101)).Return(nil, constants.ErrAccountNotFound)
+
+		_, err := svc.CreateWebhook(context.Background(), &models.WebhookRequest{
+			URL:        "https://partner.example/hooks",
+			EventTypes: []models.EventType{models.EventTransferCompleted},
+			AccountID:  101,
+		})
+
+		assert.ErrorIs(t, err, constants.ErrAccountNotFound)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestWebhookService_DeliverDue(t *testing.T) {
+	const secret = "0123456789abcdef"
+	event := models.Event{
+		ID: 42, Type: models.EventTransferCompleted, Version: 1, AccountID: 101, CounterpartyID: 202,
+		Payload: json.RawMessage(`{"transfer_id":7}`), CreatedAt: time.Date(2026, 3, 1, 14, 0, 0, 0, time.UTC),
+	}
+	delivery := func(url string, attempts int) []models.WebhookDelivery {
+		return []models.WebhookDelivery{{ID: 9, SubscriptionID: 3, URL: url, Secret: secret, Attempts: attempts, Event: event}}
+	}
+
+	t.Run("Success: Signed Event Delivered", func(t *testing.T) {
+		var (
+			body    []byte
+			headers http.Header
+		)
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ = io.ReadAll(r.Body)
+			headers = r.Header.Clone()
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer receiver.Close()
+
+		repo, _, svc := newWebhookTestSetup(t)
+		repo.On("WithLeaderLock", mock.Anything).Return(true, nil)
+		repo.On("Due", mock.Anything, mock.Anything, 100).Return(delivery(receiver.URL, 0), nil)
+		repo.On("MarkDelivered", mock.Anything, int64(9), 1).Return(nil)
+
+		delivered, err := svc.DeliverDue(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, delivered)
+		repo.AssertExpectations(t)
+
+		assert.JSONEq(t, `{"event_id":42,"type":"TransferCompleted","version":1,"account_id":101,"counterparty_account_id":202,
+			"payload":{"transfer_id":7},"created_at":"2026-03-01T14:00:00Z"}`, string(body))
+		assert.Equal(t, "42", headers.Get(publisher.WebhookEventIDHeader))
+		assert.Equal(t, "TransferCompleted", headers.Get(publisher.WebhookEventTypeHeader))
+		timestamp, err := strconv.ParseInt(headers.Get(publisher.WebhookTimestampHeader), 10, 64)
+		require.NoError(t, err)
+		assert.Equal(t, publisher.SignWebhook(secret, timestamp, body), headers.Get(publisher.WebhookSignatureHeader))
+	})
+
+	t.Run("Failure: Rejected Delivery Retried With Backoff", func(t *testing.T) {
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer receiver.Close()
+
+		repo, _, svc := newWebhookTestSetup(t)
+		repo.On("WithLeaderLock", mock.Anything).Return(true, nil)
+		repo.On("Due", mock.Anything, mock.Anything, 100).Return(delivery(receiver.URL, 1), nil)
+
+		var next time.Time
+		repo.On("RetryLater", mock.Anything, int64(9), 2, mock.Anything, "unexpected status 500").
+			Run(func(args mock.Arguments) { next = args.Get(3).(time.Time) }).
+			Return(nil)
+
+		before := time.Now()
+		delivered, err := svc.DeliverDue(context.Background())
+
+		require.NoError(t, err)
+		assert.Zero(t, delivered)
+		repo.AssertExpectations(t)
+		// The second failed attempt waits twice the base delay.
+		assert.WithinDuration(t, before.Add(2*time.Second), next, time.Second)
+	})
+
+	t.Run("Failure: Last Attempt Dead-Lettered", func(t *testing.T) {
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusGone)
+		}))
+		defer receiver.Close()
+
+		repo, _, svc := newWebhookTestSetup(t)
+		repo.On("WithLeaderLock", mock.Anything).Return(true, nil)
+		repo.On("Due", mock.Anything, mock.Anything, 100).Return(delivery(receiver.URL, 2), nil)
+		repo.On("DeadLetter", mock.Anything, int64(9), 3, "unexpected status 410").Return(nil)
+
+		_, err := svc.DeliverDue(context.Background())
+
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+		repo.AssertNotCalled(t, "RetryLater", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success: Slow Receiver Does Not Hold Up Others", func(t *testing.T) {
+		healthyDone := make(chan struct{})
+		var releasedByHealthy bool
+		slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			select {
+			case <-healthyDone:
+				releasedByHealthy = true
+			case <-time.After(500 * time.Millisecond):
+			}
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer slow.Close()
+		healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+			close(healthyDone)
+		}))
+		defer healthy.Close()
+
+		repo, _, svc := newWebhookTestSetup(t)
+		repo.On("WithLeaderLock", mock.Anything).Return(true, nil)
+		repo.On("Due", mock.Anything, mock.Anything, 100).Return([]models.WebhookDelivery{
+			{ID: 1, SubscriptionID: 3, URL: slow.URL, Secret: secret, Event: event},
+			{ID: 2, SubscriptionID: 3, URL: slow.URL, Secret: secret, Event: event},
+			{ID: 3, SubscriptionID: 4, URL: healthy.URL, Secret: secret, Event: event},
+		}, nil)
+		repo.On("RetryLater", mock.Anything, int64(1), 1, mock.Anything, "unexpected status 503").Return(nil)
+		repo.On("MarkDelivered", mock.Anything, int64(3), 1).Return(nil)
+
+		delivered, err := svc.DeliverDue(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, delivered)
+		assert.True(t, releasedByHealthy, "healthy receiver should be sent to while the slow one is waiting")
+		repo.AssertExpectations(t)
+		// The failed send defers the rest of that subscription's queue untouched.
+		repo.AssertNotCalled(t, "RetryLater", mock.Anything, int64(2), mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure: Internal Address Refused At Send Time", func(t *testing.T) {
+		var called bool
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer receiver.Close()
+
+		repo, _, svc := newWebhookTestSetupWithClient(t, publisher.NewWebhookClient(time.Second, false))
+		repo.On("WithLeaderLock", mock.Anything).Return(true, nil)
+		repo.On("Due", mock.Anything, mock.Anything, 100).Return(delivery(receiver.URL, 0), nil)
+		repo.On("RetryLater", mock.Anything, int64(9), 1, mock.Anything, mock.AnythingOfType("string")).Return(nil)
+
+		delivered, err := svc.DeliverDue(context.Background())
+
+		require.NoError(t, err)
+		assert.Zero(t, delivered)
+		assert.False(t, called)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Success: Another Instance Holds The Lock", func(t *testing.T) {
+		repo, _, svc := newWebhookTestSetup(t)
+		repo.On("WithLeaderLock", mock.Anything).Return(false, nil)
+
+		delivered, err := svc.DeliverDue(context.Background())
+
+		require.NoError(t, err)
+		assert.Zero(t, delivered)
+		repo.AssertNotCalled(t, "Due", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestWebhookService_Redeliver(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		repo, _, svc := newWebhookTestSetup(t)
+		repo.On("Redeliver", mock.Anything, int64(5)).Return(int64(12), nil)
+
+		id, err := svc.Redeliver(context.Background(), 5)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(12), id)
+	})
+
+	t.Run("Failure: Invalid ID", func(t *testing.T) {
+		repo, _, svc := newWebhookTestSetup(t)
+
+		_, err := svc.Redeliver(context.Background(), 0)
+
+		assert.ErrorIs(t, err, constants.ErrInvalidDeadLetterID)
+		repo.AssertNotCalled(t, "Redeliver", mock.Anything, mock.Anything)
+	})
+}
+
+func TestWebhookRetryPolicy_Backoff(t *testing.T) {
+	p := models.WebhookRetryPolicy{MaxAttempts: 8, BaseDelay: 10 * time.Second, MaxDelay: time.Minute}
+
+	assert.Equal(t, 10*time.Second, p.Backoff(1))
+	assert.Equal(t, 20*time.Second, p.Backoff(2))
+	assert.Equal(t, 40*time.Second, p.Backoff(3))
+	assert.Equal(t, time.Minute, p.Backoff(4))
+	assert.Equal(t, time.Minute, p.Backoff(60))
+}
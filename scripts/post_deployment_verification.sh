@@ -6,13 +6,14 @@ BASE_URL="${BASE_URL:-http://localhost:8080}"
 pass() { echo "✅ PASS: $*"; }
 fail() { echo "❌ FAIL: $*"; exit 1; }
 
-# curl_json METHOD PATH JSON EXPECTED_HTTP_CODE EXPECTED_BODY_SUBSTRING
+# curl_json METHOD PATH JSON EXPECTED_HTTP_CODE EXPECTED_BODY_SUBSTRING [EXTRA_HEADER]
 curl_json() {
   local method="$1"
   local path="$2"
   local json="$3"
   local expected_code="$4"
   local expected_substr="$5"
+  local extra_header="${6:-X-PDV: 1}"
 
   local tmp_headers tmp_body
   tmp_headers="$(mktemp)"
@@ -22,6 +23,7 @@ curl_json() {
   local http_code
   http_code="$(curl -sS -X "$method" "${BASE_URL}${path}" \
     -H "Content-Type: application/json" \
+    -H "$extra_header" \
     -d "$json" \
     -D "$tmp_headers" \
     -o "$tmp_body" \
@@ -113,6 +115,16 @@ curl_json POST /transfers \
 "200" \
 "\"success\":true"
 
+# Read back the destination balance
+curl_json GET "/accounts/${ACCOUNT_DEST}" "" \
+"200" \
+"\"balance\":\"100\""
+
+# Unknown account - should fail with 404
+curl_json GET "/accounts/${ACCOUNT_NEG}" "" \
+"404" \
+"Account not found"
+
 # =========================
 # Transfer validation cases
 # =========================
@@ -156,5 +168,45 @@ curl_json POST /transfers \
 "400" \
 "amount"
 
+# 🔟 Idempotent retry - same key and payload replays the original transfer
+IDEMPOTENCY_KEY="pdv-${RUN_ID}"
+
+curl_json POST /transfers \
+"$(transfer_json "${ACCOUNT_OK}" "${ACCOUNT_DEST}" "1.00")" \
+"200" \
+"\"success\":true" \
+"Idempotency-Key: ${IDEMPOTENCY_KEY}"
+
+curl_json POST /transfers \
+"$(transfer_json "${ACCOUNT_OK}" "${ACCOUNT_DEST}" "1.00")" \
+"200" \
+"\"success\":true" \
+"Idempotency-Key: ${IDEMPOTENCY_KEY}"
+
+# Same key, different payload - should fail with 409
+curl_json POST /transfers \
+"$(transfer_json "${ACCOUNT_OK}" "${ACCOUNT_DEST}" "2.00")" \
+"409" \
+"idempotency key" \
+"Idempotency-Key: ${IDEMPOTENCY_KEY}"
+
+# 1️⃣1️⃣ Currency checks - EUR account cannot receive USD, JPY has no minor units
+ACCOUNT_EUR="$((ACCOUNT_OK + 20))"
+
+curl_json POST /accounts \
+"{\"account_id\":${ACCOUNT_EUR},\"balance\":\"0.00\",\"currency\":\"EUR\"}" \
+"201" \
+"\"success\":true"
+
+curl_json POST /transfers \
+"$(transfer_json "${ACCOUNT_OK}" "${ACCOUNT_EUR}" "1.00")" \
+"422" \
+"currencies differ"
+
+curl_json POST /accounts \
+"{\"account_id\":$((ACCOUNT_OK + 21)),\"balance\":\"10.5\",\"currency\":\"JPY\"}" \
+"400" \
+"decimal places"
+
 echo
 echo "🎉 Post Deployment Verification completed successfully."